@@ -37,9 +37,21 @@ func NewExportCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key")).BackupRestore()
-			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key")).BackupRestore()
-			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key")).BackupRestore()
+			coreOptions, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), coreOptions...).BackupRestore()
+			ingestionOptions, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), ingestionOptions...).BackupRestore()
+			queryflowOptions, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), queryflowOptions...).BackupRestore()
 
 			clients := []cli.BackupRestoreClientEntry{
 				{Name: "core", Client: coreClient},
@@ -0,0 +1,342 @@
+package commands
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// profileValue obtains the value of the given property.
+// The global value, which is set with a flag, takes precedence over the property of the profile.
+func profileValue(vpr *viper.Viper, profile, key string) string {
+	if vpr.IsSet(key) {
+		return strings.TrimSpace(vpr.GetString(key))
+	}
+
+	return strings.TrimSpace(vpr.GetString(profile + "." + key))
+}
+
+// parseDuration parses a duration property, which can be a duration, like "30s" or "2m", or a number of seconds.
+// An empty value is parsed as zero. The name is used in the error messages.
+func parseDuration(value, name string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, cli.NewErrorWithCause(cli.ErrorExitCode, err, "Invalid %s %q. The %s must be a duration, like \"30s\" or \"2m\"", name, value, name)
+	}
+
+	if duration < 0 {
+		return 0, cli.NewError(cli.ErrorExitCode, "Invalid %s %q. The %s cannot be negative", name, value, name)
+	}
+
+	return duration, nil
+}
+
+// RequestTimeout obtains the timeout of the requests sent to Discovery with the given profile.
+// The --timeout flag takes precedence over the timeout property of the profile.
+// The timeout can be a duration, like "30s" or "2m", or a number of seconds. If it is not set, the requests have no timeout.
+func RequestTimeout(vpr *viper.Viper, profile string) (time.Duration, error) {
+	return parseDuration(profileValue(vpr, profile, "timeout"), "timeout")
+}
+
+// RequestRetryPolicy obtains the policy used to retry the requests sent to Discovery with the given profile.
+// The --retries and --retry-wait flags take precedence over the retries and retry_wait properties of the profile.
+// The retry_max_wait and retry_non_idempotent properties can only be set in the profile.
+// The properties that are not set keep the values of the default retry policy.
+func RequestRetryPolicy(vpr *viper.Viper, profile string) (discoveryPackage.RetryPolicy, error) {
+	policy := discoveryPackage.DefaultRetryPolicy()
+
+	if value := profileValue(vpr, profile, "retries"); value != "" {
+		retries, err := strconv.Atoi(value)
+		if err != nil || retries < 0 {
+			return discoveryPackage.RetryPolicy{}, cli.NewError(cli.ErrorExitCode, "Invalid number of retries %q. The number of retries must be a non-negative integer", value)
+		}
+		policy.MaxRetries = retries
+	}
+
+	if value := profileValue(vpr, profile, "retry_wait"); value != "" {
+		wait, err := parseDuration(value, "retry wait")
+		if err != nil {
+			return discoveryPackage.RetryPolicy{}, err
+		}
+		policy.WaitTime = wait
+	}
+
+	if value := profileValue(vpr, profile, "retry_max_wait"); value != "" {
+		maxWait, err := parseDuration(value, "retry max wait")
+		if err != nil {
+			return discoveryPackage.RetryPolicy{}, err
+		}
+		policy.MaxWaitTime = maxWait
+	}
+
+	if value := profileValue(vpr, profile, "retry_non_idempotent"); value != "" {
+		nonIdempotent, err := strconv.ParseBool(value)
+		if err != nil {
+			return discoveryPackage.RetryPolicy{}, cli.NewErrorWithCause(cli.ErrorExitCode, err, "Invalid value %q for retry_non_idempotent. It must be true or false", value)
+		}
+		policy.NonIdempotent = nonIdempotent
+	}
+
+	return policy, nil
+}
+
+// RequestConcurrency obtains the number of pages that are requested at the same time when listing entities with the given profile.
+// The --concurrency flag takes precedence over the concurrency property of the profile.
+// If it is not set, the pages are requested one at a time.
+func RequestConcurrency(vpr *viper.Viper, profile string) (int, error) {
+	value := profileValue(vpr, profile, "concurrency")
+	if value == "" {
+		return 1, nil
+	}
+
+	concurrency, err := strconv.Atoi(value)
+	if err != nil || concurrency < 1 {
+		return 0, cli.NewError(cli.ErrorExitCode, "Invalid concurrency %q. The concurrency must be a positive integer", value)
+	}
+
+	return concurrency, nil
+}
+
+// RequestRateLimit obtains the maximum number of requests per second sent to each Discovery product with the given profile,
+// and the number of requests that can be sent at once before the limit applies.
+// The --rate-limit flag takes precedence over the rate_limit property of the profile. The burst can only be set with the rate_limit_burst property.
+// If the rate limit is not set or is zero, the requests are not limited. If the burst is not set, it is the rate limit rounded up.
+func RequestRateLimit(vpr *viper.Viper, profile string) (float64, int, error) {
+	value := profileValue(vpr, profile, "rate_limit")
+	if value == "" {
+		return 0, 0, nil
+	}
+
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil || rate < 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+		return 0, 0, cli.NewError(cli.ErrorExitCode, "Invalid rate limit %q. The rate limit must be a non-negative number of requests per second", value)
+	}
+
+	burst := max(1, int(math.Ceil(rate)))
+	if value := profileValue(vpr, profile, "rate_limit_burst"); value != "" {
+		burst, err = strconv.Atoi(value)
+		if err != nil || burst < 1 {
+			return 0, 0, cli.NewError(cli.ErrorExitCode, "Invalid rate limit burst %q. The burst must be a positive integer", value)
+		}
+	}
+
+	return rate, burst, nil
+}
+
+// Components are the names of the Discovery components used in the properties of the profiles, like core_url.
+var Components = []string{"core", "ingestion", "queryflow", "staging"}
+
+// Authentication types of the Discovery components.
+const (
+	// AuthAPIKey sends the component's API key in the X-API-Key header. It is the default authentication.
+	AuthAPIKey string = "api-key"
+	// AuthBearer sends a static token in the Authorization header.
+	AuthBearer string = "bearer"
+	// AuthBasic sends a username and a password in the Authorization header.
+	AuthBasic string = "basic"
+	// AuthOAuth2 obtains a token with the OAuth2 client credentials grant and sends it in the Authorization header.
+	AuthOAuth2 string = "oauth2"
+)
+
+// requiredProperty obtains a property of a component's authentication and fails if it is not set.
+func requiredProperty(vpr *viper.Viper, profile, component, authType, property string) (string, error) {
+	value := strings.TrimSpace(vpr.GetString(profile + "." + component + "_" + property))
+	if value == "" {
+		return "", cli.NewError(cli.ErrorExitCode, "The %s authentication of %s requires the %s_%s property in the %q profile", authType, component, component, property, profile)
+	}
+	return value, nil
+}
+
+// RequestAuthenticator obtains the authenticator of the requests sent to the given component with the given profile.
+// The type of authentication is set with the component's auth property, like core_auth, and its credentials are set with the properties of that type:
+//   - api-key: the API key in the component's key property, like core_key. It is the default authentication, so a nil authenticator is returned.
+//   - bearer: the token in the component's token property, like core_token.
+//   - basic: the username and password properties, like core_username and core_password.
+//   - oauth2: the token_url, client_id and client_secret properties, and the optional scopes property, which is a list of scopes separated by commas or spaces.
+func RequestAuthenticator(vpr *viper.Viper, profile, component string) (discoveryPackage.Authenticator, error) {
+	authType := strings.ToLower(strings.TrimSpace(vpr.GetString(profile + "." + component + "_auth")))
+	switch authType {
+	case "", AuthAPIKey:
+		return nil, nil
+	case AuthBearer:
+		token, err := requiredProperty(vpr, profile, component, authType, "token")
+		if err != nil {
+			return nil, err
+		}
+		return discoveryPackage.BearerAuth(token), nil
+	case AuthBasic:
+		username, err := requiredProperty(vpr, profile, component, authType, "username")
+		if err != nil {
+			return nil, err
+		}
+		return discoveryPackage.BasicAuth(username, vpr.GetString(profile+"."+component+"_password")), nil
+	case AuthOAuth2:
+		config := discoveryPackage.OAuth2Config{}
+		var err error
+		if config.TokenURL, err = requiredProperty(vpr, profile, component, authType, "token_url"); err != nil {
+			return nil, err
+		}
+		if config.ClientID, err = requiredProperty(vpr, profile, component, authType, "client_id"); err != nil {
+			return nil, err
+		}
+		if config.ClientSecret, err = requiredProperty(vpr, profile, component, authType, "client_secret"); err != nil {
+			return nil, err
+		}
+		config.Scopes = strings.FieldsFunc(vpr.GetString(profile+"."+component+"_scopes"), func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+		return discoveryPackage.OAuth2ClientCredentialsAuth(config), nil
+	default:
+		return nil, cli.NewError(cli.ErrorExitCode, "Invalid authentication %q for %s. The authentication must be %s, %s, %s or %s", authType, component, AuthAPIKey, AuthBearer, AuthBasic, AuthOAuth2)
+	}
+}
+
+// RequestTransportConfig obtains the TLS and proxy settings of the requests sent to Discovery with the given profile.
+// They are set with the ca_cert, client_cert, client_key, insecure_skip_verify and proxy_url properties of the profile.
+func RequestTransportConfig(vpr *viper.Viper, profile string) (discoveryPackage.TransportConfig, error) {
+	config := discoveryPackage.TransportConfig{
+		CAFile:   strings.TrimSpace(vpr.GetString(profile + ".ca_cert")),
+		CertFile: strings.TrimSpace(vpr.GetString(profile + ".client_cert")),
+		KeyFile:  strings.TrimSpace(vpr.GetString(profile + ".client_key")),
+		ProxyURL: strings.TrimSpace(vpr.GetString(profile + ".proxy_url")),
+	}
+
+	if value := strings.TrimSpace(vpr.GetString(profile + ".insecure_skip_verify")); value != "" {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			return discoveryPackage.TransportConfig{}, cli.NewErrorWithCause(cli.ErrorExitCode, err, "Invalid value %q for insecure_skip_verify. It must be true or false", value)
+		}
+		config.InsecureSkipVerify = insecure
+	}
+
+	return config, nil
+}
+
+// transports contains the transports created for each TLS and proxy configuration, so that the clients of a command share their connections.
+var (
+	transportsMu sync.Mutex
+	transports   = map[discoveryPackage.TransportConfig]*http.Transport{}
+)
+
+// RequestTransport obtains the transport of the requests sent to Discovery with the given profile.
+// If the profile does not have TLS or proxy settings, it returns nil so that the clients use the default transport.
+// The transports are created once for each configuration and reused by the following calls.
+func RequestTransport(vpr *viper.Viper, profile string) (http.RoundTripper, error) {
+	config, err := RequestTransportConfig(vpr, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == (discoveryPackage.TransportConfig{}) {
+		return nil, nil
+	}
+
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+
+	if transport, ok := transports[config]; ok {
+		return transport, nil
+	}
+
+	transport, err := discoveryPackage.NewTransportWithConfig(config)
+	if err != nil {
+		return nil, cli.NewErrorWithCause(cli.ErrorExitCode, err, "Invalid TLS or proxy configuration in the %q profile", profile)
+	}
+	transports[config] = transport
+	return transport, nil
+}
+
+// RequestTraceLevel obtains how much information of the requests sent to Discovery is written to the error stream.
+// The --trace flag writes the headers and bodies of the requests and responses, while the --verbose flag only writes their method, URL, status and duration.
+func RequestTraceLevel(vpr *viper.Viper) discoveryPackage.TraceLevel {
+	switch {
+	case vpr.GetBool("trace"):
+		return discoveryPackage.TraceFull
+	case vpr.GetBool("verbose"):
+		return discoveryPackage.TraceVerbose
+	default:
+		return discoveryPackage.TraceOff
+	}
+}
+
+// ClientOptions returns the options used to create the Discovery clients of the given profile and component.
+// The clients use the command's context, so their requests are canceled when the command is interrupted.
+// They also use the timeout obtained with the RequestTimeout() function, the retry policy obtained with the RequestRetryPolicy() function
+// and the concurrency obtained with the RequestConcurrency() function.
+// If a rate limit is obtained with the RequestRateLimit() function, the requests of the clients are limited to it.
+// Every call returns a new rate limiter, so the limit applies to each product client and all the sub-clients created from it.
+// If the profile has TLS or proxy settings, the clients use the transport obtained with the RequestTransport() function.
+// If the component does not authenticate with its API key, the clients use the authenticator obtained with the RequestAuthenticator() function.
+// If the --verbose or --trace flags are set, the requests and responses are written to the error stream.
+// It returns an error if any of the properties is invalid, so only the commands that create clients fail because of them.
+func ClientOptions(cmd *cobra.Command, d cli.Discovery, profile, component string) ([]discoveryPackage.ClientOption, error) {
+	options := []discoveryPackage.ClientOption{discoveryPackage.WithContext(cmd.Context())}
+
+	timeout, err := RequestTimeout(d.Config(), profile)
+	if err != nil {
+		return nil, err
+	}
+	if timeout > 0 {
+		options = append(options, discoveryPackage.WithTimeout(timeout))
+	}
+
+	policy, err := RequestRetryPolicy(d.Config(), profile)
+	if err != nil {
+		return nil, err
+	}
+	options = append(options, discoveryPackage.WithRetryPolicy(policy))
+
+	concurrency, err := RequestConcurrency(d.Config(), profile)
+	if err != nil {
+		return nil, err
+	}
+	if concurrency > 1 {
+		options = append(options, discoveryPackage.WithConcurrency(concurrency))
+	}
+
+	rate, burst, err := RequestRateLimit(d.Config(), profile)
+	if err != nil {
+		return nil, err
+	}
+	if rate > 0 {
+		options = append(options, discoveryPackage.WithRateLimit(rate, burst))
+	}
+
+	transport, err := RequestTransport(d.Config(), profile)
+	if err != nil {
+		return nil, err
+	}
+	if transport != nil {
+		options = append(options, discoveryPackage.WithTransport(transport))
+	}
+
+	auth, err := RequestAuthenticator(d.Config(), profile, component)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		options = append(options, discoveryPackage.WithAuthenticator(auth))
+	}
+
+	if level := RequestTraceLevel(d.Config()); level != discoveryPackage.TraceOff {
+		options = append(options, discoveryPackage.WithTrace(d.IOStreams().Err, level))
+	}
+
+	return options, nil
+}
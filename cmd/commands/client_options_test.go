@@ -0,0 +1,753 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRequestTimeout tests the RequestTimeout() function.
+func TestRequestTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected time.Duration
+		err      error
+	}{
+		// Working cases
+		{
+			name:     "There is no timeout",
+			config:   map[string]string{},
+			expected: 0,
+			err:      nil,
+		},
+		{
+			name: "The profile has a duration timeout",
+			config: map[string]string{
+				"default.timeout": "2m",
+			},
+			expected: 2 * time.Minute,
+			err:      nil,
+		},
+		{
+			name: "The profile has a timeout in seconds",
+			config: map[string]string{
+				"default.timeout": "45",
+			},
+			expected: 45 * time.Second,
+			err:      nil,
+		},
+		{
+			name: "The timeout flag overrides the profile's timeout",
+			config: map[string]string{
+				"default.timeout": "2m",
+				"timeout":         "10s",
+			},
+			expected: 10 * time.Second,
+			err:      nil,
+		},
+		{
+			name: "The timeout of another profile is ignored",
+			config: map[string]string{
+				"cn.timeout": "2m",
+			},
+			expected: 0,
+			err:      nil,
+		},
+
+		// Error cases
+		{
+			name: "The timeout is not a duration",
+			config: map[string]string{
+				"default.timeout": "thirty seconds",
+			},
+			expected: 0,
+			err:      cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("time: invalid duration \"thirty seconds\""), "Invalid timeout \"thirty seconds\". The timeout must be a duration, like \"30s\" or \"2m\""),
+		},
+		{
+			name: "The timeout is negative",
+			config: map[string]string{
+				"default.timeout": "-5s",
+			},
+			expected: 0,
+			err:      cli.NewError(cli.ErrorExitCode, "Invalid timeout \"-5s\". The timeout cannot be negative"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			timeout, err := RequestTimeout(vpr, "default")
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, timeout)
+		})
+	}
+}
+
+// TestRequestRetryPolicy tests the RequestRetryPolicy() function.
+func TestRequestRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected discoveryPackage.RetryPolicy
+		err      error
+	}{
+		// Working cases
+		{
+			name:     "The default retry policy is used",
+			config:   map[string]string{},
+			expected: discoveryPackage.DefaultRetryPolicy(),
+			err:      nil,
+		},
+		{
+			name: "The profile sets the retry policy",
+			config: map[string]string{
+				"default.retries":              "5",
+				"default.retry_wait":           "1s",
+				"default.retry_max_wait":       "10",
+				"default.retry_non_idempotent": "true",
+			},
+			expected: discoveryPackage.RetryPolicy{
+				MaxRetries:    5,
+				WaitTime:      time.Second,
+				MaxWaitTime:   10 * time.Second,
+				NonIdempotent: true,
+			},
+			err: nil,
+		},
+		{
+			name: "The flags override the profile's retry policy",
+			config: map[string]string{
+				"default.retries":    "5",
+				"default.retry_wait": "1s",
+				"retries":            "0",
+				"retry_wait":         "200ms",
+			},
+			expected: discoveryPackage.RetryPolicy{
+				MaxRetries:  0,
+				WaitTime:    200 * time.Millisecond,
+				MaxWaitTime: discoveryPackage.DefaultMaxWaitTime,
+			},
+			err: nil,
+		},
+
+		// Error cases
+		{
+			name: "The number of retries is not an integer",
+			config: map[string]string{
+				"default.retries": "many",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid number of retries \"many\". The number of retries must be a non-negative integer"),
+		},
+		{
+			name: "The number of retries is negative",
+			config: map[string]string{
+				"default.retries": "-1",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid number of retries \"-1\". The number of retries must be a non-negative integer"),
+		},
+		{
+			name: "The retry wait is invalid",
+			config: map[string]string{
+				"default.retry_wait": "a while",
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("time: invalid duration \"a while\""), "Invalid retry wait \"a while\". The retry wait must be a duration, like \"30s\" or \"2m\""),
+		},
+		{
+			name: "The retry max wait is negative",
+			config: map[string]string{
+				"default.retry_max_wait": "-1m",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid retry max wait \"-1m\". The retry max wait cannot be negative"),
+		},
+		{
+			name: "The non-idempotent retries property is not a boolean",
+			config: map[string]string{
+				"default.retry_non_idempotent": "sometimes",
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("strconv.ParseBool: parsing \"sometimes\": invalid syntax"), "Invalid value \"sometimes\" for retry_non_idempotent. It must be true or false"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			policy, err := RequestRetryPolicy(vpr, "default")
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, policy)
+			}
+		})
+	}
+}
+
+// TestRequestConcurrency tests the RequestConcurrency() function.
+func TestRequestConcurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected int
+		err      error
+	}{
+		// Working cases
+		{
+			name:     "The pages are requested one at a time by default",
+			config:   map[string]string{},
+			expected: 1,
+			err:      nil,
+		},
+		{
+			name: "The profile sets the concurrency",
+			config: map[string]string{
+				"default.concurrency": "4",
+			},
+			expected: 4,
+			err:      nil,
+		},
+		{
+			name: "The concurrency flag overrides the profile's concurrency",
+			config: map[string]string{
+				"default.concurrency": "4",
+				"concurrency":         "8",
+			},
+			expected: 8,
+			err:      nil,
+		},
+
+		// Error cases
+		{
+			name: "The concurrency is not an integer",
+			config: map[string]string{
+				"default.concurrency": "fast",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid concurrency \"fast\". The concurrency must be a positive integer"),
+		},
+		{
+			name: "The concurrency is zero",
+			config: map[string]string{
+				"concurrency": "0",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid concurrency \"0\". The concurrency must be a positive integer"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			concurrency, err := RequestConcurrency(vpr, "default")
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, concurrency)
+			}
+		})
+	}
+}
+
+// TestRequestRateLimit tests the RequestRateLimit() function.
+func TestRequestRateLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        map[string]string
+		expectedRate  float64
+		expectedBurst int
+		err           error
+	}{
+		// Working cases
+		{
+			name:          "The requests are not limited by default",
+			config:        map[string]string{},
+			expectedRate:  0,
+			expectedBurst: 0,
+			err:           nil,
+		},
+		{
+			name: "The profile sets the rate limit and the burst is the rate rounded up",
+			config: map[string]string{
+				"default.rate_limit": "2.5",
+			},
+			expectedRate:  2.5,
+			expectedBurst: 3,
+			err:           nil,
+		},
+		{
+			name: "A rate limit lower than one has a burst of one",
+			config: map[string]string{
+				"default.rate_limit": "0.5",
+			},
+			expectedRate:  0.5,
+			expectedBurst: 1,
+			err:           nil,
+		},
+		{
+			name: "The rate limit flag overrides the profile's rate limit",
+			config: map[string]string{
+				"default.rate_limit":       "5",
+				"default.rate_limit_burst": "20",
+				"rate_limit":               "10",
+			},
+			expectedRate:  10,
+			expectedBurst: 20,
+			err:           nil,
+		},
+
+		// Error cases
+		{
+			name: "The rate limit is not a number",
+			config: map[string]string{
+				"default.rate_limit": "fast",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid rate limit \"fast\". The rate limit must be a non-negative number of requests per second"),
+		},
+		{
+			name: "The rate limit is negative",
+			config: map[string]string{
+				"rate_limit": "-1",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid rate limit \"-1\". The rate limit must be a non-negative number of requests per second"),
+		},
+		{
+			name: "The burst is zero",
+			config: map[string]string{
+				"default.rate_limit":       "5",
+				"default.rate_limit_burst": "0",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid rate limit burst \"0\". The burst must be a positive integer"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			rate, burst, err := RequestRateLimit(vpr, "default")
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedRate, rate)
+				assert.Equal(t, tc.expectedBurst, burst)
+			}
+		})
+	}
+}
+
+// TestRequestTraceLevel tests the RequestTraceLevel() function.
+func TestRequestTraceLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected discoveryPackage.TraceLevel
+	}{
+		{
+			name:     "The requests are not traced by default",
+			config:   map[string]string{},
+			expected: discoveryPackage.TraceOff,
+		},
+		{
+			name:     "The verbose flag is set",
+			config:   map[string]string{"verbose": "true"},
+			expected: discoveryPackage.TraceVerbose,
+		},
+		{
+			name:     "The trace flag is set",
+			config:   map[string]string{"trace": "true"},
+			expected: discoveryPackage.TraceFull,
+		},
+		{
+			name:     "The trace flag takes precedence over the verbose flag",
+			config:   map[string]string{"verbose": "true", "trace": "true"},
+			expected: discoveryPackage.TraceFull,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			assert.Equal(t, tc.expected, RequestTraceLevel(vpr))
+		})
+	}
+}
+
+// TestRequestAuthenticator tests the RequestAuthenticator() function.
+func TestRequestAuthenticator(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         map[string]string
+		expectedHeader string
+		expectedValue  string
+		err            error
+	}{
+		{
+			name:   "The API key is used by default",
+			config: map[string]string{"default.core_key": "apiKey"},
+		},
+		{
+			name:   "The api-key authentication returns no authenticator",
+			config: map[string]string{"default.core_auth": "api-key", "default.core_key": "apiKey"},
+		},
+		{
+			name:           "Bearer authentication",
+			config:         map[string]string{"default.core_auth": "Bearer", "default.core_token": "my-token"},
+			expectedHeader: "Authorization",
+			expectedValue:  "Bearer my-token",
+		},
+		{
+			name:           "Basic authentication",
+			config:         map[string]string{"default.core_auth": "basic", "default.core_username": "user", "default.core_password": "pass"},
+			expectedHeader: "Authorization",
+			expectedValue:  "Basic dXNlcjpwYXNz",
+		},
+		{
+			name:   "Bearer authentication without a token",
+			config: map[string]string{"default.core_auth": "bearer"},
+			err:    cli.NewError(cli.ErrorExitCode, "The bearer authentication of core requires the core_token property in the \"default\" profile"),
+		},
+		{
+			name:   "Basic authentication without a username",
+			config: map[string]string{"default.core_auth": "basic", "default.core_password": "pass"},
+			err:    cli.NewError(cli.ErrorExitCode, "The basic authentication of core requires the core_username property in the \"default\" profile"),
+		},
+		{
+			name:   "OAuth2 authentication without a client secret",
+			config: map[string]string{"default.core_auth": "oauth2", "default.core_token_url": "http://localhost/token", "default.core_client_id": "id"},
+			err:    cli.NewError(cli.ErrorExitCode, "The oauth2 authentication of core requires the core_client_secret property in the \"default\" profile"),
+		},
+		{
+			name:   "Invalid authentication",
+			config: map[string]string{"default.core_auth": "kerberos"},
+			err:    cli.NewError(cli.ErrorExitCode, "Invalid authentication \"kerberos\" for core. The authentication must be api-key, bearer, basic or oauth2"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			auth, err := RequestAuthenticator(vpr, "default", "core")
+			if tc.err != nil {
+				assert.EqualError(t, err, tc.err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.expectedHeader == "" {
+				assert.Nil(t, auth)
+				return
+			}
+
+			header := http.Header{}
+			require.NoError(t, auth.Authenticate(context.Background(), header))
+			assert.Equal(t, tc.expectedValue, header.Get(tc.expectedHeader))
+		})
+	}
+}
+
+// TestRequestAuthenticator_OAuth2 tests that the OAuth2 authenticator is configured with the properties of the profile.
+func TestRequestAuthenticator_OAuth2(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		clientId, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "my-client", clientId)
+		assert.Equal(t, "my-secret", clientSecret)
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "read write", r.PostForm.Get("scope"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"oauth-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	vpr := viper.New()
+	vpr.Set("default.queryflow_auth", "oauth2")
+	vpr.Set("default.queryflow_token_url", srv.URL)
+	vpr.Set("default.queryflow_client_id", "my-client")
+	vpr.Set("default.queryflow_client_secret", "my-secret")
+	vpr.Set("default.queryflow_scopes", "read, write")
+
+	auth, err := RequestAuthenticator(vpr, "default", "queryflow")
+	require.NoError(t, err)
+
+	header := http.Header{}
+	require.NoError(t, auth.Authenticate(context.Background(), header))
+	assert.Equal(t, "Bearer oauth-token", header.Get("Authorization"))
+}
+
+// TestRequestTransportConfig tests the RequestTransportConfig() function.
+func TestRequestTransportConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected discoveryPackage.TransportConfig
+		err      error
+	}{
+		{
+			name:     "No TLS or proxy settings",
+			config:   map[string]string{"default.core_url": "http://localhost:12010"},
+			expected: discoveryPackage.TransportConfig{},
+		},
+		{
+			name: "Every setting is set",
+			config: map[string]string{
+				"default.ca_cert":              "/certs/ca.pem",
+				"default.client_cert":          "/certs/client.pem",
+				"default.client_key":           "/certs/client-key.pem",
+				"default.insecure_skip_verify": "true",
+				"default.proxy_url":            " http://proxy.example.com:3128 ",
+			},
+			expected: discoveryPackage.TransportConfig{
+				CAFile:             "/certs/ca.pem",
+				CertFile:           "/certs/client.pem",
+				KeyFile:            "/certs/client-key.pem",
+				InsecureSkipVerify: true,
+				ProxyURL:           "http://proxy.example.com:3128",
+			},
+		},
+		{
+			name:   "Invalid insecure_skip_verify",
+			config: map[string]string{"default.insecure_skip_verify": "maybe"},
+			err:    cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("strconv.ParseBool: parsing \"maybe\": invalid syntax"), "Invalid value \"maybe\" for insecure_skip_verify. It must be true or false"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			config, err := RequestTransportConfig(vpr, "default")
+			if tc.err != nil {
+				assert.EqualError(t, err, tc.err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, config)
+		})
+	}
+}
+
+// TestRequestTransport tests that the RequestTransport() function creates a transport only when the profile has TLS or proxy settings
+// and that the same transport is returned for the same settings.
+func TestRequestTransport(t *testing.T) {
+	vpr := viper.New()
+	transport, err := RequestTransport(vpr, "default")
+	require.NoError(t, err)
+	assert.Nil(t, transport)
+
+	vpr.Set("default.proxy_url", "http://proxy.example.com:3128")
+	transport, err = RequestTransport(vpr, "default")
+	require.NoError(t, err)
+	require.NotNil(t, transport)
+
+	vpr.Set("dev.proxy_url", "http://proxy.example.com:3128")
+	other, err := RequestTransport(vpr, "dev")
+	require.NoError(t, err)
+	assert.Same(t, transport, other)
+
+	vpr.Set("dev.ca_cert", "doesnotexist.pem")
+	_, err = RequestTransport(vpr, "dev")
+	var cliErr cli.Error
+	require.ErrorAs(t, err, &cliErr)
+	assert.ErrorContains(t, err, "Invalid TLS or proxy configuration in the \"dev\" profile")
+	assert.ErrorContains(t, err, "could not read the CA certificates")
+}
+
+// TestClientOptions tests the ClientOptions() function.
+func TestClientOptions(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          map[string]string
+		expectedOptions int
+		err             error
+	}{
+		{
+			name:            "Only the context and the retry policy are set",
+			config:          map[string]string{},
+			expectedOptions: 2,
+		},
+		{
+			name: "The context, the timeout and the retry policy are set",
+			config: map[string]string{
+				"default.timeout": "30s",
+			},
+			expectedOptions: 3,
+		},
+		{
+			name: "The context, the retry policy and the concurrency are set",
+			config: map[string]string{
+				"default.concurrency": "4",
+			},
+			expectedOptions: 3,
+		},
+		{
+			name: "The context, the retry policy and the rate limit are set",
+			config: map[string]string{
+				"default.rate_limit": "10",
+			},
+			expectedOptions: 3,
+		},
+		{
+			name: "A rate limit of zero is not set",
+			config: map[string]string{
+				"rate_limit": "0",
+			},
+			expectedOptions: 2,
+		},
+		{
+			name: "The context, the retry policy and the tracer are set",
+			config: map[string]string{
+				"verbose": "true",
+			},
+			expectedOptions: 3,
+		},
+		{
+			name: "The context, the retry policy and the authenticator are set",
+			config: map[string]string{
+				"default.core_auth":  "bearer",
+				"default.core_token": "token",
+			},
+			expectedOptions: 3,
+		},
+		{
+			name: "The authenticator of another component is not set",
+			config: map[string]string{
+				"default.ingestion_auth":  "bearer",
+				"default.ingestion_token": "token",
+			},
+			expectedOptions: 2,
+		},
+		{
+			name: "The timeout is invalid",
+			config: map[string]string{
+				"default.timeout": "-1s",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid timeout \"-1s\". The timeout cannot be negative"),
+		},
+		{
+			name: "The number of retries is invalid",
+			config: map[string]string{
+				"default.retries": "x",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid number of retries \"x\". The number of retries must be a non-negative integer"),
+		},
+		{
+			name: "The concurrency is invalid",
+			config: map[string]string{
+				"default.concurrency": "-2",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid concurrency \"-2\". The concurrency must be a positive integer"),
+		},
+		{
+			name: "The rate limit is invalid",
+			config: map[string]string{
+				"default.rate_limit": "none",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Invalid rate limit \"none\". The rate limit must be a non-negative number of requests per second"),
+		},
+		{
+			name: "The proxy is invalid",
+			config: map[string]string{
+				"default.proxy_url": "proxy.example.com",
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid proxy URL \"proxy.example.com\""), "Invalid TLS or proxy configuration in the \"default\" profile"),
+		},
+		{
+			name: "The authentication of the component is invalid",
+			config: map[string]string{
+				"default.core_auth": "oauth2",
+			},
+			err: cli.NewError(cli.ErrorExitCode, "The oauth2 authentication of core requires the core_token_url property in the \"default\" profile"),
+		},
+		{
+			name: "The authentication of another component is not validated",
+			config: map[string]string{
+				"default.staging_auth": "oauth2",
+			},
+			expectedOptions: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			ios := iostreams.IOStreams{
+				In:  strings.NewReader(""),
+				Out: &bytes.Buffer{},
+				Err: &bytes.Buffer{},
+			}
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+			cmd := &cobra.Command{}
+			cmd.SetContext(context.Background())
+
+			options, err := ClientOptions(cmd, d, "default", "core")
+			if tc.err != nil {
+				assert.EqualError(t, err, tc.err.Error())
+				assert.Nil(t, options)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, options, tc.expectedOptions)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// LongClone is the message used in the Long field of the Clone commands.
+	LongClone string = "clone is the command used to copy Discovery %[2]s's %[1]ss. The user must send the name or UUID of the %[1]s that will be cloned. The name of the copy can be set with the --name flag, or Discovery chooses one. The --deep flag also clones the entities that are referenced by the %[1]s, and the --labels flag adds labels to the copy. The command prints the new %[1]s."
+)
+
+// AddCloneFlags adds the --name, --deep and --labels flags to the given clone command.
+// The --uri flag is only added to the commands of the entities that are exposed through a URI.
+func AddCloneFlags(cmd *cobra.Command, config *cli.CloneConfig, uri bool) {
+	cmd.Flags().StringVar(&config.Name, "name", "", "the name of the copy. If it is not set, Discovery chooses the name")
+	if uri {
+		cmd.Flags().StringVar(&config.URI, "uri", "", "the URI of the copy, which is used to invoke it")
+	}
+	cmd.Flags().BoolVar(&config.Deep, "deep", false, "also clone the entities that are referenced by the entity")
+	cmd.Flags().StringArrayVar(&config.Labels, "labels", []string{}, "add a label in the format {key}[:{value}] to the copy. A label replaces the label of the copy with the same key. The flag can be repeated to add several labels")
+}
+
+// SearchCloneCommand is the function that executes the clone operation for the clone commands, which work with names and UUIDs.
+func SearchCloneCommand(id string, d cli.Discovery, client cli.SearchCloner, config commandConfig, cloneConfig cli.CloneConfig) error {
+	err := CheckCredentials(d, config.profile, config.componentName, config.url)
+	if err != nil {
+		return err
+	}
+
+	printer := cli.GetObjectPrinter(config.output)
+	return d.SearchCloneEntity(client, id, cloneConfig, printer)
+}
@@ -0,0 +1,311 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/pureinsights/discovery-cli/internal/testutils/mocks"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestAddCloneFlags tests that the AddCloneFlags() function only adds the --uri flag when it is requested.
+func TestAddCloneFlags(t *testing.T) {
+	var config cli.CloneConfig
+	cmd := &cobra.Command{}
+	AddCloneFlags(cmd, &config, false)
+	assert.Nil(t, cmd.Flags().Lookup("uri"))
+
+	cmd = &cobra.Command{}
+	AddCloneFlags(cmd, &config, true)
+	require.NoError(t, cmd.Flags().Parse([]string{"--name", "my-copy", "--uri", "/my-copy", "--deep", "--labels", "A:B", "--labels", "C"}))
+	assert.Equal(t, cli.CloneConfig{Name: "my-copy", URI: "/my-copy", Deep: true, Labels: []string{"A:B", "C"}}, config)
+}
+
+// TestSearchCloneCommand tests the SearchCloneCommand() function.
+func TestSearchCloneCommand(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         cli.SearchCloner
+		args           string
+		url            string
+		apiKey         string
+		componentName  string
+		cloneConfig    cli.CloneConfig
+		expectedOutput string
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working case
+		{
+			name:           "SearchCloneEntity correctly prints the copy",
+			url:            "http://localhost:12010/v2",
+			apiKey:         "core123",
+			componentName:  "Core",
+			args:           "MongoDB Atlas Server",
+			client:         new(mocks.WorkingSearchCloner),
+			cloneConfig:    cli.CloneConfig{Name: "my-copy", Labels: []string{"C:D"}},
+			expectedOutput: "{\"id\":\"c77caced-b1d4-49de-b690-09bac3bc80a7\",\"labels\":[{\"key\":\"A\",\"value\":\"A\"},{\"key\":\"B\"},{\"key\":\"C\",\"value\":\"D\"}],\"name\":\"my-copy\",\"type\":\"mongo\"}\n",
+			err:            nil,
+		},
+
+		// Error case
+		{
+			name:          "CheckCredentials fails",
+			client:        new(mocks.WorkingSearchCloner),
+			url:           "",
+			apiKey:        "core123",
+			componentName: "Core",
+			args:          "MongoDB Atlas Server",
+			err:           cli.NewError(cli.ErrorExitCode, "The Discovery Core URL is missing for profile \"default\".\nTo set the URL for the Discovery Core API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery core config --profile \"default\""),
+		},
+		{
+			name:          "SearchCloneEntity returns 409 Conflict",
+			client:        new(mocks.FailingSearchClonerCloneFails),
+			url:           "http://localhost:12010/v2",
+			apiKey:        "core123",
+			componentName: "Core",
+			args:          "MongoDB Atlas Server",
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusConflict, Body: gjson.Parse(`{
+	"status": 409,
+	"code": 4002,
+	"messages": [
+		"Duplicated entity: MongoDB Atlas server clone"
+	]
+}`)}, "Could not clone entity with id \"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\""),
+		},
+		{
+			name:          "Printing JSON fails",
+			client:        new(mocks.WorkingSearchCloner),
+			url:           "http://localhost:12010/v2",
+			apiKey:        "core123",
+			componentName: "Core",
+			args:          "MongoDB Atlas Server",
+			outWriter:     testutils.ErrWriter{Err: errors.New("write failed")},
+			err:           cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("write failed"), "Could not print JSON object"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: os.Stderr,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "json")
+			if tc.url != "" {
+				vpr.Set("default.core_url", tc.url)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.core_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, "")
+			err := SearchCloneCommand(tc.args, d, tc.client, GetCommandConfig("default", "json", tc.componentName, "core_url"), tc.cloneConfig)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// TestSearchCloneCommand_Requests tests the requests that SearchCloneCommand() sends to Discovery to clone an entity.
+// The clone commands of every entity share this logic, so their own tests only check how they wire their flags and clients.
+func TestSearchCloneCommand_Requests(t *testing.T) {
+	searchResponse := testutils.MockResponse{
+		StatusCode: http.StatusOK,
+		Body: `{
+	"content": [
+		{
+			"source": {
+				"type": "default",
+				"name": "my-endpoint",
+				"labels": [{"key": "A", "value": "A"}],
+				"active": true,
+				"id": "ea02fc14-f07b-49f2-b185-e9ceaedcb367"
+			},
+			"highlight": {},
+			"score": 1.4854797
+		}
+	],
+	"totalSize": 1,
+	"totalPages": 1,
+	"empty": false,
+	"size": 25,
+	"pageNumber": 0,
+	"numberOfElements": 1
+}`,
+		ContentType: "application/json",
+	}
+	getResponse := testutils.MockResponse{
+		StatusCode:  http.StatusOK,
+		Body:        `{"type": "default", "name": "my-endpoint", "labels": [{"key": "A", "value": "A"}], "active": true, "id": "ea02fc14-f07b-49f2-b185-e9ceaedcb367"}`,
+		ContentType: "application/json",
+	}
+
+	tests := []struct {
+		name           string
+		args           string
+		cloneConfig    cli.CloneConfig
+		responses      map[string]testutils.MockResponse
+		expectedOutput string
+		err            error
+	}{
+		// Working case
+		{
+			name:        "The copy is cloned with the flags as parameters",
+			args:        "my-endpoint",
+			cloneConfig: cli.CloneConfig{Name: "my-endpoint-copy", URI: "/my-endpoint-copy", Deep: true},
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/entrypoint/endpoint/search":                              searchResponse,
+				"GET:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367": getResponse,
+				"POST:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367/clone": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"type": "default", "name": "my-endpoint-copy", "labels": [{"key": "A", "value": "A"}], "active": true, "id": "c77caced-b1d4-49de-b690-09bac3bc80a7"}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "my-endpoint-copy", r.URL.Query().Get("name"))
+						assert.Equal(t, "/my-endpoint-copy", r.URL.Query().Get("uri"))
+						assert.Equal(t, "true", r.URL.Query().Get("deep"))
+					},
+				},
+			},
+			expectedOutput: "{\"active\":true,\"id\":\"c77caced-b1d4-49de-b690-09bac3bc80a7\",\"labels\":[{\"key\":\"A\",\"value\":\"A\"}],\"name\":\"my-endpoint-copy\",\"type\":\"default\"}\n",
+		},
+		{
+			name:        "The labels are added to the copy with an update",
+			args:        "ea02fc14-f07b-49f2-b185-e9ceaedcb367",
+			cloneConfig: cli.CloneConfig{Labels: []string{"A:B", "C"}},
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367": getResponse,
+				"POST:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367/clone": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"type": "default", "name": "my-endpoint clone", "labels": [{"key": "A", "value": "A"}], "active": true, "id": "c77caced-b1d4-49de-b690-09bac3bc80a7"}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Empty(t, r.URL.Query())
+					},
+				},
+				"PUT:/v2/entrypoint/endpoint/c77caced-b1d4-49de-b690-09bac3bc80a7": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"type": "default", "name": "my-endpoint clone", "labels": [{"key": "A", "value": "B"}, {"key": "C"}], "active": true, "id": "c77caced-b1d4-49de-b690-09bac3bc80a7"}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, `[{"key":"A","value":"B"},{"key":"C"}]`, gjson.GetBytes(body, "labels").Raw)
+					},
+				},
+			},
+			expectedOutput: "{\"active\":true,\"id\":\"c77caced-b1d4-49de-b690-09bac3bc80a7\",\"labels\":[{\"key\":\"A\",\"value\":\"B\"},{\"key\":\"C\"}],\"name\":\"my-endpoint clone\",\"type\":\"default\"}\n",
+		},
+
+		// Error case
+		{
+			name: "The name does not exist",
+			args: "test",
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/entrypoint/endpoint/search": {
+					StatusCode:  http.StatusNoContent,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "test" does not exist"
+	]
+}`),
+			}, "Could not search for entity with name \"test\""),
+		},
+		{
+			name:        "The label does not have a key",
+			args:        "my-endpoint",
+			cloneConfig: cli.CloneConfig{Labels: []string{":B"}},
+			responses:   map[string]testutils.MockResponse{},
+			err:         cli.NewError(cli.ErrorExitCode, "Label \":B\" does not follow the format {key}[:{value}]"),
+		},
+		{
+			name: "The clone request returns 409 Conflict",
+			args: "my-endpoint",
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/entrypoint/endpoint/search":                              searchResponse,
+				"GET:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367": getResponse,
+				"POST:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367/clone": {
+					StatusCode:  http.StatusConflict,
+					Body:        `{"status": 409, "code": 4002, "messages": ["Duplicated entity: my-endpoint clone"]}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusConflict, Body: gjson.Parse(`{"status": 409, "code": 4002, "messages": ["Duplicated entity: my-endpoint clone"]}`)}, "Could not clone entity with id \"ea02fc14-f07b-49f2-b185-e9ceaedcb367\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+			defer srv.Close()
+
+			buf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: buf,
+				Err: os.Stderr,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "json")
+			vpr.Set("default.queryflow_url", srv.URL)
+			vpr.Set("default.queryflow_key", "queryflow123")
+
+			d := cli.NewDiscovery(&ios, vpr, "")
+			client := discoveryPackage.NewQueryFlow(srv.URL, "queryflow123").Endpoints()
+			err := SearchCloneCommand(tc.args, d, client, GetCommandConfig("default", "json", "QueryFlow", "queryflow_url"), tc.cloneConfig)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
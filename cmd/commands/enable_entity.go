@@ -0,0 +1,75 @@
+package commands
+
+import (
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// LongEnable is the message used in the Long field of the Enable commands.
+	LongEnable string = "enable is the command used to enable Discovery %[2]s's %[1]ss. The user can send the names or UUIDs of the %[1]ss that will be enabled, and choose more of them with filter expressions in the --filter flag, like --filter 'label=env:prod', or with filters written in Discovery's DSL in the --filter-json flag. The command prints the result of each %[1]s and fails if any of them could not be enabled."
+	// LongDisable is the message used in the Long field of the Disable commands.
+	LongDisable string = "disable is the command used to disable Discovery %[2]s's %[1]ss. The user can send the names or UUIDs of the %[1]ss that will be disabled, and choose more of them with filter expressions in the --filter flag, like --filter 'label=env:prod', or with filters written in Discovery's DSL in the --filter-json flag. The command prints the result of each %[1]s and fails if any of them could not be disabled."
+)
+
+// EnableFlags contains the values of the flags that choose the entities of the enable and disable commands.
+type EnableFlags struct {
+	Filters    []string
+	FilterJSON string
+}
+
+// AddEnableFlags adds the --filter and --filter-json flags to the given enable or disable command.
+func AddEnableFlags(cmd *cobra.Command, flags *EnableFlags) {
+	cmd.Flags().StringArrayVarP(&flags.Filters, "filter", "f", []string{}, `choose the entities with filter expressions, like the ones of the get command. The filters are combined through the "and" operator when the flag is repeated`)
+	cmd.Flags().StringVar(&flags.FilterJSON, "filter-json", "", "choose the entities with a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
+}
+
+// filter builds the filter of the flags. If no filter was set, the result does not exist.
+func (f EnableFlags) filter() (gjson.Result, error) {
+	if len(f.Filters) == 0 && f.FilterJSON == "" {
+		return gjson.Result{}, nil
+	}
+
+	filter, err := cli.BuildEntitiesFilter(f.Filters)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+
+	if f.FilterJSON != "" {
+		jsonFilter, err := cli.ParseFilterJSON(f.FilterJSON)
+		if err != nil {
+			return gjson.Result{}, err
+		}
+		filter = discoveryPackage.And(filter, jsonFilter)
+	}
+	return filter, nil
+}
+
+// SearchEnableCommand is the function that executes the enable and disable commands, which work with names, UUIDs and filters.
+func SearchEnableCommand(args []string, d cli.Discovery, client cli.SearchEnabler, config commandConfig, flags EnableFlags, enable bool) error {
+	err := CheckCredentials(d, config.profile, config.componentName, config.url)
+	if err != nil {
+		return err
+	}
+
+	filter, err := flags.filter()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 && !filter.Exists() {
+		action := "disable"
+		if enable {
+			action = "enable"
+		}
+		return cli.NewError(cli.ErrorExitCode, "There are no entities to %s. Send their names or UUIDs, or choose them with the --filter or --filter-json flags", action)
+	}
+
+	output := config.output
+	if output == prettyJson {
+		output = "json"
+	}
+	return d.SearchEnableEntities(client, args, filter, enable, cli.GetArrayPrinter(output))
+}
@@ -0,0 +1,307 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/pureinsights/discovery-cli/internal/testutils/mocks"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestSearchEnableCommand tests the SearchEnableCommand() function.
+func TestSearchEnableCommand(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         cli.SearchEnabler
+		args           []string
+		url            string
+		apiKey         string
+		flags          EnableFlags
+		enable         bool
+		expectedOutput string
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working case
+		{
+			name:           "SearchEnableCommand enables the entity of a name",
+			client:         new(mocks.WorkingSearchEnabler),
+			url:            "http://localhost:12040/v2",
+			apiKey:         "queryflow123",
+			args:           []string{"MongoDB Atlas server"},
+			enable:         true,
+			expectedOutput: "{\"acknowledged\":true,\"id\":\"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\"name\":\"MongoDB Atlas server\"}\n",
+			err:            nil,
+		},
+		{
+			name:           "SearchEnableCommand disables the entities of the filters",
+			client:         new(mocks.WorkingSearchEnabler),
+			url:            "http://localhost:12040/v2",
+			apiKey:         "queryflow123",
+			flags:          EnableFlags{Filters: []string{"type=mongo"}, FilterJSON: `{"exists":{"field":"labels"}}`},
+			enable:         false,
+			expectedOutput: "{\"acknowledged\":true,\"id\":\"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\"name\":\"MongoDB Atlas server\"}\n{\"acknowledged\":true,\"id\":\"8f14c11c-bb66-49d3-aa2a-dedff4608c17\",\"name\":\"MongoDB Atlas server clone 1\"}\n",
+			err:            nil,
+		},
+
+		// Error case
+		{
+			name:   "CheckCredentials fails",
+			client: new(mocks.WorkingSearchEnabler),
+			url:    "",
+			apiKey: "queryflow123",
+			args:   []string{"MongoDB Atlas server"},
+			enable: true,
+			err:    cli.NewError(cli.ErrorExitCode, "The Discovery QueryFlow URL is missing for profile \"default\".\nTo set the URL for the Discovery QueryFlow API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery queryflow config --profile \"default\""),
+		},
+		{
+			name:   "No names or filters",
+			client: new(mocks.WorkingSearchEnabler),
+			url:    "http://localhost:12040/v2",
+			apiKey: "queryflow123",
+			enable: false,
+			err:    cli.NewError(cli.ErrorExitCode, "There are no entities to disable. Send their names or UUIDs, or choose them with the --filter or --filter-json flags"),
+		},
+		{
+			name:   "Invalid filter",
+			client: new(mocks.WorkingSearchEnabler),
+			url:    "http://localhost:12040/v2",
+			apiKey: "queryflow123",
+			flags:  EnableFlags{Filters: []string{"color=blue"}},
+			enable: true,
+			err:    cli.NewError(cli.ErrorExitCode, "Filter type \"color\" does not exist"),
+		},
+		{
+			name:   "Invalid filter JSON",
+			client: new(mocks.WorkingSearchEnabler),
+			url:    "http://localhost:12040/v2",
+			apiKey: "queryflow123",
+			flags:  EnableFlags{FilterJSON: `{"exists":`},
+			enable: true,
+			err:    cli.NewError(cli.ErrorExitCode, "The filter JSON \"{\\\"exists\\\":\" is not a valid JSON object"),
+		},
+		{
+			name:           "One of the entities cannot be disabled",
+			client:         new(mocks.FailingSearchEnablerToggleFails),
+			url:            "http://localhost:12040/v2",
+			apiKey:         "queryflow123",
+			flags:          EnableFlags{Filters: []string{"type=mongo"}},
+			enable:         false,
+			expectedOutput: "{\"acknowledged\":true,\"id\":\"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\"name\":\"MongoDB Atlas server\"}\n{\"error\":{\"code\":1003,\"messages\":[\"Entity not found: 8f14c11c-bb66-49d3-aa2a-dedff4608c17\"],\"status\":404},\"id\":\"8f14c11c-bb66-49d3-aa2a-dedff4608c17\",\"name\":\"MongoDB Atlas server clone 1\"}\n",
+			err:            cli.NewError(cli.ErrorExitCode, "Could not disable 1 of the 2 entities"),
+		},
+		{
+			name:   "The search of the filters fails",
+			client: &mocks.WorkingSearchEnabler{Err: discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status": 500, "code": 5000, "messages": ["Internal server error"]}`)}},
+			url:    "http://localhost:12040/v2",
+			apiKey: "queryflow123",
+			flags:  EnableFlags{Filters: []string{"type=mongo"}},
+			enable: true,
+			err:    cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status": 500, "code": 5000, "messages": ["Internal server error"]}`)}, "Could not search for the entities to enable"),
+		},
+		{
+			name:      "Printing JSON fails",
+			client:    new(mocks.WorkingSearchEnabler),
+			url:       "http://localhost:12040/v2",
+			apiKey:    "queryflow123",
+			args:      []string{"MongoDB Atlas server"},
+			enable:    true,
+			outWriter: testutils.ErrWriter{Err: errors.New("write failed")},
+			err:       cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("write failed"), "Could not print JSON Array"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: os.Stderr,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "pretty-json")
+			if tc.url != "" {
+				vpr.Set("default.queryflow_url", tc.url)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.queryflow_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, "")
+			err := SearchEnableCommand(tc.args, d, tc.client, GetCommandConfig("default", "pretty-json", "QueryFlow", "queryflow_url"), tc.flags, tc.enable)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expectedOutput != "" {
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// TestAddEnableFlags tests that the AddEnableFlags() function adds the --filter and --filter-json flags.
+func TestAddEnableFlags(t *testing.T) {
+	var flags EnableFlags
+	cmd := &cobra.Command{}
+	AddEnableFlags(cmd, &flags)
+	require.NoError(t, cmd.Flags().Parse([]string{"-f", "label=env", "--filter", "type=default", "--filter-json", `{"exists":{"field":"labels"}}`}))
+	assert.Equal(t, EnableFlags{Filters: []string{"label=env", "type=default"}, FilterJSON: `{"exists":{"field":"labels"}}`}, flags)
+}
+
+// TestSearchEnableCommand_Requests tests the requests that SearchEnableCommand() sends to Discovery to enable and disable entities.
+// The enable and disable commands of every entity share this logic, so their own tests only check how they wire their flags and clients.
+func TestSearchEnableCommand_Requests(t *testing.T) {
+	searchResponse := testutils.MockResponse{
+		StatusCode: http.StatusOK,
+		Body: `{
+	"content": [
+		{
+			"source": {"type": "default", "name": "my-endpoint", "labels": [{"key": "env"}], "active": true, "id": "ea02fc14-f07b-49f2-b185-e9ceaedcb367"},
+			"highlight": {},
+			"score": 1.4854797
+		},
+		{
+			"source": {"type": "default", "name": "my-endpoint-2", "labels": [{"key": "env"}], "active": true, "id": "5d0e0a3c-7a4b-4d6b-9a34-0f8a3c1e2b77"},
+			"highlight": {},
+			"score": 1.4854797
+		}
+	],
+	"totalSize": 2,
+	"totalPages": 1,
+	"empty": false,
+	"size": 25,
+	"pageNumber": 0,
+	"numberOfElements": 2
+}`,
+		ContentType: "application/json",
+		Assertions: func(t *testing.T, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"equals":{"field":"labels.key","value":"env"}}`, string(body))
+		},
+	}
+	getResponse := testutils.MockResponse{
+		StatusCode:  http.StatusOK,
+		Body:        `{"type": "default", "name": "my-endpoint", "labels": [{"key": "env"}], "active": true, "id": "ea02fc14-f07b-49f2-b185-e9ceaedcb367"}`,
+		ContentType: "application/json",
+	}
+	acknowledged := testutils.MockResponse{
+		StatusCode:  http.StatusOK,
+		Body:        `{"acknowledged": true}`,
+		ContentType: "application/json",
+	}
+	notFound := testutils.MockResponse{
+		StatusCode:  http.StatusNotFound,
+		Body:        `{"status": 404, "code": 1003, "messages": ["Entity not found: 5d0e0a3c-7a4b-4d6b-9a34-0f8a3c1e2b77"]}`,
+		ContentType: "application/json",
+	}
+
+	tests := []struct {
+		name           string
+		args           []string
+		flags          EnableFlags
+		enable         bool
+		responses      map[string]testutils.MockResponse
+		expectedOutput string
+		err            error
+	}{
+		// Working case
+		{
+			name:   "Every entity of the names and the filter is enabled once",
+			args:   []string{"ea02fc14-f07b-49f2-b185-e9ceaedcb367"},
+			flags:  EnableFlags{Filters: []string{"label=env"}},
+			enable: true,
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367":          getResponse,
+				"POST:/v2/entrypoint/endpoint/search":                                       searchResponse,
+				"PATCH:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367/enable": acknowledged,
+				"PATCH:/v2/entrypoint/endpoint/5d0e0a3c-7a4b-4d6b-9a34-0f8a3c1e2b77/enable": acknowledged,
+			},
+			expectedOutput: "{\"acknowledged\":true,\"id\":\"ea02fc14-f07b-49f2-b185-e9ceaedcb367\",\"name\":\"my-endpoint\"}\n{\"acknowledged\":true,\"id\":\"5d0e0a3c-7a4b-4d6b-9a34-0f8a3c1e2b77\",\"name\":\"my-endpoint-2\"}\n",
+		},
+
+		// Error case
+		{
+			name:   "One of the entities cannot be disabled",
+			flags:  EnableFlags{Filters: []string{"label=env"}},
+			enable: false,
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/entrypoint/endpoint/search":                                        searchResponse,
+				"PATCH:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367/disable": acknowledged,
+				"PATCH:/v2/entrypoint/endpoint/5d0e0a3c-7a4b-4d6b-9a34-0f8a3c1e2b77/disable": notFound,
+			},
+			expectedOutput: "{\"acknowledged\":true,\"id\":\"ea02fc14-f07b-49f2-b185-e9ceaedcb367\",\"name\":\"my-endpoint\"}\n{\"error\":{\"code\":1003,\"messages\":[\"Entity not found: 5d0e0a3c-7a4b-4d6b-9a34-0f8a3c1e2b77\"],\"status\":404},\"id\":\"5d0e0a3c-7a4b-4d6b-9a34-0f8a3c1e2b77\",\"name\":\"my-endpoint-2\"}\n",
+			err:            cli.NewError(cli.ErrorExitCode, "Could not disable 1 of the 2 entities"),
+		},
+		{
+			name:      "No names or filters",
+			enable:    true,
+			responses: map[string]testutils.MockResponse{},
+			err:       cli.NewError(cli.ErrorExitCode, "There are no entities to enable. Send their names or UUIDs, or choose them with the --filter or --filter-json flags"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+			defer srv.Close()
+
+			buf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: buf,
+				Err: os.Stderr,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "json")
+			vpr.Set("default.queryflow_url", srv.URL)
+			vpr.Set("default.queryflow_key", "queryflow123")
+
+			d := cli.NewDiscovery(&ios, vpr, "")
+			client := discoveryPackage.NewQueryFlow(srv.URL, "queryflow123").Endpoints()
+			err := SearchEnableCommand(tc.args, d, client, GetCommandConfig("default", "json", "QueryFlow", "queryflow_url"), tc.flags, tc.enable)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedOutput, buf.String())
+		})
+	}
+}
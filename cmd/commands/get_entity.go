@@ -1,21 +1,78 @@
 package commands
 
 import (
+	"strings"
+
 	"github.com/google/uuid"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
 )
 
 const (
 	// LongGetNoNames is the message used in the Long field of the Get commands that do not support getting by name or using filters.
 	LongGetNoNames string = "get is the command used to obtain Discovery %[2]s's %[1]ss. The user can send a UUID to get a specific %[1]s. If no UUID is given, then the command retrieves every %[1]s. The optional argument must be a UUID. This command does not support filters or referencing an entity by name."
 	// LongGetSearch is the message used in the Long field of the Get commands that do support getting by name or using filters.
-	LongGetSearch string = "get is the command used to obtain Discovery %[2]s's %[1]ss. The user can send a name or UUID to get a specific %[1]s. If no argument is given, then the command retrieves every %[1]s. The command also supports filters with the flag --filter followed by the filter in the format filter=key:value."
+	LongGetSearch string = "get is the command used to obtain Discovery %[2]s's %[1]ss. The user can send a name or UUID to get a specific %[1]s. If no argument is given, then the command retrieves every %[1]s. The command also supports filter expressions with the flag --filter, like --filter 'type=mongo & name=my-*', and filters written in Discovery's DSL with the flag --filter-json."
 	// PrettyJson is used to avoid writing the "pretty-json" literal in the functions.
 	prettyJson string = "pretty-json"
 )
 
+// ListFlags contains the values of the flags that sort and limit the entities listed by the get commands.
+type ListFlags struct {
+	Sort     []string
+	PageSize int
+	Limit    int
+}
+
+// AddListFlags adds the --sort, --page-size and --limit flags to the given get command.
+func AddListFlags(cmd *cobra.Command, flags *ListFlags) {
+	cmd.Flags().StringArrayVar(&flags.Sort, "sort", []string{}, `sort the entities in the format "field[,asc|desc]". The direction is ascending by default. The flag can be repeated to sort by several fields`)
+	cmd.Flags().IntVar(&flags.PageSize, "page-size", 0, "the number of entities requested in each page. By default, it is the limit or Discovery's page size")
+	cmd.Flags().IntVar(&flags.Limit, "limit", 0, "the maximum number of entities that are listed. No more pages are requested once it is reached")
+}
+
+// ListConfig validates the flags and converts them to the configuration of the requests that list the entities.
+// If a limit is set without a page size, the size of the pages is the limit, so that the limited entities are obtained with a single request.
+func (f ListFlags) ListConfig() (cli.ListConfig, error) {
+	if f.PageSize < 0 {
+		return cli.ListConfig{}, cli.NewError(cli.ErrorExitCode, "Invalid page size %d. The page size must be a positive integer", f.PageSize)
+	}
+	if f.Limit < 0 {
+		return cli.ListConfig{}, cli.NewError(cli.ErrorExitCode, "Invalid limit %d. The limit must be a positive integer", f.Limit)
+	}
+
+	options := []discoveryPackage.RequestOption{}
+	for _, sort := range f.Sort {
+		field, direction, found := strings.Cut(sort, ",")
+		field = strings.TrimSpace(field)
+		direction = strings.ToLower(strings.TrimSpace(direction))
+		if !found {
+			direction = string(discoveryPackage.SortAscending)
+		}
+
+		switch {
+		case field == "":
+			return cli.ListConfig{}, cli.NewError(cli.ErrorExitCode, "Invalid sort %q. The field cannot be empty", sort)
+		case direction != string(discoveryPackage.SortAscending) && direction != string(discoveryPackage.SortDescending):
+			return cli.ListConfig{}, cli.NewError(cli.ErrorExitCode, "Invalid sort %q. The direction must be asc or desc", sort)
+		}
+		options = append(options, discoveryPackage.WithSort(field, discoveryPackage.SortDirection(direction)))
+	}
+
+	pageSize := f.PageSize
+	if pageSize == 0 {
+		pageSize = f.Limit
+	}
+	if pageSize > 0 {
+		options = append(options, discoveryPackage.WithPageSize(pageSize))
+	}
+
+	return cli.ListConfig{Options: options, Limit: f.Limit}, nil
+}
+
 // GetCommand is the function that executes the get operation for the get commands that do not work with names or filters.
-func GetCommand(args []string, d cli.Discovery, client cli.Getter, config commandConfig) error {
+func GetCommand(args []string, d cli.Discovery, client cli.Getter, config commandConfig, list ListFlags) error {
 	err := CheckCredentials(d, config.profile, config.componentName, config.url)
 	if err != nil {
 		return err
@@ -33,13 +90,18 @@ func GetCommand(args []string, d cli.Discovery, client cli.Getter, config comman
 		if output == prettyJson {
 			output = "json"
 		}
-		printer := cli.GetArrayPrinter(output)
-		return d.GetEntities(client, printer)
+		listConfig, err := list.ListConfig()
+		if err != nil {
+			return err
+		}
+		printer := cli.GetArrayStreamPrinter(output)
+		return d.GetEntities(client, listConfig, printer)
 	}
 }
 
 // SearchCommand is the function that the get command executes when it also allows for searching by name and with filters.
-func SearchCommand(args []string, d cli.Discovery, client cli.Searcher, config commandConfig, filters *[]string) error {
+// The filter expressions and the filter written in Discovery's DSL are combined through the "and" operator.
+func SearchCommand(args []string, d cli.Discovery, client cli.Searcher, config commandConfig, filters *[]string, filterJSON string, list ListFlags) error {
 	err := CheckCredentials(d, config.profile, config.componentName, config.url)
 	if err != nil {
 		return err
@@ -48,24 +110,40 @@ func SearchCommand(args []string, d cli.Discovery, client cli.Searcher, config c
 	if len(args) > 0 {
 		printer := cli.GetObjectPrinter(config.output)
 		return d.SearchEntity(client, args[0], printer)
-	} else if len(*filters) > 0 {
+	} else if len(*filters) > 0 || filterJSON != "" {
 		output := config.output
 		if output == prettyJson {
 			output = "json"
 		}
-		printer := cli.GetArrayPrinter(output)
+		printer := cli.GetArrayStreamPrinter(output)
 		filter, err := cli.BuildEntitiesFilter(*filters)
 		if err != nil {
 			return err
 		}
 
-		return d.SearchEntities(client, filter, printer)
+		if filterJSON != "" {
+			jsonFilter, err := cli.ParseFilterJSON(filterJSON)
+			if err != nil {
+				return err
+			}
+			filter = discoveryPackage.And(filter, jsonFilter)
+		}
+
+		listConfig, err := list.ListConfig()
+		if err != nil {
+			return err
+		}
+		return d.SearchEntities(client, filter, listConfig, printer)
 	} else {
 		output := config.output
 		if output == prettyJson {
 			output = "json"
 		}
-		printer := cli.GetArrayPrinter(output)
-		return d.GetEntities(client, printer)
+		listConfig, err := list.ListConfig()
+		if err != nil {
+			return err
+		}
+		printer := cli.GetArrayStreamPrinter(output)
+		return d.GetEntities(client, listConfig, printer)
 	}
 }
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"testing"
 
+	"github.com/go-resty/resty/v2"
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/pureinsights/discovery-cli/internal/cli"
 	"github.com/pureinsights/discovery-cli/internal/iostreams"
@@ -25,6 +27,7 @@ func TestGetCommand(t *testing.T) {
 		name           string
 		client         cli.Getter
 		args           []string
+		list           ListFlags
 		url            string
 		apiKey         string
 		componentName  string
@@ -53,6 +56,17 @@ func TestGetCommand(t *testing.T) {
 			expectedOutput: "{\"active\":true,\"creationTimestamp\":\"2025-08-21T17:57:16Z\",\"id\":\"3393f6d9-94c1-4b70-ba02-5f582727d998\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-08-21T17:57:16Z\",\"name\":\"MongoDB text processor 4\",\"type\":\"mongo\"}\n{\"active\":true,\"creationTimestamp\":\"2025-08-14T18:02:38Z\",\"id\":\"5f125024-1e5e-4591-9fee-365dc20eeeed\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-08-18T20:55:43Z\",\"name\":\"MongoDB text processor\",\"type\":\"mongo\"}\n{\"active\":true,\"creationTimestamp\":\"2025-08-14T18:02:38Z\",\"id\":\"86e7f920-a4e4-4b64-be84-5437a7673db8\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-08-14T18:02:38Z\",\"name\":\"Script processor\",\"type\":\"script\"}\n",
 			err:            nil,
 		},
+		{
+			name:           "GetEntities prints only the entities within the limit",
+			client:         new(mocks.WorkingGetter),
+			url:            "http://localhost:12010",
+			apiKey:         "core123",
+			componentName:  "Core",
+			args:           []string{},
+			list:           ListFlags{Sort: []string{"name,desc"}, Limit: 1},
+			expectedOutput: "{\"active\":true,\"creationTimestamp\":\"2025-08-21T17:57:16Z\",\"id\":\"3393f6d9-94c1-4b70-ba02-5f582727d998\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-08-21T17:57:16Z\",\"name\":\"MongoDB text processor 4\",\"type\":\"mongo\"}\n",
+			err:            nil,
+		},
 
 		// Error case
 		{
@@ -95,6 +109,16 @@ func TestGetCommand(t *testing.T) {
 		}`),
 			}, "Could not get entity with id \"3d51beef-8b90-40aa-84b5-033241dc6239\""),
 		},
+		{
+			name:          "The limit is invalid",
+			client:        new(mocks.WorkingGetter),
+			url:           "http://localhost:12010",
+			apiKey:        "core123",
+			componentName: "Core",
+			args:          []string{},
+			list:          ListFlags{Limit: -1},
+			err:           cli.NewError(cli.ErrorExitCode, "Invalid limit -1. The limit must be a positive integer"),
+		},
 		{
 			name:           "GetAll returns 401 Unauthorized",
 			client:         new(mocks.FailingGetter),
@@ -154,7 +178,7 @@ func TestGetCommand(t *testing.T) {
 			}
 
 			d := cli.NewDiscovery(&ios, vpr, "")
-			err := GetCommand(tc.args, d, tc.client, GetCommandConfig("default", "pretty-json", tc.componentName, "core_url"))
+			err := GetCommand(tc.args, d, tc.client, GetCommandConfig("default", "pretty-json", tc.componentName, "core_url"), tc.list)
 
 			if tc.err != nil {
 				require.Error(t, err)
@@ -176,6 +200,8 @@ func TestSearchCommand(t *testing.T) {
 		client         cli.Searcher
 		args           []string
 		filters        []string
+		filterJSON     string
+		list           ListFlags
 		url            string
 		apiKey         string
 		componentName  string
@@ -225,6 +251,17 @@ func TestSearchCommand(t *testing.T) {
 			expectedOutput: "{\"highlight\":{},\"score\":0.20970252,\"source\":{\"active\":true,\"creationTimestamp\":\"2025-09-29T15:50:17Z\",\"id\":\"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-09-29T15:50:17Z\",\"name\":\"MongoDB Atlas server clone\",\"type\":\"mongo\"}}\n{\"highlight\":{},\"score\":0.20970252,\"source\":{\"active\":true,\"creationTimestamp\":\"2025-09-29T15:50:19Z\",\"id\":\"8f14c11c-bb66-49d3-aa2a-dedff4608c17\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-09-29T15:50:19Z\",\"name\":\"MongoDB Atlas server clone 1\",\"type\":\"mongo\"}}\n{\"highlight\":{},\"score\":0.20970252,\"source\":{\"active\":true,\"creationTimestamp\":\"2025-09-29T15:50:20Z\",\"id\":\"3a0214a4-72cc-4eee-ad0c-9e3af9b08a6c\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-09-29T15:50:20Z\",\"name\":\"MongoDB Atlas server clone 3\",\"type\":\"mongo\"}}\n",
 			err:            nil,
 		},
+		{
+			name:           "Get with a filter in the DSL returns a search array",
+			args:           []string{},
+			filterJSON:     `{"exists":{"field":"labels"}}`,
+			url:            "http://localhost:12010/v2",
+			apiKey:         "apiKey123",
+			componentName:  "Core",
+			client:         new(mocks.WorkingSearcher),
+			expectedOutput: "{\"highlight\":{},\"score\":0.20970252,\"source\":{\"active\":true,\"creationTimestamp\":\"2025-09-29T15:50:17Z\",\"id\":\"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-09-29T15:50:17Z\",\"name\":\"MongoDB Atlas server clone\",\"type\":\"mongo\"}}\n{\"highlight\":{},\"score\":0.20970252,\"source\":{\"active\":true,\"creationTimestamp\":\"2025-09-29T15:50:19Z\",\"id\":\"8f14c11c-bb66-49d3-aa2a-dedff4608c17\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-09-29T15:50:19Z\",\"name\":\"MongoDB Atlas server clone 1\",\"type\":\"mongo\"}}\n{\"highlight\":{},\"score\":0.20970252,\"source\":{\"active\":true,\"creationTimestamp\":\"2025-09-29T15:50:20Z\",\"id\":\"3a0214a4-72cc-4eee-ad0c-9e3af9b08a6c\",\"labels\":[],\"lastUpdatedTimestamp\":\"2025-09-29T15:50:20Z\",\"name\":\"MongoDB Atlas server clone 3\",\"type\":\"mongo\"}}\n",
+			err:            nil,
+		},
 
 		// Error case
 		{
@@ -300,6 +337,25 @@ func TestSearchCommand(t *testing.T) {
 			client:  new(mocks.WorkingSearcher),
 			err:     cli.NewError(cli.ErrorExitCode, "Filter type \"gte\" does not exist"),
 		},
+		{
+			name:       "The filter in the DSL is invalid",
+			args:       []string{},
+			filterJSON: `{"exists":`,
+			url:        "http://localhost:12010/v2",
+			apiKey:     "apiKey123",
+			client:     new(mocks.WorkingSearcher),
+			err:        cli.NewError(cli.ErrorExitCode, "The filter JSON \"{\\\"exists\\\":\" is not a valid JSON object"),
+		},
+		{
+			name:          "The sort of the search is invalid",
+			url:           "http://localhost:12010/v2",
+			apiKey:        "core123",
+			componentName: "Core",
+			filters:       []string{"label=A"},
+			list:          ListFlags{Sort: []string{"name,up"}},
+			client:        new(mocks.WorkingSearcher),
+			err:           cli.NewError(cli.ErrorExitCode, "Invalid sort \"name,up\". The direction must be asc or desc"),
+		},
 		{
 			name:          "Printing JSON fails",
 			client:        new(mocks.WorkingSearcher),
@@ -348,7 +404,7 @@ func TestSearchCommand(t *testing.T) {
 			}
 
 			d := cli.NewDiscovery(&ios, vpr, "")
-			err := SearchCommand(tc.args, d, tc.client, GetCommandConfig("default", "pretty-json", tc.componentName, "core_url"), &tc.filters)
+			err := SearchCommand(tc.args, d, tc.client, GetCommandConfig("default", "pretty-json", tc.componentName, "core_url"), &tc.filters, tc.filterJSON, tc.list)
 
 			if tc.err != nil {
 				require.Error(t, err)
@@ -362,3 +418,79 @@ func TestSearchCommand(t *testing.T) {
 		})
 	}
 }
+
+// TestListFlags_ListConfig tests the ListFlags.ListConfig() function.
+func TestListFlags_ListConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		flags         ListFlags
+		expectedQuery url.Values
+		expectedLimit int
+		err           error
+	}{
+		// Working cases
+		{
+			name:          "No flags",
+			flags:         ListFlags{},
+			expectedQuery: url.Values{},
+		},
+		{
+			name:          "Several sorts and a page size",
+			flags:         ListFlags{Sort: []string{"name", "creationTimestamp,DESC", " type , asc "}, PageSize: 50},
+			expectedQuery: url.Values{"sort": {"name,asc", "creationTimestamp,desc", "type,asc"}, "size": {"50"}},
+		},
+		{
+			name:          "The limit is the page size when it is not set",
+			flags:         ListFlags{Limit: 5},
+			expectedQuery: url.Values{"size": {"5"}},
+			expectedLimit: 5,
+		},
+		{
+			name:          "The page size is kept with a limit",
+			flags:         ListFlags{PageSize: 2, Limit: 5},
+			expectedQuery: url.Values{"size": {"2"}},
+			expectedLimit: 5,
+		},
+
+		// Error cases
+		{
+			name:  "Negative page size",
+			flags: ListFlags{PageSize: -1},
+			err:   cli.NewError(cli.ErrorExitCode, "Invalid page size -1. The page size must be a positive integer"),
+		},
+		{
+			name:  "Negative limit",
+			flags: ListFlags{Limit: -5},
+			err:   cli.NewError(cli.ErrorExitCode, "Invalid limit -5. The limit must be a positive integer"),
+		},
+		{
+			name:  "Sort without a field",
+			flags: ListFlags{Sort: []string{",desc"}},
+			err:   cli.NewError(cli.ErrorExitCode, "Invalid sort \",desc\". The field cannot be empty"),
+		},
+		{
+			name:  "Sort with an unknown direction",
+			flags: ListFlags{Sort: []string{"name,descending"}},
+			err:   cli.NewError(cli.ErrorExitCode, "Invalid sort \"name,descending\". The direction must be asc or desc"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := tc.flags.ListConfig()
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			request := resty.New().R()
+			for _, option := range config.Options {
+				require.NoError(t, option(request))
+			}
+			assert.Equal(t, tc.expectedQuery, request.QueryParam)
+			assert.Equal(t, tc.expectedLimit, config.Limit)
+		})
+	}
+}
@@ -24,7 +24,11 @@ func NewExportCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
 			return commands.ExportCommand(d, coreClient.BackupRestore(), file, commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"))
 		},
 		Args: cobra.NoArgs,
@@ -6,6 +6,7 @@ import (
 	"github.com/pureinsights/discovery-cli/cmd/core/credentials"
 	"github.com/pureinsights/discovery-cli/cmd/core/file"
 	"github.com/pureinsights/discovery-cli/cmd/core/labels"
+	"github.com/pureinsights/discovery-cli/cmd/core/maintenance"
 	"github.com/pureinsights/discovery-cli/cmd/core/secrets"
 	"github.com/pureinsights/discovery-cli/cmd/core/servers"
 	"github.com/pureinsights/discovery-cli/cmd/core/statuscheck"
@@ -29,6 +30,7 @@ func NewCoreCommand(d cli.Discovery) *cobra.Command {
 	core.AddCommand(backuprestore.NewExportCommand(d))
 	core.AddCommand(backuprestore.NewImportCommand(d))
 	core.AddCommand(statuscheck.NewStatusCommand(d))
+	core.AddCommand(maintenance.NewLogLevelCommand(d))
 
 	return core
 }
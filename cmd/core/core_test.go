@@ -50,6 +50,6 @@ func TestNewCoreCommand(t *testing.T) {
 		}
 	}
 
-	expectedCommands := []string{"config", "credential", "export", "file", "import", "label", "secret", "server", "status"}
+	expectedCommands := []string{"config", "credential", "export", "file", "import", "label", "log-level", "secret", "server", "status"}
 	assert.Equal(t, expectedCommands, commandNames)
 }
@@ -0,0 +1,47 @@
+package credentials
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the credential clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <credential>",
+		Short: "The command that clones credentials in Discovery Core.",
+		Long:  fmt.Sprintf(commands.LongClone, "credential", "Core"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
+			return commands.SearchCloneCommand(args[0], d, coreClient.Credentials(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Clone a credential by name
+	discovery core credential clone my-credential --name my-credential-copy
+
+	# Clone a credential by id and add labels to the copy
+	discovery core credential clone 9ababe08-0b74-4672-bb7c-e7a8227d6d4c --name my-credential-copy --labels env:dev --labels draft
+
+	# Clone a credential and the entities it references
+	discovery core credential clone my-credential --name my-credential-copy --deep`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, false)
+	return clone
+}
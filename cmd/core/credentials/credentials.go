@@ -14,6 +14,7 @@ func NewCredentialCommand(d cli.Discovery) *cobra.Command {
 
 	credential.AddCommand(NewGetCommand(d))
 	credential.AddCommand(NewStoreCommand(d))
+	credential.AddCommand(NewCloneCommand(d))
 	credential.AddCommand(NewDeleteCommand(d))
 
 	return credential
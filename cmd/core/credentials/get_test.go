@@ -68,7 +68,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-credential",
+					"name": "my-credential clone",
 					"labels": [
 					{
 						"key": "A",
@@ -322,6 +322,11 @@ func TestNewGetCommand(t *testing.T) {
 			url:       true,
 			apiKey:    "apiKey123",
 			responses: map[string]testutils.MockResponse{
+				"GET:/v2/credential/3b32e410-2f33-412d-9fb8-17970131921c": {
+					StatusCode:  http.StatusNotFound,
+					Body:        `{"status":404,"code":1003,"messages":["Entity not found: 3b32e410-2f33-412d-9fb8-17970131921c"]}`,
+					ContentType: "application/json",
+				},
 				"POST:/v2/credential/search": {
 					StatusCode: http.StatusInternalServerError,
 					Body: `{
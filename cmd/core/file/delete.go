@@ -26,7 +26,11 @@ func NewDeleteCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
 			printer := cli.GetObjectPrinter(vpr.GetString("output"))
 			return d.DeleteFile(coreClient.Files(), args[0], printer)
 		},
@@ -277,6 +277,7 @@ func TestNewDownloadCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 
 			if tc.url {
 				vpr.Set("default.core_url", srv.URL)
@@ -347,6 +348,7 @@ func TestNewDownloadCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 
 	vpr.Set("default.core_url", "test")
@@ -223,6 +223,7 @@ func TestNewGetCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 
 			if tc.url {
 				vpr.Set("default.core_url", srv.URL)
@@ -279,6 +280,7 @@ func TestNewGetCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 
 	vpr.Set("default.core_url", "test")
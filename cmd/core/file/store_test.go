@@ -331,6 +331,7 @@ func TestNewStoreCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 
 			if tc.url {
 				vpr.Set("default.core_url", srv.URL)
@@ -387,6 +388,7 @@ func TestNewStoreCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 
 	vpr.Set("default.core_url", "test")
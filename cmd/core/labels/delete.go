@@ -12,9 +12,9 @@ import (
 // NewDeleteCommand creates the label delete command.
 func NewDeleteCommand(d cli.Discovery) *cobra.Command {
 	get := &cobra.Command{
-		Use:   "delete <labelId>",
+		Use:   "delete <label>",
 		Short: "The command that deletes labels from Discovery Core.",
-		Long:  fmt.Sprintf(commands.LongDeleteNoNames, "label", "Core"),
+		Long:  fmt.Sprintf(commands.LongDeleteSearch, "label", "Core") + " The labels are referenced by their key or in the format {key}:{value}.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			profile, err := cmd.Flags().GetString("profile")
 			if err != nil {
@@ -23,12 +23,19 @@ func NewDeleteCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"))
-			return commands.DeleteCommand(args[0], d, coreClient.Labels(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"))
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
+			return commands.SearchDeleteCommand(args[0], d, coreClient.Labels(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"))
 		},
 		Args: cobra.ExactArgs(1),
 		Example: `	# Delete a label by id
-	discovery core label delete 3d51beef-8b90-40aa-84b5-033241dc6239`,
+	discovery core label delete 3d51beef-8b90-40aa-84b5-033241dc6239
+
+	# Delete a label by its key and value
+	discovery core label delete my-label:my-value`,
 	}
 	return get
 }
@@ -11,10 +11,15 @@ import (
 
 // NewGetCommand creates the label get command.
 func NewGetCommand(d cli.Discovery) *cobra.Command {
+	var (
+		filters    []string
+		filterJSON string
+		list       commands.ListFlags
+	)
 	get := &cobra.Command{
-		Use:   "get [<labelId>]",
+		Use:   "get [<label>]",
 		Short: "The command that obtains labels from Discovery Core.",
-		Long:  fmt.Sprintf(commands.LongGetNoNames, "label", "Core"),
+		Long:  fmt.Sprintf(commands.LongGetSearch, "label", "Core") + " The labels are referenced by their key or in the format {key}:{value}.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			profile, err := cmd.Flags().GetString("profile")
 			if err != nil {
@@ -23,15 +28,38 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"))
-			return commands.GetCommand(args, d, coreClient.Labels(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"))
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
+			return commands.SearchCommand(args, d, coreClient.Labels(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"), &filters, filterJSON, list)
 		},
 		Args: cobra.MaximumNArgs(1),
 		Example: `	# Get a label by id
 	discovery core label get 3d51beef-8b90-40aa-84b5-033241dc6239
-	
+
+	# Get a label by its key and value
+	discovery core label get my-label:my-value
+
+	# Get the labels whose key starts with "my-"
+	discovery core label get --filter 'field=key:my-*'
+
+	# Get the labels using a filter written in Discovery's DSL
+	discovery core label get --filter-json '{"in":{"field":"value","values":["my-value","my-value-2"]}}'
+
 	# Get all labels using the configuration in profile "cn"
-	discovery core label get -p cn`,
+	discovery core label get -p cn
+
+	# Get the 10 most recently created labels
+	discovery core label get --sort creationTimestamp,desc --limit 10`,
 	}
+
+	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filter expressions. The filters are combined through the "and" operator when the flag is repeated. The available conditions are:
+- Field: The format is field={path}{:|>|>=|<|<=}{value}, like field=key:my-label or field=value:my-*
+- Timestamps: The formats are created{>|>=|<|<=}{date} and updated{>|>=|<|<=}{date}, where the date is YYYY-MM-DD or an RFC 3339 timestamp
+The conditions can be negated with != or !, grouped with parentheses and combined with & (and) and | (or). The values with spaces or special characters can be quoted with double quotes`)
+	get.Flags().StringVar(&filterJSON, "filter-json", "", "apply a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
+	commands.AddListFlags(get, &list)
 	return get
 }
@@ -20,56 +20,7 @@ import (
 
 // TestNewGetCommand tests the NewGetCommand() function.
 func TestNewGetCommand(t *testing.T) {
-	tests := []struct {
-		name       string
-		args       []string
-		url        bool
-		apiKey     string
-		outGolden  string
-		errGolden  string
-		outBytes   []byte
-		errBytes   []byte
-		method     string
-		path       string
-		statusCode int
-		response   string
-		err        error
-	}{
-		// Working case
-		{
-			name:       "Get by ID returns an object",
-			args:       []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
-			url:        true,
-			apiKey:     "",
-			outGolden:  "NewGetCommand_Out_GetByIdReturnsObject",
-			errGolden:  "NewGetCommand_Err_GetByIdReturnsObject",
-			outBytes:   testutils.Read(t, "NewGetCommand_Out_GetByIdReturnsObject"),
-			errBytes:   []byte(nil),
-			method:     http.MethodGet,
-			path:       "/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusOK,
-			response: `{
-				"id": "3d51beef-8b90-40aa-84b5-033241dc6239",
-				"creationTimestamp": "2025-08-27T19:22:06Z",
-				"lastUpdatedTimestamp": "2025-08-27T19:22:47Z",
-				"key": "label6",
-				"value": "hello2"
-			}`,
-			err: nil,
-		},
-		{
-			name:       "Get with no args returns an array",
-			args:       []string{},
-			outGolden:  "NewGetCommand_Out_GetAllReturnsArray",
-			errGolden:  "NewGetCommand_Err_GetAllReturnsArray",
-			outBytes:   testutils.Read(t, "NewGetCommand_Out_GetAllReturnsArray"),
-			errBytes:   []byte(nil),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/label",
-			statusCode: http.StatusOK,
-			response: `{
+	labelsResponse := `{
 			"content": [
 				{
 				"id": "3d51beef-8b90-40aa-84b5-033241dc6239",
@@ -105,69 +56,250 @@ func TestNewGetCommand(t *testing.T) {
 			"offset": 0,
 			"pageNumber": 0,
 			"numberOfElements": 3
+			}`
+
+	tests := []struct {
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Get by ID returns an object",
+			args:      []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
+			url:       true,
+			apiKey:    "",
+			outGolden: "NewGetCommand_Out_GetByIdReturnsObject",
+			errGolden: "NewGetCommand_Err_GetByIdReturnsObject",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetByIdReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"id": "3d51beef-8b90-40aa-84b5-033241dc6239",
+				"creationTimestamp": "2025-08-27T19:22:06Z",
+				"lastUpdatedTimestamp": "2025-08-27T19:22:47Z",
+				"key": "label6",
+				"value": "hello2"
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, http.MethodGet, r.Method)
+						assert.Equal(t, "", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Get by key returns an object",
+			args:      []string{"label6"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewGetCommand_Out_GetByKeyReturnsObject",
+			errGolden: "NewGetCommand_Err_GetByKeyReturnsObject",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetByKeyReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+				"GET:/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"id": "3d51beef-8b90-40aa-84b5-033241dc6239",
+				"creationTimestamp": "2025-08-27T19:22:06Z",
+				"lastUpdatedTimestamp": "2025-08-27T19:22:47Z",
+				"key": "label6",
+				"value": "hello2"
+			}`,
+					ContentType: "application/json",
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Get by key and value returns an object",
+			args:      []string{"D:D"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewGetCommand_Out_GetByKeyAndValueReturnsObject",
+			errGolden: "NewGetCommand_Err_GetByKeyAndValueReturnsObject",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetByKeyAndValueReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/label/7d0cb8c9-6555-4592-9b6c-1f4ed7fca9f4": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"id": "7d0cb8c9-6555-4592-9b6c-1f4ed7fca9f4",
+				"creationTimestamp": "2025-10-15T20:25:29Z",
+				"lastUpdatedTimestamp": "2025-10-15T20:25:29Z",
+				"key": "D",
+				"value": "D"
 			}`,
+					ContentType: "application/json",
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Get with no args returns an array",
+			args:      []string{},
+			outGolden: "NewGetCommand_Out_GetAllReturnsArray",
+			errGolden: "NewGetCommand_Err_GetAllReturnsArray",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetAllReturnsArray"),
+			errBytes:  []byte(nil),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, http.MethodGet, r.Method)
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Get with filters returns the matching labels",
+			args:      []string{"--filter", "field=key:D", "--filter", "updated>2025-10-15T20:26:00Z"},
+			outGolden: "NewGetCommand_Out_GetWithFiltersReturnsArray",
+			errGolden: "NewGetCommand_Err_GetWithFiltersReturnsArray",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetWithFiltersReturnsArray"),
+			errBytes:  []byte(nil),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+				},
+			},
 			err: nil,
 		},
 
 		// Error case
 		{
-			name:       "No URL",
-			args:       []string{},
-			outGolden:  "NewGetCommand_Out_NoURL",
-			errGolden:  "NewGetCommand_Err_NoURL",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_NoURL"),
-			url:        false,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusOK,
-			response:   ``,
-			err:        cli.NewError(cli.ErrorExitCode, "The Discovery Core URL is missing for profile \"default\".\nTo set the URL for the Discovery Core API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery core config --profile \"default\""),
+			name:      "No URL",
+			args:      []string{},
+			outGolden: "NewGetCommand_Out_NoURL",
+			errGolden: "NewGetCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{},
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Core URL is missing for profile \"default\".\nTo set the URL for the Discovery Core API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery core config --profile \"default\""),
 		},
 		{
-			name:       "user does not send a UUID",
-			args:       []string{"test"},
-			url:        true,
-			apiKey:     "apiKey123",
-			outGolden:  "NewGetCommand_Out_NotUUID",
-			errGolden:  "NewGetCommand_Err_NotUUID",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_NotUUID"),
-			method:     http.MethodGet,
-			path:       "/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusOK,
-			response:   ``,
-			err:        cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid UUID length: 4"), "Could not convert given id \"test\" to UUID. This command does not support filters or referencing an entity by name."),
+			name:      "user sends a key that does not exist",
+			args:      []string{"test"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewGetCommand_Out_KeyDoesNotExist",
+			errGolden: "NewGetCommand_Err_KeyDoesNotExist",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_KeyDoesNotExist"),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "test" does not exist"
+	]
+}`),
+			}, "Could not search for entity with id \"test\""),
 		},
 		{
-			name:       "Printing JSON object fails",
-			args:       []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
-			outGolden:  "NewGetCommand_Out_PrintJSONFails",
-			errGolden:  "NewGetCommand_Err_PrintJSONFails",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_PrintJSONFails"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusOK,
-			response:   `{"messages": {{}`,
-			err:        cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid character '{' looking for beginning of object key string"), "Could not print JSON object"),
+			name:      "user sends a key that several labels have",
+			args:      []string{"D"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewGetCommand_Out_AmbiguousKey",
+			errGolden: "NewGetCommand_Err_AmbiguousKey",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_AmbiguousKey"),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.AmbiguousNameError{Name: "D", Candidates: make([]gjson.Result, 2)}, "Several entities have the name \"D\". Use the id or the {key}:{value} format to choose one of them:\n- id: 5467ab23-7827-4fae-aa78-dfd4800549ee, value: F\n- id: 7d0cb8c9-6555-4592-9b6c-1f4ed7fca9f4, value: D"), "Could not search for entity with id \"D\""),
 		},
 		{
-			name:       "Printing JSON array fails",
-			args:       []string{},
-			outGolden:  "NewGetCommand_Out_PrintArrayFails",
-			errGolden:  "NewGetCommand_Err_PrintArrayFails",
-			outBytes:   testutils.Read(t, "NewGetCommand_Out_PrintArrayFails"),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_PrintArrayFails"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/label",
-			statusCode: http.StatusOK,
-			response: `{
+			name:      "Printing JSON object fails",
+			args:      []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
+			outGolden: "NewGetCommand_Out_PrintJSONFails",
+			errGolden: "NewGetCommand_Err_PrintJSONFails",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_PrintJSONFails"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"messages": {{}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid character '{' looking for beginning of object key string"), "Could not print JSON object"),
+		},
+		{
+			name:      "Printing JSON array fails",
+			args:      []string{},
+			outGolden: "NewGetCommand_Out_PrintArrayFails",
+			errGolden: "NewGetCommand_Err_PrintArrayFails",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_PrintArrayFails"),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_PrintArrayFails"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode: http.StatusOK,
+					Body: `{
 			"content": [{"active":true,"creationTimestamp":"2025-08-21T17:57:16Z","id":"3393f6d9-94c1-4b70-ba02-5f582727d998","labels":[],"lastUpdatedTimestamp":"2025-08-21T17:57:16Z","name":"MongoDB text processor 4","type":"mongo"},     
 			{"active":true,"creationTimestamp":"2025-08-14T18:02:38Z","id":"5f125024-1e5e-4591-9fee-365dc20eeeed","labels":[],"lastUpdatedTimestamp":"2025-08-18T20:55:43Z","name":"MongoDB text processor","type":"mongo",       
 			{"active":true,"creationTimestamp":"2025-08-14T18:02:38Z","id":"86e7f920-a4e4-4b64-be84-5437a7673db8","labels":[],"lastUpdatedTimestamp":"2025-08-14T18:02:38Z","name":"Script processor","type":"script"}
@@ -185,21 +317,29 @@ func TestNewGetCommand(t *testing.T) {
 			"numberOfElements": 3,
 			"pageNumber": 0
 			}`,
+					ContentType: "application/json",
+				},
+			},
 			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid character '{' looking for beginning of object key string"), "Could not print JSON Array"),
 		},
 		{
-			name:       "GetEntity returns HTTP error",
-			args:       []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
-			outGolden:  "NewGetCommand_Out_GetEntityHTTPError",
-			errGolden:  "NewGetCommand_Err_GetEntityHTTPError",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_GetEntityHTTPError"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusNotFound,
-			response: `{
+			name:      "GetEntity returns HTTP error",
+			args:      []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
+			outGolden: "NewGetCommand_Out_GetEntityHTTPError",
+			errGolden: "NewGetCommand_Err_GetEntityHTTPError",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_GetEntityHTTPError"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusOK,
+					Body:        labelsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/label/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusNotFound,
+					Body: `{
 			"status": 404,
 			"code": 1003,
 			"messages": [
@@ -207,6 +347,9 @@ func TestNewGetCommand(t *testing.T) {
 			],
 			"timestamp": "2025-10-16T17:46:45.386963700Z"
 			}`,
+					ContentType: "application/json",
+				},
+			},
 			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{
 			"status": 404,
 			"code": 1003,
@@ -214,32 +357,31 @@ func TestNewGetCommand(t *testing.T) {
 				"Entity not found: 3d51beef-8b90-40aa-84b5-033241dc6239"
 			],
 			"timestamp": "2025-10-16T17:46:45.386963700Z"
-			}`)}, "Could not get entity with id \"3d51beef-8b90-40aa-84b5-033241dc6239\""),
+			}`)}, "Could not search for entity with id \"3d51beef-8b90-40aa-84b5-033241dc6239\""),
 		},
 		{
-			name:       "GetEntities returns HTTP error",
-			args:       []string{},
-			outGolden:  "NewGetCommand_Out_GetEntitiesHTTPError",
-			errGolden:  "NewGetCommand_Err_GetEntitiesHTTPError",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_GetEntitiesHTTPError"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/label",
-			statusCode: http.StatusUnauthorized,
-			response:   `{"error": "unauthorized"}`,
-			err:        cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error": "unauthorized"}`)}, "Could not get all entities"),
+			name:      "GetEntities returns HTTP error",
+			args:      []string{},
+			outGolden: "NewGetCommand_Out_GetEntitiesHTTPError",
+			errGolden: "NewGetCommand_Err_GetEntitiesHTTPError",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_GetEntitiesHTTPError"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/label": {
+					StatusCode:  http.StatusUnauthorized,
+					Body:        `{"error": "unauthorized"}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error": "unauthorized"}`)}, "Could not get all entities"),
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			srv := httptest.NewServer(testutils.HttpHandler(t, tc.statusCode, "application/json", tc.response, func(t *testing.T, r *http.Request) {
-				assert.Equal(t, tc.method, r.Method)
-				assert.Equal(t, tc.path, r.URL.Path)
-				assert.Equal(t, tc.apiKey, r.Header.Get("X-API-Key"))
-			}))
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
 
 			defer srv.Close()
 
@@ -0,0 +1,90 @@
+package maintenance
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewLogLevelCommand creates the discovery core log-level command that changes the log level of Discovery's components.
+func NewLogLevelCommand(d cli.Discovery) *cobra.Command {
+	var (
+		logger       string
+		duration     time.Duration
+		restoreLevel string
+		detach       bool
+	)
+	logLevel := &cobra.Command{
+		Use:   "log-level <component> <level>",
+		Short: "Change the log level of a Discovery component",
+		Long:  "log-level is the command used to change the log level of a Discovery component, like ingestion-api or queryflow-api. The level can be ERROR, WARN, INFO, DEBUG or TRACE. The --logger flag only changes the level of the given logger of the component. Discovery acknowledges the change even if the component does not exist, so the command warns when the component is not one of the known components: " + strings.Join(cli.KnownLogComponents, ", ") + ". With the --for flag, the command waits for the given duration and then restores the level set with the --restore-level flag, which is required with --for, as Discovery does not report the current level of a component. If the command is interrupted while it waits, the level is restored right away. With the --detach flag, the command does not wait and writes a reminder with the command that restores the level.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			err = commands.CheckCredentials(d, profile, "Core", "core_url")
+			if err != nil {
+				return err
+			}
+
+			config := cli.LogLevelConfig{Component: args[0], Logger: logger, Duration: duration, Detach: detach}
+			config.Level, err = cli.ParseLogLevel(args[1])
+			if err != nil {
+				return err
+			}
+
+			if duration < 0 {
+				return cli.NewError(cli.ErrorExitCode, "Invalid duration %q. The duration cannot be negative", duration)
+			}
+			if duration == 0 && (detach || cmd.Flags().Changed("restore-level")) {
+				return cli.NewError(cli.ErrorExitCode, "The --detach and --restore-level flags can only be used with the --for flag")
+			}
+			if duration > 0 {
+				if !cmd.Flags().Changed("restore-level") {
+					return cli.NewError(cli.ErrorExitCode, "The --restore-level flag is required with the --for flag, as Discovery does not report the current level of the component")
+				}
+				config.RestoreLevel, err = cli.ParseLogLevel(restoreLevel)
+				if err != nil {
+					return err
+				}
+			}
+
+			vpr := d.Config()
+
+			// The requests are not canceled with the command, so that the level is restored when the command is interrupted while it waits.
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			options = append(options, discoveryPackage.WithContext(context.WithoutCancel(cmd.Context())))
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
+			printer := cli.GetObjectPrinter(vpr.GetString("output"))
+			return d.ChangeLogLevel(cmd.Context(), coreClient.Maintenance(), config, printer)
+		},
+		Args: cobra.ExactArgs(2),
+		Example: `	# Change the log level of Discovery Ingestion's API to DEBUG
+	discovery core log-level ingestion-api DEBUG
+
+	# Change the log level of a single logger
+	discovery core log-level queryflow-api TRACE --logger com.pureinsights
+
+	# Change the log level to DEBUG for 15 minutes and then restore it to INFO
+	discovery core log-level ingestion-api DEBUG --for 15m --restore-level INFO
+
+	# Change the log level to TRACE for an hour without waiting, and restore it to WARN afterwards
+	discovery core log-level core-api TRACE --for 1h --restore-level WARN --detach`,
+	}
+
+	logLevel.Flags().StringVar(&logger, "logger", "", "the name of the logger whose level is changed. If it is not set, every logger of the component is changed")
+	logLevel.Flags().DurationVar(&duration, "for", 0, "the time after which the level is restored, like 15m or 1h")
+	logLevel.Flags().StringVar(&restoreLevel, "restore-level", "", "the level that is restored once the --for duration ends. It is required with the --for flag")
+	logLevel.Flags().BoolVar(&detach, "detach", false, "do not wait for the --for duration to restore the level. A reminder with the command that restores it is written instead")
+	return logLevel
+}
@@ -0,0 +1,285 @@
+package maintenance
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestNewLogLevelCommand tests the NewLogLevelCommand() function.
+func TestNewLogLevelCommand(t *testing.T) {
+	var levels []string
+	logResponse := func(status int, body string) map[string]testutils.MockResponse {
+		return map[string]testutils.MockResponse{
+			"POST:/v2/maintenance/log": {
+				StatusCode:  status,
+				Body:        body,
+				ContentType: "application/json",
+				Assertions: func(t *testing.T, r *http.Request) {
+					assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					levels = append(levels, r.URL.Query().Get("componentName")+":"+r.URL.Query().Get("level")+":"+r.URL.Query().Get("loggerName"))
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		args           []string
+		url            bool
+		apiKey         string
+		outGolden      string
+		errGolden      string
+		outBytes       []byte
+		errBytes       []byte
+		responses      map[string]testutils.MockResponse
+		expectedLevels []string
+		err            error
+	}{
+		// Working case
+		{
+			name:           "Change the level of a logger",
+			args:           []string{"ingestion-api", "debug", "--logger", "com.pureinsights"},
+			url:            true,
+			apiKey:         "apiKey123",
+			outGolden:      "NewLogLevelCommand_Out_ChangeLevel",
+			errGolden:      "NewLogLevelCommand_Err_ChangeLevel",
+			outBytes:       testutils.Read(t, "NewLogLevelCommand_Out_ChangeLevel"),
+			errBytes:       testutils.Read(t, "NewLogLevelCommand_Err_ChangeLevel"),
+			responses:      logResponse(http.StatusOK, `{"acknowledged": true}`),
+			expectedLevels: []string{"ingestion-api:DEBUG:com.pureinsights"},
+			err:            nil,
+		},
+		{
+			name:           "Change the level of an unknown component",
+			args:           []string{"ingestion", "WARN"},
+			url:            true,
+			apiKey:         "apiKey123",
+			outGolden:      "NewLogLevelCommand_Out_UnknownComponent",
+			errGolden:      "NewLogLevelCommand_Err_UnknownComponent",
+			outBytes:       testutils.Read(t, "NewLogLevelCommand_Out_UnknownComponent"),
+			errBytes:       testutils.Read(t, "NewLogLevelCommand_Err_UnknownComponent"),
+			responses:      logResponse(http.StatusOK, `{"acknowledged": true}`),
+			expectedLevels: []string{"ingestion:WARN:"},
+			err:            nil,
+		},
+		{
+			name:           "Change the level for a duration and restore it",
+			args:           []string{"queryflow-api", "TRACE", "--for", "10ms", "--restore-level", "warn"},
+			url:            true,
+			apiKey:         "apiKey123",
+			outGolden:      "NewLogLevelCommand_Out_ChangeLevelFor",
+			errGolden:      "NewLogLevelCommand_Err_ChangeLevelFor",
+			outBytes:       testutils.Read(t, "NewLogLevelCommand_Out_ChangeLevelFor"),
+			errBytes:       testutils.Read(t, "NewLogLevelCommand_Err_ChangeLevelFor"),
+			responses:      logResponse(http.StatusOK, `{"acknowledged": true}`),
+			expectedLevels: []string{"queryflow-api:TRACE:", "queryflow-api:WARN:"},
+			err:            nil,
+		},
+		{
+			name:           "Change the level for a duration without waiting",
+			args:           []string{"core-api", "DEBUG", "--for", "15m", "--restore-level", "INFO", "--detach"},
+			url:            true,
+			apiKey:         "apiKey123",
+			outGolden:      "NewLogLevelCommand_Out_Detach",
+			errGolden:      "NewLogLevelCommand_Err_Detach",
+			outBytes:       testutils.Read(t, "NewLogLevelCommand_Out_Detach"),
+			errBytes:       testutils.Read(t, "NewLogLevelCommand_Err_Detach"),
+			responses:      logResponse(http.StatusOK, `{"acknowledged": true}`),
+			expectedLevels: []string{"core-api:DEBUG:"},
+			err:            nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"core-api", "DEBUG"},
+			outGolden: "NewLogLevelCommand_Out_NoURL",
+			errGolden: "NewLogLevelCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewLogLevelCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Core URL is missing for profile \"default\".\nTo set the URL for the Discovery Core API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery core config --profile \"default\""),
+		},
+		{
+			name:      "Invalid level",
+			args:      []string{"core-api", "VERBOSE"},
+			outGolden: "NewLogLevelCommand_Out_InvalidLevel",
+			errGolden: "NewLogLevelCommand_Err_InvalidLevel",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewLogLevelCommand_Err_InvalidLevel"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "Invalid log level \"VERBOSE\". The level must be ERROR, WARN, INFO, DEBUG or TRACE"),
+		},
+		{
+			name:      "Detach without a duration",
+			args:      []string{"core-api", "DEBUG", "--detach"},
+			outGolden: "NewLogLevelCommand_Out_DetachWithoutFor",
+			errGolden: "NewLogLevelCommand_Err_DetachWithoutFor",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewLogLevelCommand_Err_DetachWithoutFor"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The --detach and --restore-level flags can only be used with the --for flag"),
+		},
+		{
+			name:      "Duration without a restore level",
+			args:      []string{"core-api", "DEBUG", "--for", "15m"},
+			outGolden: "NewLogLevelCommand_Out_ForWithoutRestoreLevel",
+			errGolden: "NewLogLevelCommand_Err_ForWithoutRestoreLevel",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewLogLevelCommand_Err_ForWithoutRestoreLevel"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The --restore-level flag is required with the --for flag, as Discovery does not report the current level of the component"),
+		},
+		{
+			name:      "Invalid restore level",
+			args:      []string{"core-api", "DEBUG", "--for", "15m", "--restore-level", "VERBOSE"},
+			outGolden: "NewLogLevelCommand_Out_InvalidRestoreLevel",
+			errGolden: "NewLogLevelCommand_Err_InvalidRestoreLevel",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewLogLevelCommand_Err_InvalidRestoreLevel"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "Invalid log level \"VERBOSE\". The level must be ERROR, WARN, INFO, DEBUG or TRACE"),
+		},
+		{
+			name:      "Negative duration",
+			args:      []string{"core-api", "DEBUG", "--for", "-5m"},
+			outGolden: "NewLogLevelCommand_Out_NegativeDuration",
+			errGolden: "NewLogLevelCommand_Err_NegativeDuration",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewLogLevelCommand_Err_NegativeDuration"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "Invalid duration \"-5m0s\". The duration cannot be negative"),
+		},
+		{
+			name:           "Log returns an HTTP error",
+			args:           []string{"core-api", "DEBUG"},
+			outGolden:      "NewLogLevelCommand_Out_LogError",
+			errGolden:      "NewLogLevelCommand_Err_LogError",
+			outBytes:       []byte(nil),
+			errBytes:       testutils.Read(t, "NewLogLevelCommand_Err_LogError"),
+			url:            true,
+			apiKey:         "apiKey123",
+			responses:      logResponse(http.StatusInternalServerError, `{"status": 500, "code": 5000, "messages": ["Internal server error"]}`),
+			expectedLevels: []string{"core-api:DEBUG:"},
+			err:            cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status": 500, "code": 5000, "messages": ["Internal server error"]}`)}, "Could not change the log level of component \"core-api\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			levels = nil
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader("")
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.core_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.core_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			logLevelCmd := NewLogLevelCommand(d)
+
+			logLevelCmd.SilenceUsage = true
+			logLevelCmd.SetIn(ios.In)
+			logLevelCmd.SetOut(ios.Out)
+			logLevelCmd.SetErr(ios.Err)
+
+			logLevelCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			logLevelCmd.SetArgs(tc.args)
+
+			err := logLevelCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedLevels, levels)
+			testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewLogLevelCommand_NoProfileFlag tests the NewLogLevelCommand when the profile flag was not defined.
+func TestNewLogLevelCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.core_url", "test")
+	vpr.Set("default.core_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	logLevelCmd := NewLogLevelCommand(d)
+
+	logLevelCmd.SetIn(ios.In)
+	logLevelCmd.SetOut(ios.Out)
+	logLevelCmd.SetErr(ios.Err)
+
+	logLevelCmd.SetArgs([]string{"core-api", "DEBUG"})
+
+	err := logLevelCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewLogLevelCommand_Out_NoProfile", testutils.Read(t, "NewLogLevelCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewLogLevelCommand_Err_NoProfile", testutils.Read(t, "NewLogLevelCommand_Err_NoProfile"), errBuf.Bytes())
+}
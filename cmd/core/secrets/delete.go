@@ -12,9 +12,9 @@ import (
 // NewDeleteCommand creates the secret delete command.
 func NewDeleteCommand(d cli.Discovery) *cobra.Command {
 	get := &cobra.Command{
-		Use:   "delete <secretId>",
+		Use:   "delete <secret>",
 		Short: "The command that deletes secrets from Discovery Core.",
-		Long:  fmt.Sprintf(commands.LongDeleteNoNames, "secret", "Core"),
+		Long:  fmt.Sprintf(commands.LongDeleteSearch, "secret", "Core"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			profile, err := cmd.Flags().GetString("profile")
 			if err != nil {
@@ -23,12 +23,19 @@ func NewDeleteCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"))
-			return commands.DeleteCommand(args[0], d, coreClient.Secrets(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"))
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
+			return commands.SearchDeleteCommand(args[0], d, coreClient.Secrets(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"))
 		},
 		Args: cobra.ExactArgs(1),
 		Example: `	# Delete a secret by id
-	discovery core secret delete 3d51beef-8b90-40aa-84b5-033241dc6239`,
+	discovery core secret delete 3d51beef-8b90-40aa-84b5-033241dc6239
+
+	# Delete a secret by name
+	discovery core secret delete my-mongo-secret`,
 	}
 	return get
 }
@@ -20,111 +20,258 @@ import (
 
 // TestNewDeleteCommand tests the NewDeleteCommand() function.
 func TestNewDeleteCommand(t *testing.T) {
+	secretsResponse := `{
+			"content": [
+                  {
+                          "name": "openai-secret",
+                          "labels": [],
+                          "active": true,
+                          "id": "3d51beef-8b90-40aa-84b5-033241dc6239",
+                          "creationTimestamp": "2025-08-26T21:56:50Z",
+                          "lastUpdatedTimestamp": "2025-08-26T21:56:50Z"
+                  },
+                  {
+                          "name": "mongo-secret",
+                          "labels": [],
+                          "active": true,
+                          "id": "cfa0ef51-1fd9-47e2-8fdb-262ac9712781",
+                          "creationTimestamp": "2025-08-14T18:01:59Z",
+                          "lastUpdatedTimestamp": "2025-08-14T18:01:59Z"
+                  }
+          ],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 2,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"pageNumber": 0,
+			"numberOfElements": 2
+			}`
+
 	tests := []struct {
-		name       string
-		args       []string
-		url        bool
-		apiKey     string
-		outGolden  string
-		errGolden  string
-		outBytes   []byte
-		errBytes   []byte
-		method     string
-		path       string
-		statusCode int
-		response   string
-		err        error
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
 	}{
 		// Working case
 		{
-			name:       "Delete by ID returns an acknowledged true",
-			args:       []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
-			url:        true,
-			apiKey:     "",
-			outGolden:  "NewDeleteCommand_Out_DeleteByIdReturnsObject",
-			errGolden:  "NewDeleteCommand_Err_DeleteByIdReturnsObject",
-			outBytes:   testutils.Read(t, "NewDeleteCommand_Out_DeleteByIdReturnsObject"),
-			errBytes:   []byte(nil),
-			method:     http.MethodDelete,
-			path:       "/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusOK,
-			response: `{
+			name:      "Delete by ID returns an acknowledged true",
+			args:      []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
+			url:       true,
+			apiKey:    "",
+			outGolden: "NewDeleteCommand_Out_DeleteByIdReturnsObject",
+			errGolden: "NewDeleteCommand_Err_DeleteByIdReturnsObject",
+			outBytes:  testutils.Read(t, "NewDeleteCommand_Out_DeleteByIdReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"name": "openai-secret",
+				"labels": [],
+				"active": true,
+				"id": "3d51beef-8b90-40aa-84b5-033241dc6239",
+				"creationTimestamp": "2025-08-26T21:56:50Z",
+				"lastUpdatedTimestamp": "2025-08-26T21:56:50Z"
+			}`,
+					ContentType: "application/json",
+				},
+				"DELETE:/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"acknowledged": true
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, http.MethodDelete, r.Method)
+						assert.Equal(t, "", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Delete by name returns an acknowledged true",
+			args:      []string{"openai-secret"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewDeleteCommand_Out_DeleteByNameReturnsObject",
+			errGolden: "NewDeleteCommand_Err_DeleteByNameReturnsObject",
+			outBytes:  testutils.Read(t, "NewDeleteCommand_Out_DeleteByNameReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+				"GET:/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"name": "openai-secret",
+				"labels": [],
+				"active": true,
+				"id": "3d51beef-8b90-40aa-84b5-033241dc6239",
+				"creationTimestamp": "2025-08-26T21:56:50Z",
+				"lastUpdatedTimestamp": "2025-08-26T21:56:50Z"
+			}`,
+					ContentType: "application/json",
+				},
+				"DELETE:/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusOK,
+					Body: `{
 				"acknowledged": true
 			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, http.MethodDelete, r.Method)
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
 			err: nil,
 		},
 
 		// Error case
 		{
-			name:       "No URL",
-			args:       []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
-			outGolden:  "NewDeleteCommand_Out_NoURL",
-			errGolden:  "NewDeleteCommand_Err_NoURL",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewDeleteCommand_Err_NoURL"),
-			url:        false,
-			apiKey:     "apiKey123",
-			method:     http.MethodDelete,
-			path:       "/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusOK,
-			response:   ``,
-			err:        cli.NewError(cli.ErrorExitCode, "The Discovery Core URL is missing for profile \"default\".\nTo set the URL for the Discovery Core API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery core config --profile \"default\""),
+			name:      "No URL",
+			args:      []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
+			outGolden: "NewDeleteCommand_Out_NoURL",
+			errGolden: "NewDeleteCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewDeleteCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{},
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Core URL is missing for profile \"default\".\nTo set the URL for the Discovery Core API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery core config --profile \"default\""),
 		},
 		{
-			name:       "user does not send a UUID",
-			args:       []string{"test"},
-			url:        true,
-			apiKey:     "apiKey123",
-			outGolden:  "NewDeleteCommand_Out_NotUUID",
-			errGolden:  "NewDeleteCommand_Err_NotUUID",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewDeleteCommand_Err_NotUUID"),
-			method:     http.MethodDelete,
-			path:       "/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusOK,
-			response:   ``,
-			err:        cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid UUID length: 4"), "Could not convert given id \"test\" to UUID. This command does not support referencing an entity by name."),
+			name:      "sent name does not exist",
+			args:      []string{"test"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewDeleteCommand_Out_NameDoesNotExist",
+			errGolden: "NewDeleteCommand_Err_NameDoesNotExist",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewDeleteCommand_Err_NameDoesNotExist"),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "test" does not exist"
+	]
+}`),
+			}, "Could not search for entity with name \"test\""),
 		},
 		{
-			name:       "Printing JSON object fails",
-			args:       []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
-			outGolden:  "NewDeleteCommand_Out_PrintJSONFails",
-			errGolden:  "NewDeleteCommand_Err_PrintJSONFails",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewDeleteCommand_Err_PrintJSONFails"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodDelete,
-			path:       "/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusOK,
-			response:   `{"messages": {{}`,
-			err:        cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid character '{' looking for beginning of object key string"), "Could not print JSON object"),
+			name:      "Printing JSON object fails",
+			args:      []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
+			outGolden: "NewDeleteCommand_Out_PrintJSONFails",
+			errGolden: "NewDeleteCommand_Err_PrintJSONFails",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewDeleteCommand_Err_PrintJSONFails"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"name": "openai-secret",
+				"labels": [],
+				"active": true,
+				"id": "3d51beef-8b90-40aa-84b5-033241dc6239",
+				"creationTimestamp": "2025-08-26T21:56:50Z",
+				"lastUpdatedTimestamp": "2025-08-26T21:56:50Z"
+			}`,
+					ContentType: "application/json",
+				},
+				"DELETE:/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"messages": {{}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid character '{' looking for beginning of object key string"), "Could not print JSON object"),
 		},
 		{
-			name:       "DeleteEntity returns HTTP error",
-			args:       []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
-			outGolden:  "NewDeleteCommand_Out_DeleteEntityHTTPError",
-			errGolden:  "NewDeleteCommand_Err_DeleteEntityHTTPError",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewDeleteCommand_Err_DeleteEntityHTTPError"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodDelete,
-			path:       "/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239",
-			statusCode: http.StatusBadRequest,
-			response: `{
-			"status": 400,
-			"code": 3002,
+			name:      "DeleteEntity returns HTTP error",
+			args:      []string{"3d51beef-8b90-40aa-84b5-033241dc6239"},
+			outGolden: "NewDeleteCommand_Out_DeleteEntityHTTPError",
+			errGolden: "NewDeleteCommand_Err_DeleteEntityHTTPError",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewDeleteCommand_Err_DeleteEntityHTTPError"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"name": "openai-secret",
+				"labels": [],
+				"active": true,
+				"id": "3d51beef-8b90-40aa-84b5-033241dc6239",
+				"creationTimestamp": "2025-08-26T21:56:50Z",
+				"lastUpdatedTimestamp": "2025-08-26T21:56:50Z"
+			}`,
+					ContentType: "application/json",
+				},
+				"DELETE:/v2/secret/3d51beef-8b90-40aa-84b5-033241dc6239": {
+					StatusCode: http.StatusConflict,
+					Body: `{
+			"status": 409,
+			"code": 4001,
 			"messages": [
-				"Failed to convert argument [id] for value [test] due to: Invalid UUID string: test"
+				"The secret is referenced by other entities"
 			],
 			"timestamp": "2025-10-23T22:35:38.345647200Z"
 			}`,
-			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{
-			"status": 400,
-			"code": 3002,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusConflict, Body: gjson.Parse(`{
+			"status": 409,
+			"code": 4001,
 			"messages": [
-				"Failed to convert argument [id] for value [test] due to: Invalid UUID string: test"
+				"The secret is referenced by other entities"
 			],
 			"timestamp": "2025-10-23T22:35:38.345647200Z"
 			}`)}, "Could not delete entity with id \"3d51beef-8b90-40aa-84b5-033241dc6239\""),
@@ -133,11 +280,7 @@ func TestNewDeleteCommand(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			srv := httptest.NewServer(testutils.HttpHandler(t, tc.statusCode, "application/json", tc.response, func(t *testing.T, r *http.Request) {
-				assert.Equal(t, tc.method, r.Method)
-				assert.Equal(t, tc.path, r.URL.Path)
-				assert.Equal(t, tc.apiKey, r.Header.Get("X-API-Key"))
-			}))
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
 
 			defer srv.Close()
 
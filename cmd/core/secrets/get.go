@@ -11,10 +11,15 @@ import (
 
 // NewGetCommand creates the secret get command.
 func NewGetCommand(d cli.Discovery) *cobra.Command {
+	var (
+		filters    []string
+		filterJSON string
+		list       commands.ListFlags
+	)
 	get := &cobra.Command{
-		Use:   "get [<secretId>]",
+		Use:   "get [<secret>]",
 		Short: "The command that obtains secrets from Discovery Core.",
-		Long:  fmt.Sprintf(commands.LongGetNoNames, "secret", "Core"),
+		Long:  fmt.Sprintf(commands.LongGetSearch, "secret", "Core"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			profile, err := cmd.Flags().GetString("profile")
 			if err != nil {
@@ -23,15 +28,43 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"))
-			return commands.GetCommand(args, d, coreClient.Secrets(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"))
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
+			return commands.SearchCommand(args, d, coreClient.Secrets(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"), &filters, filterJSON, list)
 		},
 		Args: cobra.MaximumNArgs(1),
 		Example: `	# Get a secret by id
 	discovery core secret get 81ca1ac6-3058-4ecd-a292-e439827a675a
 
+	# Get a secret by name
+	discovery core secret get my-mongo-secret
+
+	# Get secrets using filters
+	discovery core secret get --filter label=A:A
+
+	# Get secrets whose name starts with "my-" or that were updated after October 1st, 2026
+	discovery core secret get --filter 'name=my-* | updated>2026-10-01'
+
+	# Get secrets using a filter written in Discovery's DSL
+	discovery core secret get --filter-json '{"exists":{"field":"labels"}}'
+
 	# Get all secrets using the configuration in profile "cn"
-	discovery core secret get -p cn`,
+	discovery core secret get -p cn
+
+	# Get the 10 most recently created secrets
+	discovery core secret get --sort creationTimestamp,desc --limit 10`,
 	}
+
+	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filter expressions. The filters are combined through the "and" operator when the flag is repeated. The available conditions are:
+- Label: The format is label={key}[:{value}], where the value is optional
+- Name: The format is name={name}, where the name can have the * and ? wildcards
+- Field: The format is field={path}{:|>|>=|<|<=}{value}, like field=config.index:products or field=config.batchSize>=10
+- Timestamps: The formats are created{>|>=|<|<=}{date} and updated{>|>=|<|<=}{date}, where the date is YYYY-MM-DD or an RFC 3339 timestamp
+The conditions can be negated with != or !, grouped with parentheses and combined with & (and) and | (or). The values with spaces or special characters can be quoted with double quotes`)
+	get.Flags().StringVar(&filterJSON, "filter-json", "", "apply a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
+	commands.AddListFlags(get, &list)
 	return get
 }
@@ -20,57 +20,7 @@ import (
 
 // TestNewGetCommand tests the NewGetCommand() function.
 func TestNewGetCommand(t *testing.T) {
-	tests := []struct {
-		name       string
-		args       []string
-		url        bool
-		apiKey     string
-		outGolden  string
-		errGolden  string
-		outBytes   []byte
-		errBytes   []byte
-		method     string
-		path       string
-		statusCode int
-		response   string
-		err        error
-	}{
-		// Working case
-		{
-			name:       "Get by ID returns an object",
-			args:       []string{"81ca1ac6-3058-4ecd-a292-e439827a675a"},
-			url:        true,
-			apiKey:     "",
-			outGolden:  "NewGetCommand_Out_GetByIdReturnsObject",
-			errGolden:  "NewGetCommand_Err_GetByIdReturnsObject",
-			outBytes:   testutils.Read(t, "NewGetCommand_Out_GetByIdReturnsObject"),
-			errBytes:   []byte(nil),
-			method:     http.MethodGet,
-			path:       "/v2/secret/81ca1ac6-3058-4ecd-a292-e439827a675a",
-			statusCode: http.StatusOK,
-			response: `{
-				"name": "openai-secret",
-				"labels": [],
-				"active": true,
-				"id": "81ca1ac6-3058-4ecd-a292-e439827a675a",
-				"creationTimestamp": "2025-08-26T21:56:50Z",
-				"lastUpdatedTimestamp": "2025-08-26T21:56:50Z"
-			}`,
-			err: nil,
-		},
-		{
-			name:       "Get with no args returns an array",
-			args:       []string{},
-			outGolden:  "NewGetCommand_Out_GetAllReturnsArray",
-			errGolden:  "NewGetCommand_Err_GetAllReturnsArray",
-			outBytes:   testutils.Read(t, "NewGetCommand_Out_GetAllReturnsArray"),
-			errBytes:   []byte(nil),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/secret",
-			statusCode: http.StatusOK,
-			response: `{
+	secretsResponse := `{
 			"content": [
                   {
                           "name": "openai-secret",
@@ -101,69 +51,205 @@ func TestNewGetCommand(t *testing.T) {
 			"offset": 0,
 			"pageNumber": 0,
 			"numberOfElements": 2
+			}`
+
+	tests := []struct {
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Get by ID returns an object",
+			args:      []string{"81ca1ac6-3058-4ecd-a292-e439827a675a"},
+			url:       true,
+			apiKey:    "",
+			outGolden: "NewGetCommand_Out_GetByIdReturnsObject",
+			errGolden: "NewGetCommand_Err_GetByIdReturnsObject",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetByIdReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/secret/81ca1ac6-3058-4ecd-a292-e439827a675a": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"name": "openai-secret",
+				"labels": [],
+				"active": true,
+				"id": "81ca1ac6-3058-4ecd-a292-e439827a675a",
+				"creationTimestamp": "2025-08-26T21:56:50Z",
+				"lastUpdatedTimestamp": "2025-08-26T21:56:50Z"
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, http.MethodGet, r.Method)
+						assert.Equal(t, "", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Get by name returns an object",
+			args:      []string{"mongo-secret"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewGetCommand_Out_GetByNameReturnsObject",
+			errGolden: "NewGetCommand_Err_GetByNameReturnsObject",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetByNameReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+				"GET:/v2/secret/cfa0ef51-1fd9-47e2-8fdb-262ac9712781": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"name": "mongo-secret",
+				"labels": [],
+				"active": true,
+				"id": "cfa0ef51-1fd9-47e2-8fdb-262ac9712781",
+				"creationTimestamp": "2025-08-14T18:01:59Z",
+				"lastUpdatedTimestamp": "2025-08-14T18:01:59Z"
 			}`,
+					ContentType: "application/json",
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Get with no args returns an array",
+			args:      []string{},
+			outGolden: "NewGetCommand_Out_GetAllReturnsArray",
+			errGolden: "NewGetCommand_Err_GetAllReturnsArray",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetAllReturnsArray"),
+			errBytes:  []byte(nil),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, http.MethodGet, r.Method)
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Get with filters returns the matching secrets",
+			args:      []string{"--filter", "name=*-secret", "--filter", "updated>2025-08-20"},
+			outGolden: "NewGetCommand_Out_GetWithFiltersReturnsArray",
+			errGolden: "NewGetCommand_Err_GetWithFiltersReturnsArray",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_GetWithFiltersReturnsArray"),
+			errBytes:  []byte(nil),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+			},
 			err: nil,
 		},
 
 		// Error case
 		{
-			name:       "No URL",
-			args:       []string{},
-			outGolden:  "NewGetCommand_Out_NoURL",
-			errGolden:  "NewGetCommand_Err_NoURL",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_NoURL"),
-			url:        false,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/secret/81ca1ac6-3058-4ecd-a292-e439827a675a",
-			statusCode: http.StatusOK,
-			response:   ``,
-			err:        cli.NewError(cli.ErrorExitCode, "The Discovery Core URL is missing for profile \"default\".\nTo set the URL for the Discovery Core API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery core config --profile \"default\""),
+			name:      "No URL",
+			args:      []string{},
+			outGolden: "NewGetCommand_Out_NoURL",
+			errGolden: "NewGetCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{},
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Core URL is missing for profile \"default\".\nTo set the URL for the Discovery Core API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery core config --profile \"default\""),
 		},
 		{
-			name:       "user does not send a UUID",
-			args:       []string{"test"},
-			url:        true,
-			apiKey:     "apiKey123",
-			outGolden:  "NewGetCommand_Out_NotUUID",
-			errGolden:  "NewGetCommand_Err_NotUUID",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_NotUUID"),
-			method:     http.MethodGet,
-			path:       "/v2/secret/81ca1ac6-3058-4ecd-a292-e439827a675a",
-			statusCode: http.StatusOK,
-			response:   ``,
-			err:        cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid UUID length: 4"), "Could not convert given id \"test\" to UUID. This command does not support filters or referencing an entity by name."),
+			name:      "user sends a name that does not exist",
+			args:      []string{"test"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewGetCommand_Out_NameDoesNotExist",
+			errGolden: "NewGetCommand_Err_NameDoesNotExist",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_NameDoesNotExist"),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "test" does not exist"
+	]
+}`),
+			}, "Could not search for entity with id \"test\""),
 		},
 		{
-			name:       "Printing JSON object fails",
-			args:       []string{"81ca1ac6-3058-4ecd-a292-e439827a675a"},
-			outGolden:  "NewGetCommand_Out_PrintJSONFails",
-			errGolden:  "NewGetCommand_Err_PrintJSONFails",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_PrintJSONFails"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/secret/81ca1ac6-3058-4ecd-a292-e439827a675a",
-			statusCode: http.StatusOK,
-			response:   `{"messages": {{}`,
-			err:        cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid character '{' looking for beginning of object key string"), "Could not print JSON object"),
+			name:      "Printing JSON object fails",
+			args:      []string{"81ca1ac6-3058-4ecd-a292-e439827a675a"},
+			outGolden: "NewGetCommand_Out_PrintJSONFails",
+			errGolden: "NewGetCommand_Err_PrintJSONFails",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_PrintJSONFails"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/secret/81ca1ac6-3058-4ecd-a292-e439827a675a": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"messages": {{}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid character '{' looking for beginning of object key string"), "Could not print JSON object"),
 		},
 		{
-			name:       "Printing JSON array fails",
-			args:       []string{},
-			outGolden:  "NewGetCommand_Out_PrintArrayFails",
-			errGolden:  "NewGetCommand_Err_PrintArrayFails",
-			outBytes:   testutils.Read(t, "NewGetCommand_Out_PrintArrayFails"),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_PrintArrayFails"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/secret",
-			statusCode: http.StatusOK,
-			response: `{
+			name:      "Printing JSON array fails",
+			args:      []string{},
+			outGolden: "NewGetCommand_Out_PrintArrayFails",
+			errGolden: "NewGetCommand_Err_PrintArrayFails",
+			outBytes:  testutils.Read(t, "NewGetCommand_Out_PrintArrayFails"),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_PrintArrayFails"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode: http.StatusOK,
+					Body: `{
 			"content": [{"active":true,"creationTimestamp":"2025-08-21T17:57:16Z","id":"3393f6d9-94c1-4b70-ba02-5f582727d998","secrets":[],"lastUpdatedTimestamp":"2025-08-21T17:57:16Z","name":"MongoDB text processor 4","type":"mongo"},     
 			{"active":true,"creationTimestamp":"2025-08-14T18:02:38Z","id":"5f125024-1e5e-4591-9fee-365dc20eeeed","secrets":[],"lastUpdatedTimestamp":"2025-08-18T20:55:43Z","name":"MongoDB text processor","type":"mongo",       
 			{"active":true,"creationTimestamp":"2025-08-14T18:02:38Z","id":"86e7f920-a4e4-4b64-be84-5437a7673db8","secrets":[],"lastUpdatedTimestamp":"2025-08-14T18:02:38Z","name":"Script processor","type":"script"}
@@ -181,21 +267,29 @@ func TestNewGetCommand(t *testing.T) {
 			"numberOfElements": 3,
 			"pageNumber": 0
 			}`,
+					ContentType: "application/json",
+				},
+			},
 			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("invalid character '{' looking for beginning of object key string"), "Could not print JSON Array"),
 		},
 		{
-			name:       "GetEntity returns HTTP error",
-			args:       []string{"81ca1ac6-3058-4ecd-a292-e439827a675a"},
-			outGolden:  "NewGetCommand_Out_GetEntityHTTPError",
-			errGolden:  "NewGetCommand_Err_GetEntityHTTPError",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_GetEntityHTTPError"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/secret/81ca1ac6-3058-4ecd-a292-e439827a675a",
-			statusCode: http.StatusNotFound,
-			response: `{
+			name:      "GetEntity returns HTTP error",
+			args:      []string{"81ca1ac6-3058-4ecd-a292-e439827a675a"},
+			outGolden: "NewGetCommand_Out_GetEntityHTTPError",
+			errGolden: "NewGetCommand_Err_GetEntityHTTPError",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_GetEntityHTTPError"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusOK,
+					Body:        secretsResponse,
+					ContentType: "application/json",
+				},
+				"GET:/v2/secret/81ca1ac6-3058-4ecd-a292-e439827a675a": {
+					StatusCode: http.StatusNotFound,
+					Body: `{
 			"status": 404,
 			"code": 1003,
 			"messages": [
@@ -203,6 +297,9 @@ func TestNewGetCommand(t *testing.T) {
 			],
 			"timestamp": "2025-10-16T17:46:45.386963700Z"
 			}`,
+					ContentType: "application/json",
+				},
+			},
 			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{
 			"status": 404,
 			"code": 1003,
@@ -210,32 +307,31 @@ func TestNewGetCommand(t *testing.T) {
 				"Entity not found: 81ca1ac6-3058-4ecd-a292-e439827a675a"
 			],
 			"timestamp": "2025-10-16T17:46:45.386963700Z"
-			}`)}, "Could not get entity with id \"81ca1ac6-3058-4ecd-a292-e439827a675a\""),
+			}`)}, "Could not search for entity with id \"81ca1ac6-3058-4ecd-a292-e439827a675a\""),
 		},
 		{
-			name:       "GetEntities returns HTTP error",
-			args:       []string{},
-			outGolden:  "NewGetCommand_Out_GetEntitiesHTTPError",
-			errGolden:  "NewGetCommand_Err_GetEntitiesHTTPError",
-			outBytes:   []byte(nil),
-			errBytes:   testutils.Read(t, "NewGetCommand_Err_GetEntitiesHTTPError"),
-			url:        true,
-			apiKey:     "apiKey123",
-			method:     http.MethodGet,
-			path:       "/v2/secret",
-			statusCode: http.StatusUnauthorized,
-			response:   `{"error": "unauthorized"}`,
-			err:        cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error": "unauthorized"}`)}, "Could not get all entities"),
+			name:      "GetEntities returns HTTP error",
+			args:      []string{},
+			outGolden: "NewGetCommand_Out_GetEntitiesHTTPError",
+			errGolden: "NewGetCommand_Err_GetEntitiesHTTPError",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewGetCommand_Err_GetEntitiesHTTPError"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/secret": {
+					StatusCode:  http.StatusUnauthorized,
+					Body:        `{"error": "unauthorized"}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error": "unauthorized"}`)}, "Could not get all entities"),
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			srv := httptest.NewServer(testutils.HttpHandler(t, tc.statusCode, "application/json", tc.response, func(t *testing.T, r *http.Request) {
-				assert.Equal(t, tc.method, r.Method)
-				assert.Equal(t, tc.path, r.URL.Path)
-				assert.Equal(t, tc.apiKey, r.Header.Get("X-API-Key"))
-			}))
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
 
 			defer srv.Close()
 
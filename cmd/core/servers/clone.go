@@ -0,0 +1,47 @@
+package servers
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the server clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <server>",
+		Short: "The command that clones servers in Discovery Core.",
+		Long:  fmt.Sprintf(commands.LongClone, "server", "Core"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
+			return commands.SearchCloneCommand(args[0], d, coreClient.Servers(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Clone a server by name
+	discovery core server clone my-server --name my-server-copy
+
+	# Clone a server by id and add labels to the copy
+	discovery core server clone 21029da3-041c-43b5-a67e-870251f2f6a6 --name my-server-copy --labels env:dev --labels draft
+
+	# Clone a server and the entities it references
+	discovery core server clone my-server --name my-server-copy --deep`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, false)
+	return clone
+}
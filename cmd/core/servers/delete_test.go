@@ -431,6 +431,7 @@ func TestNewDeleteCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 			vpr.Set("output", "pretty-json")
 			if tc.url {
 				vpr.Set("default.core_url", srv.URL)
@@ -488,6 +489,7 @@ func TestNewDeleteCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 
 	vpr.Set("default.core_url", "test")
@@ -525,6 +527,7 @@ func TestNewDeleteCommand_NotExactly1Arg(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 
 	vpr.Set("default.core_url", "test")
@@ -11,7 +11,11 @@ import (
 
 // NewGetCommand creates the server get command.
 func NewGetCommand(d cli.Discovery) *cobra.Command {
-	var filters []string
+	var (
+		filters    []string
+		filterJSON string
+		list       commands.ListFlags
+	)
 	get := &cobra.Command{
 		Use:   "get <server>",
 		Short: "The command that obtains servers from Discovery Core.",
@@ -24,8 +28,12 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"))
-			return commands.SearchCommand(args, d, coreClient.Servers(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"), &filters)
+			options, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			coreClient := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), options...)
+			return commands.SearchCommand(args, d, coreClient.Servers(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Core", "core_url"), &filters, filterJSON, list)
 		},
 		Args: cobra.MaximumNArgs(1),
 		Example: `	# Get server by name
@@ -34,12 +42,27 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 	# Get servers using filters
 	discovery core server get --filter label=A:A -f type=mongo
 
+	# Get servers whose name starts with "my-" or that were updated after October 1st, 2026
+	discovery core server get --filter 'name=my-* | updated>2026-10-01'
+
+	# Get servers using a filter written in Discovery's DSL
+	discovery core server get --filter-json '{"exists":{"field":"labels"}}'
+
 	# Get all servers using the configuration in profile "cn"
-	discovery core server get -p cn`,
+	discovery core server get -p cn
+
+	# Get the 10 most recently created servers
+	discovery core server get --sort creationTimestamp,desc --limit 10`,
 	}
 
-	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filters in the format "filter=key:value". The available filters are:
+	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filter expressions. The filters are combined through the "and" operator when the flag is repeated. The available conditions are:
 - Label: The format is label={key}[:{value}], where the value is optional
-- Type: The format is type={type}`)
+- Type: The format is type={type}
+- Name: The format is name={name}, where the name can have the * and ? wildcards
+- Field: The format is field={path}{:|>|>=|<|<=}{value}, like field=config.index:products or field=config.batchSize>=10
+- Timestamps: The formats are created{>|>=|<|<=}{date} and updated{>|>=|<|<=}{date}, where the date is YYYY-MM-DD or an RFC 3339 timestamp
+The conditions can be negated with != or !, grouped with parentheses and combined with & (and) and | (or). The values with spaces or special characters can be quoted with double quotes`)
+	get.Flags().StringVar(&filterJSON, "filter-json", "", "apply a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
+	commands.AddListFlags(get, &list)
 	return get
 }
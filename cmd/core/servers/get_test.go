@@ -68,7 +68,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-server",
+					"name": "my-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -322,6 +322,11 @@ func TestNewGetCommand(t *testing.T) {
 			url:       true,
 			apiKey:    "apiKey123",
 			responses: map[string]testutils.MockResponse{
+				"GET:/v2/server/3b32e410-2f33-412d-9fb8-17970131921c": {
+					StatusCode:  http.StatusNotFound,
+					Body:        `{"status":404,"code":1003,"messages":["Entity not found: 3b32e410-2f33-412d-9fb8-17970131921c"]}`,
+					ContentType: "application/json",
+				},
 				"POST:/v2/server/search": {
 					StatusCode: http.StatusInternalServerError,
 					Body: `{
@@ -572,6 +577,7 @@ func TestNewGetCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 
 			if tc.url {
 				vpr.Set("default.core_url", srv.URL)
@@ -629,6 +635,7 @@ func TestNewGetCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 
 	vpr.Set("default.core_url", "test")
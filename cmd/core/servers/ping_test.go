@@ -429,6 +429,7 @@ func TestNewPingCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 			vpr.Set("output", "pretty-json")
 			if tc.url {
 				vpr.Set("default.core_url", srv.URL)
@@ -486,6 +487,7 @@ func TestNewPingCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 
 	vpr.Set("default.core_url", "test")
@@ -523,6 +525,7 @@ func TestNewPingCommand_NotExactly1Arg(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 
 	vpr.Set("default.core_url", "test")
@@ -14,6 +14,7 @@ func NewServerCommand(d cli.Discovery) *cobra.Command {
 
 	server.AddCommand(NewGetCommand(d))
 	server.AddCommand(NewStoreCommand(d))
+	server.AddCommand(NewCloneCommand(d))
 	server.AddCommand(NewDeleteCommand(d))
 	server.AddCommand(NewPingCommand(d))
 
@@ -581,6 +581,7 @@ func TestNewStoreCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 			vpr.Set("output", "json")
 			if tc.url {
 				vpr.Set("default.core_url", srv.URL)
@@ -749,6 +750,7 @@ func TestNewStoreCommand_MultipleFiles(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 	vpr.Set("default.core_url", srv.URL)
 	vpr.Set("default.core_key", "apiKey123")
@@ -792,6 +794,7 @@ func TestNewStoreCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 
 	vpr.Set("default.core_url", "test")
@@ -123,6 +123,7 @@ func TestNewStatusCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 
 			if tc.url {
 				vpr.Set("default.core_url", srv.URL)
@@ -180,6 +181,7 @@ func TestNewStatusCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 
 	vpr.Set("default.core_url", "test")
@@ -1,6 +1,7 @@
 package deploy
 
 import (
+	"github.com/pureinsights/discovery-cli/cmd/commands"
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/pureinsights/discovery-cli/internal/cli"
 	"github.com/spf13/cobra"
@@ -39,10 +40,22 @@ The entity directories have JSON files with the configurations that will be impo
 
 			vpr := d.Config()
 
-			core := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"))
+			coreOptions, err := commands.ClientOptions(cmd, d, profile, "core")
+			if err != nil {
+				return err
+			}
+			core := discoveryPackage.NewCore(vpr.GetString(profile+".core_url"), vpr.GetString(profile+".core_key"), coreOptions...)
 			coreClient := core.BackupRestore()
-			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key")).BackupRestore()
-			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key")).BackupRestore()
+			ingestionOptions, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), ingestionOptions...).BackupRestore()
+			queryflowOptions, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), queryflowOptions...).BackupRestore()
 
 			clients := []cli.BackupRestoreClientEntry{
 				{Name: "core", Client: coreClient},
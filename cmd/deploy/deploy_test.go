@@ -250,6 +250,7 @@ func TestNewDeployCommand_ProfileFlag(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 			vpr.Set("output", "pretty-json")
 			if tc.coreUrl {
 				url := "http://localhost:12010"
@@ -329,6 +330,7 @@ func TestNewDeployCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 
 	vpr.Set("default.ingestion_url", "test")
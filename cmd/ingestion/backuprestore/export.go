@@ -24,7 +24,11 @@ func NewExportCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
 			return commands.ExportCommand(d, ingestionClient.BackupRestore(), file, commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", "ingestion_url"))
 		},
 		Args: cobra.NoArgs,
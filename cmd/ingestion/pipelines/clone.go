@@ -0,0 +1,47 @@
+package pipelines
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the pipeline clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <pipeline>",
+		Short: "The command that clones pipelines in Discovery Ingestion.",
+		Long:  fmt.Sprintf(commands.LongClone, "pipeline", "Ingestion"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
+			return commands.SearchCloneCommand(args[0], d, ingestionClient.Pipelines(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", "ingestion_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Clone a pipeline by name
+	discovery ingestion pipeline clone my-pipeline --name my-pipeline-copy
+
+	# Clone a pipeline by id and add labels to the copy
+	discovery ingestion pipeline clone 04536687-f083-4353-8ecc-b7348e14b748 --name my-pipeline-copy --labels env:dev --labels draft
+
+	# Clone a pipeline and the entities it references
+	discovery ingestion pipeline clone my-pipeline --name my-pipeline-copy --deep`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, false)
+	return clone
+}
@@ -14,6 +14,7 @@ func NewPipelineCommand(d cli.Discovery) *cobra.Command {
 
 	pipeline.AddCommand(NewStoreCommand(d))
 	pipeline.AddCommand(NewGetCommand(d))
+	pipeline.AddCommand(NewCloneCommand(d))
 	pipeline.AddCommand(NewDeleteCommand(d))
 
 	return pipeline
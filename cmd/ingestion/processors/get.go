@@ -11,7 +11,11 @@ import (
 
 // NewGetCommand creates the processor get command.
 func NewGetCommand(d cli.Discovery) *cobra.Command {
-	var filters []string
+	var (
+		filters    []string
+		filterJSON string
+		list       commands.ListFlags
+	)
 	get := &cobra.Command{
 		Use:   "get [<processor>]",
 		Short: "The command that obtains processors from Discovery Ingestion.",
@@ -24,8 +28,12 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"))
-			return commands.SearchCommand(args, d, ingestionClient.Processors(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", "ingestion_url"), &filters)
+			options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
+			return commands.SearchCommand(args, d, ingestionClient.Processors(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", "ingestion_url"), &filters, filterJSON, list)
 		},
 		Args: cobra.MaximumNArgs(1),
 		Example: `	# Get processor by name
@@ -34,12 +42,27 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 	# Get processors using filters
 	discovery ingestion processor get --filter label=A:A -f type=mongo
 
+	# Get processors whose name starts with "my-" or that were updated after October 1st, 2026
+	discovery ingestion processor get --filter 'name=my-* | updated>2026-10-01'
+
+	# Get processors using a filter written in Discovery's DSL
+	discovery ingestion processor get --filter-json '{"exists":{"field":"labels"}}'
+
 	# Get all processors using the configuration in profile "cn"
-	discovery ingestion processor get -p cn`,
+	discovery ingestion processor get -p cn
+
+	# Get the 10 most recently created processors
+	discovery ingestion processor get --sort creationTimestamp,desc --limit 10`,
 	}
 
-	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filters in the format "filter=key:value". The available filters are:
+	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filter expressions. The filters are combined through the "and" operator when the flag is repeated. The available conditions are:
 - Label: The format is label={key}[:{value}], where the value is optional
-- Type: The format is type={type}`)
+- Type: The format is type={type}
+- Name: The format is name={name}, where the name can have the * and ? wildcards
+- Field: The format is field={path}{:|>|>=|<|<=}{value}, like field=config.index:products or field=config.batchSize>=10
+- Timestamps: The formats are created{>|>=|<|<=}{date} and updated{>|>=|<|<=}{date}, where the date is YYYY-MM-DD or an RFC 3339 timestamp
+The conditions can be negated with != or !, grouped with parentheses and combined with & (and) and | (or). The values with spaces or special characters can be quoted with double quotes`)
+	get.Flags().StringVar(&filterJSON, "filter-json", "", "apply a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
+	commands.AddListFlags(get, &list)
 	return get
 }
@@ -14,6 +14,7 @@ func NewProcessorCommand(d cli.Discovery) *cobra.Command {
 
 	processor.AddCommand(NewGetCommand(d))
 	processor.AddCommand(NewStoreCommand(d))
+	processor.AddCommand(NewCloneCommand(d))
 	processor.AddCommand(NewDeleteCommand(d))
 
 	return processor
@@ -47,6 +47,6 @@ func Test_NewProcessorCommand(t *testing.T) {
 		}
 	}
 
-	expectedCommands := []string{"delete", "get", "store"}
+	expectedCommands := []string{"clone", "delete", "get", "store"}
 	assert.Equal(t, expectedCommands, commandNames)
 }
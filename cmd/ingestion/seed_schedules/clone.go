@@ -0,0 +1,47 @@
+package seed_schedules
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the seed schedule clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <seed-schedule>",
+		Short: "The command that clones seed schedules in Discovery Ingestion.",
+		Long:  fmt.Sprintf(commands.LongClone, "seed schedule", "Ingestion"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
+			return commands.SearchCloneCommand(args[0], d, ingestionClient.SeedSchedules(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", "ingestion_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Clone a seed schedule by name
+	discovery ingestion seed-schedule clone my-seed-schedule --name my-seed-schedule-copy
+
+	# Clone a seed schedule by id and add labels to the copy
+	discovery ingestion seed-schedule clone e9cec918-69a9-4053-946b-c2538a7a49be --name my-seed-schedule-copy --labels env:dev --labels draft
+
+	# Clone a seed schedule and the entities it references
+	discovery ingestion seed-schedule clone my-seed-schedule --name my-seed-schedule-copy --deep`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, false)
+	return clone
+}
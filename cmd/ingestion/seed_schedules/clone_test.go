@@ -0,0 +1,221 @@
+package seed_schedules
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewCloneCommand tests the NewCloneCommand() function.
+func TestNewCloneCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Clone by name returns the copy",
+			args:      []string{"my-seed-schedule", "--name", "my-seed-schedule-copy", "--deep"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewCloneCommand_Out_CloneByNameReturnsObject",
+			errGolden: "NewCloneCommand_Err_CloneByNameReturnsObject",
+			outBytes:  testutils.Read(t, "NewCloneCommand_Out_CloneByNameReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/seed/schedule/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"name": "my-seed-schedule",
+					"labels": [],
+					"active": true,
+					"id": "e9cec918-69a9-4053-946b-c2538a7a49be",
+					"creationTimestamp": "2025-10-17T22:37:57Z",
+					"lastUpdatedTimestamp": "2025-10-17T22:37:57Z"
+				},
+				"highlight": {},
+				"score": 1.4854797
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"pageNumber": 0,
+			"numberOfElements": 1
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+				"GET:/v2/seed/schedule/e9cec918-69a9-4053-946b-c2538a7a49be": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"name": "my-seed-schedule",
+				"labels": [],
+				"active": true,
+				"id": "e9cec918-69a9-4053-946b-c2538a7a49be",
+				"creationTimestamp": "2025-10-17T22:37:57Z",
+				"lastUpdatedTimestamp": "2025-10-17T22:37:57Z"
+			}`,
+					ContentType: "application/json",
+				},
+				"POST:/v2/seed/schedule/e9cec918-69a9-4053-946b-c2538a7a49be/clone": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"name": "my-seed-schedule-copy",
+				"labels": [],
+				"active": true,
+				"id": "c77caced-b1d4-49de-b690-09bac3bc80a7",
+				"creationTimestamp": "2025-10-17T22:37:57Z",
+				"lastUpdatedTimestamp": "2025-10-17T22:37:57Z"
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "my-seed-schedule-copy", r.URL.Query().Get("name"))
+						assert.Equal(t, "true", r.URL.Query().Get("deep"))
+					},
+				},
+			},
+			err: nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"my-seed-schedule"},
+			outGolden: "NewCloneCommand_Out_NoURL",
+			errGolden: "NewCloneCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewCloneCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Ingestion URL is missing for profile \"default\".\nTo set the URL for the Discovery Ingestion API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery ingestion config --profile \"default\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader("")
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.ingestion_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.ingestion_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			cloneCmd := NewCloneCommand(d)
+
+			cloneCmd.SilenceUsage = true
+			cloneCmd.SetIn(ios.In)
+			cloneCmd.SetOut(ios.Out)
+			cloneCmd.SetErr(ios.Err)
+
+			cloneCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			cloneCmd.SetArgs(tc.args)
+
+			err := cloneCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewCloneCommand_NoProfileFlag tests the NewCloneCommand when the profile flag was not defined.
+func TestNewCloneCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.ingestion_url", "test")
+	vpr.Set("default.ingestion_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	cloneCmd := NewCloneCommand(d)
+
+	cloneCmd.SetIn(ios.In)
+	cloneCmd.SetOut(ios.Out)
+	cloneCmd.SetErr(ios.Err)
+
+	cloneCmd.SetArgs([]string{"my-seed-schedule"})
+
+	err := cloneCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewCloneCommand_Out_NoProfile", testutils.Read(t, "NewCloneCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewCloneCommand_Err_NoProfile", testutils.Read(t, "NewCloneCommand_Err_NoProfile"), errBuf.Bytes())
+}
@@ -0,0 +1,46 @@
+package seed_schedules
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewDisableCommand creates the seed schedule disable command.
+func NewDisableCommand(d cli.Discovery) *cobra.Command {
+	var flags commands.EnableFlags
+	disable := &cobra.Command{
+		Use:   "disable [<seed-schedule>...]",
+		Short: "The command that disables seed schedules in Discovery Ingestion.",
+		Long:  fmt.Sprintf(commands.LongDisable, "seed schedule", "Ingestion"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
+			return commands.SearchEnableCommand(args, d, ingestionClient.SeedSchedules(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", "ingestion_url"), flags, false)
+		},
+		Example: `	# Disable a seed schedule by name
+	discovery ingestion seed-schedule disable my-seed-schedule
+
+	# Disable several seed schedules by name or id
+	discovery ingestion seed-schedule disable my-seed-schedule e9cec918-69a9-4053-946b-c2538a7a49be
+
+	# Disable every seed schedule with the label env:prod
+	discovery ingestion seed-schedule disable --filter label=env:prod`,
+	}
+
+	commands.AddEnableFlags(disable, &flags)
+	return disable
+}
@@ -0,0 +1,46 @@
+package seed_schedules
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewEnableCommand creates the seed schedule enable command.
+func NewEnableCommand(d cli.Discovery) *cobra.Command {
+	var flags commands.EnableFlags
+	enable := &cobra.Command{
+		Use:   "enable [<seed-schedule>...]",
+		Short: "The command that enables seed schedules in Discovery Ingestion.",
+		Long:  fmt.Sprintf(commands.LongEnable, "seed schedule", "Ingestion"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
+			return commands.SearchEnableCommand(args, d, ingestionClient.SeedSchedules(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", "ingestion_url"), flags, true)
+		},
+		Example: `	# Enable a seed schedule by name
+	discovery ingestion seed-schedule enable my-seed-schedule
+
+	# Enable several seed schedules by name or id
+	discovery ingestion seed-schedule enable my-seed-schedule e9cec918-69a9-4053-946b-c2538a7a49be
+
+	# Enable every seed schedule with the label env:prod
+	discovery ingestion seed-schedule enable --filter label=env:prod`,
+	}
+
+	commands.AddEnableFlags(enable, &flags)
+	return enable
+}
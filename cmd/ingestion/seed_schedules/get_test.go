@@ -66,7 +66,7 @@ func TestNewGetCommand(t *testing.T) {
 				},
 				{
 				"source": {
-					"name": "my-seed-schedule",
+					"name": "my-seed-schedule clone",
 					"labels": [
 					{
 						"key": "A",
@@ -318,6 +318,11 @@ func TestNewGetCommand(t *testing.T) {
 			url:       true,
 			apiKey:    "apiKey123",
 			responses: map[string]testutils.MockResponse{
+				"GET:/v2/seed/schedule/3b32e410-2f33-412d-9fb8-17970131921c": {
+					StatusCode:  http.StatusNotFound,
+					Body:        `{"status":404,"code":1003,"messages":["Entity not found: 3b32e410-2f33-412d-9fb8-17970131921c"]}`,
+					ContentType: "application/json",
+				},
 				"POST:/v2/seed/schedule/search": {
 					StatusCode: http.StatusInternalServerError,
 					Body: `{
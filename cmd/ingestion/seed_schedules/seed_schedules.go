@@ -14,7 +14,10 @@ func NewSeedScheduleCommand(d cli.Discovery) *cobra.Command {
 
 	seedSchedule.AddCommand(NewGetCommand(d))
 	seedSchedule.AddCommand(NewStoreCommand(d))
+	seedSchedule.AddCommand(NewCloneCommand(d))
 	seedSchedule.AddCommand(NewDeleteCommand(d))
+	seedSchedule.AddCommand(NewEnableCommand(d))
+	seedSchedule.AddCommand(NewDisableCommand(d))
 
 	return seedSchedule
 }
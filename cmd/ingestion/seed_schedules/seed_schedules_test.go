@@ -47,6 +47,6 @@ func Test_NewSeedScheduleCommand(t *testing.T) {
 		}
 	}
 
-	expectedCommands := []string{"delete", "get", "store"}
+	expectedCommands := []string{"clone", "delete", "disable", "enable", "get", "store"}
 	assert.Equal(t, expectedCommands, commandNames)
 }
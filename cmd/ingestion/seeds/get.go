@@ -18,14 +18,18 @@ const (
 )
 
 // getSeedExecution gets the seed execution, with details if the flag is enabled.
-func getSeedExecution(d cli.Discovery, seedId uuid.UUID, executionId, profile string, details bool, printer cli.Printer) error {
+func getSeedExecution(cmd *cobra.Command, d cli.Discovery, seedId uuid.UUID, executionId, profile string, details bool, printer cli.Printer) error {
 	seedExecutionId, err := uuid.Parse(executionId)
 	if err != nil {
 		return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get seed execution id")
 	}
 
 	vpr := d.Config()
-	ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+"."+ingestionUrl), vpr.GetString(profile+"."+ingestionKey))
+	ingestionOptions, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+	if err != nil {
+		return err
+	}
+	ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+"."+ingestionUrl), vpr.GetString(profile+"."+ingestionKey), ingestionOptions...)
 	seedExecutionClient := ingestionClient.Seeds().Executions(seedId)
 
 	summarizers := map[string]cli.Summarizer{
@@ -48,7 +52,11 @@ func RecordOrExecution(cmd *cobra.Command, args []string, d cli.Discovery, profi
 	}
 
 	vpr := d.Config()
-	ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+"."+ingestionUrl), vpr.GetString(profile+"."+ingestionKey))
+	ingestionOptions, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+	if err != nil {
+		return err
+	}
+	ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+"."+ingestionUrl), vpr.GetString(profile+"."+ingestionKey), ingestionOptions...)
 	seed, err := cli.SearchEntity(d, ingestionClient.Seeds(), args[0])
 	if err != nil {
 		return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not search for entity with id %q", args[0])
@@ -66,13 +74,15 @@ func RecordOrExecution(cmd *cobra.Command, args []string, d cli.Discovery, profi
 		return d.AppendSeedRecord(seed, ingestionClient.Seeds().Records(seedId), recordId, printer)
 	}
 
-	return getSeedExecution(d, seedId, executionId, profile, details, printer)
+	return getSeedExecution(cmd, d, seedId, executionId, profile, details, printer)
 }
 
 // NewGetCommand creates the seed get command
 func NewGetCommand(d cli.Discovery) *cobra.Command {
 	var (
 		filters     []string
+		filterJSON  string
+		list        commands.ListFlags
 		recordId    string
 		executionId string
 		details     bool
@@ -90,8 +100,12 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 			vpr := d.Config()
 
 			if !cmd.Flags().Changed("record") && !cmd.Flags().Changed("execution") {
-				ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+"."+ingestionUrl), vpr.GetString(profile+"."+ingestionKey))
-				return commands.SearchCommand(args, d, ingestionClient.Seeds(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", ingestionUrl), &filters)
+				ingestionOptions, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+				if err != nil {
+					return err
+				}
+				ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+"."+ingestionUrl), vpr.GetString(profile+"."+ingestionKey), ingestionOptions...)
+				return commands.SearchCommand(args, d, ingestionClient.Seeds(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Ingestion", ingestionUrl), &filters, filterJSON, list)
 			}
 
 			return RecordOrExecution(cmd, args, d, profile, recordId, executionId, details)
@@ -102,9 +116,18 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 	# Get seeds using filters
 	discovery ingestion seed get --filter label=A:A -f type=staging
+	
+	# Get seeds whose name starts with "my-" or that were updated after October 1st, 2026
+	discovery ingestion seed get --filter 'name=my-* | updated>2026-10-01'
+	
+	# Get seeds using a filter written in Discovery's DSL
+	discovery ingestion seed get --filter-json '{"exists":{"field":"labels"}}'
 
 	# Get all seeds using the configuration in profile "cn"
 	discovery ingestion seed get -p cn
+
+	# Get the 10 most recently created seeds
+	discovery ingestion seed get --sort creationTimestamp,desc --limit 10
 	
 	# Get a seed record by id
 	discovery ingestion seed get 2acd0a61-852c-4f38-af2b-9c84e152873e --record A3HTDEgCa65BFZsac9TInFisvloRlL3M50ijCWNCKx0=
@@ -113,9 +136,14 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 	discovery ingestion seed get 2acd0a61-852c-4f38-af2b-9c84e152873e --execution 0f20f984-1854-4741-81ea-30f8b965b007 --details`,
 	}
 
-	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filters in the format "filter=key:value". The available filters are:
+	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filter expressions. The filters are combined through the "and" operator when the flag is repeated. The available conditions are:
 - Label: The format is label={key}[:{value}], where the value is optional
-- Type: The format is type={type}`)
+- Type: The format is type={type}
+- Name: The format is name={name}, where the name can have the * and ? wildcards
+- Field: The format is field={path}{:|>|>=|<|<=}{value}, like field=config.index:products or field=config.batchSize>=10
+- Timestamps: The formats are created{>|>=|<|<=}{date} and updated{>|>=|<|<=}{date}, where the date is YYYY-MM-DD or an RFC 3339 timestamp
+The conditions can be negated with != or !, grouped with parentheses and combined with & (and) and | (or). The values with spaces or special characters can be quoted with double quotes`)
+	get.Flags().StringVar(&filterJSON, "filter-json", "", "apply a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
 
 	get.Flags().StringVar(&recordId, "record", "", "the id of the record that will be retrieved")
 	get.Flags().StringVar(&executionId, "execution", "", "the id of the seed execution that will be retrieved")
@@ -123,5 +151,8 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 	get.MarkFlagsMutuallyExclusive("filter", "record", "execution")
 	get.MarkFlagsMutuallyExclusive("filter", "record", "details")
+	get.MarkFlagsMutuallyExclusive("filter-json", "record", "execution")
+	get.MarkFlagsMutuallyExclusive("filter-json", "record", "details")
+	commands.AddListFlags(get, &list)
 	return get
 }
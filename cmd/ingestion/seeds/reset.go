@@ -0,0 +1,57 @@
+package seeds
+
+import (
+	"github.com/google/uuid"
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewResetCommand creates the seed reset command to reset the metadata and records of a seed.
+func NewResetCommand(d cli.Discovery) *cobra.Command {
+	var config cli.ResetSeedConfig
+	reset := &cobra.Command{
+		Use:   "reset <seed>",
+		Short: "The command that resets a seed in Discovery Ingestion.",
+		Long:  "reset is the command used to reset a seed in Discovery Ingestion by its name or UUID. The reset deletes the records of the seed and resets its metadata, so the next execution processes every record again. A seed cannot be reset while it has active executions, so the command checks the last executions of the seed first and refuses to reset it if any of them is active. With the --halt flag, the active executions are halted and the command waits for them to stop before the reset. The command asks for confirmation before the seed is changed, unless the --yes flag is set.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			err = commands.CheckCredentials(d, profile, "Ingestion", "ingestion_url")
+			if err != nil {
+				return err
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
+			seedsClient := ingestionClient.Seeds()
+			executions := func(seedId uuid.UUID) cli.LastExecutionsGetter {
+				return seedsClient.Executions(seedId)
+			}
+			return d.ResetSeed(cmd.Context(), seedsClient, executions, args[0], config, cli.GetObjectPrinter(vpr.GetString("output")))
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Reset a seed by name after confirming it
+	discovery ingestion seed reset my-seed
+
+	# Reset a seed by id without confirmation
+	discovery ingestion seed reset 1d81d3d5-58a2-44a5-9acf-3fc8358afe09 --yes
+
+	# Halt the active executions of a seed and reset it
+	discovery ingestion seed reset my-seed --halt`,
+	}
+
+	reset.Flags().BoolVarP(&config.Yes, "yes", "y", false, "reset the seed without asking for confirmation")
+	reset.Flags().BoolVar(&config.Halt, "halt", false, "halt the active executions of the seed before the reset")
+
+	return reset
+}
@@ -0,0 +1,255 @@
+package seeds
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewResetCommand tests the NewResetCommand() function.
+func TestNewResetCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		in        string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Reset with the yes flag resets the seed",
+			args:      []string{"MongoDB seed", "--yes"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewResetCommand_Out_ResetWithYes",
+			errGolden: "NewResetCommand_Err_ResetWithYes",
+			outBytes:  testutils.Read(t, "NewResetCommand_Out_ResetWithYes"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/seed/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"type": "mongo",
+					"name": "MongoDB seed",
+					"labels": [],
+					"active": true,
+					"id": "9ababe08-0b74-4672-bb7c-e7a8227d6d4c",
+					"creationTimestamp": "2025-08-14T18:02:11Z",
+					"lastUpdatedTimestamp": "2025-08-14T18:02:11Z"
+				},
+				"highlight": {},
+				"score": 0.15534057
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"numberOfElements": 1,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/seed/9ababe08-0b74-4672-bb7c-e7a8227d6d4c": {
+					StatusCode: http.StatusOK,
+					Body: `{
+					"type": "mongo",
+					"name": "MongoDB seed",
+					"labels": [],
+					"active": true,
+					"id": "9ababe08-0b74-4672-bb7c-e7a8227d6d4c",
+					"creationTimestamp": "2025-10-17T22:37:53Z",
+					"lastUpdatedTimestamp": "2025-10-17T22:37:53Z"
+				}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/seed/9ababe08-0b74-4672-bb7c-e7a8227d6d4c/execution": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{"id":"cb48ab6b-577a-4354-8edf-981e1b0c9acb","creationTimestamp":"2026-04-10T17:26:26Z","lastUpdatedTimestamp":"2026-04-10T17:26:47Z","triggerType":"MANUAL","status":"DONE","scanType":"FULL"},
+				{"id":"3fdddf51-fa6b-406b-9b28-cc40969d908d","creationTimestamp":"2026-04-08T16:33:32Z","lastUpdatedTimestamp":"2026-04-08T16:39:32Z","triggerType":"MANUAL","status":"DONE","scanType":"FULL"}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 5,
+				"sort": []
+			},
+			"totalSize": 2,
+			"totalPages": 1,
+			"empty": false,
+			"size": 5,
+			"offset": 0,
+			"numberOfElements": 2,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "5", r.URL.Query().Get("size"))
+					},
+				},
+				"POST:/v2/seed/9ababe08-0b74-4672-bb7c-e7a8227d6d4c/reset": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"acknowledged":true}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"MongoDB seed"},
+			outGolden: "NewResetCommand_Out_NoURL",
+			errGolden: "NewResetCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewResetCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Ingestion URL is missing for profile \"default\".\nTo set the URL for the Discovery Ingestion API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery ingestion config --profile \"default\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader(tc.in)
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.ingestion_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.ingestion_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			resetCmd := NewResetCommand(d)
+
+			resetCmd.SilenceUsage = true
+			resetCmd.SetIn(ios.In)
+			resetCmd.SetOut(ios.Out)
+			resetCmd.SetErr(ios.Err)
+
+			resetCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			resetCmd.SetArgs(tc.args)
+
+			err := resetCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewResetCommand_Flags tests the flags of the command returned by NewResetCommand().
+// The behaviour of the reset is tested with the ResetSeed() function of the cli package.
+func TestNewResetCommand_Flags(t *testing.T) {
+	d := cli.NewDiscovery(&iostreams.IOStreams{}, viper.New(), t.TempDir())
+	resetCmd := NewResetCommand(d)
+
+	yes := resetCmd.Flags().Lookup("yes")
+	require.NotNil(t, yes)
+	assert.Equal(t, "y", yes.Shorthand)
+	assert.Equal(t, "false", yes.DefValue)
+
+	halt := resetCmd.Flags().Lookup("halt")
+	require.NotNil(t, halt)
+	assert.Empty(t, halt.Shorthand)
+	assert.Equal(t, "false", halt.DefValue)
+}
+
+// TestNewResetCommand_NoProfileFlag tests the NewResetCommand when the profile flag was not defined.
+func TestNewResetCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.ingestion_url", "test")
+	vpr.Set("default.ingestion_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	resetCmd := NewResetCommand(d)
+
+	resetCmd.SetIn(ios.In)
+	resetCmd.SetOut(ios.Out)
+	resetCmd.SetErr(ios.Err)
+
+	resetCmd.SetArgs([]string{"MongoDB seed"})
+
+	err := resetCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewResetCommand_Out_NoProfile", testutils.Read(t, "NewResetCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewResetCommand_Err_NoProfile", testutils.Read(t, "NewResetCommand_Err_NoProfile"), errBuf.Bytes())
+}
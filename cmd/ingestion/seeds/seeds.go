@@ -16,6 +16,8 @@ func NewSeedCommand(d cli.Discovery) *cobra.Command {
 	seed.AddCommand(NewGetCommand(d))
 	seed.AddCommand(NewStartCommand(d))
 	seed.AddCommand(NewHaltCommand(d))
+	seed.AddCommand(NewResetCommand(d))
+	seed.AddCommand(NewCloneCommand(d))
 	seed.AddCommand(NewDeleteCommand(d))
 	seed.AddCommand(NewStatusCommand(d))
 
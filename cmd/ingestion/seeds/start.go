@@ -29,7 +29,11 @@ func NewStartCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+			if err != nil {
+				return err
+			}
+			ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
 			scan := discoveryPackage.ScanType(scanType)
 			propertiesJSON := gjson.Parse(executionProperties)
 			printer := cli.GetObjectPrinter(vpr.GetString("output"))
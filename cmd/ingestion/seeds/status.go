@@ -31,7 +31,11 @@ func seedStatusCheck(d cli.Discovery, cmd *cobra.Command, args []string, executi
 
 	printer := cli.GetObjectPrinter(vpr.GetString("output"))
 
-	ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"))
+	options, err := commands.ClientOptions(cmd, d, profile, "ingestion")
+	if err != nil {
+		return err
+	}
+	ingestionClient := discoveryPackage.NewIngestion(vpr.GetString(profile+".ingestion_url"), vpr.GetString(profile+".ingestion_key"), options...)
 	seed, err := cli.SearchEntity(d, ingestionClient.Seeds(), args[0])
 	if err != nil {
 		return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not search for entity with id %q", args[0])
@@ -43,7 +47,7 @@ func seedStatusCheck(d cli.Discovery, cmd *cobra.Command, args []string, executi
 	}
 
 	if cmd.Flags().Changed(executionFlag) {
-		return getSeedExecution(d, seedId, executionId, profile, details, printer)
+		return getSeedExecution(cmd, d, seedId, executionId, profile, details, printer)
 	}
 
 	if cmd.Flags().Changed(latestExecutionFlag) {
@@ -59,7 +63,7 @@ func seedStatusCheck(d cli.Discovery, cmd *cobra.Command, args []string, executi
 		}
 
 		execution := executions[0]
-		return getSeedExecution(d, seedId, execution.Get("id").String(), profile, details, printer)
+		return getSeedExecution(cmd, d, seedId, execution.Get("id").String(), profile, details, printer)
 	}
 
 	return d.StatusOfSeedExecutions(ingestionClient.Seeds().Executions(seedId), ingestionClient.Seeds().Records(seedId), printer)
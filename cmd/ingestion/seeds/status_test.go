@@ -1279,6 +1279,11 @@ func TestNewStatusCommand(t *testing.T) {
 			url:       true,
 			apiKey:    "apiKey123",
 			responses: map[string]testutils.MockResponse{
+				"GET:/v2/seed/3b32e410-2f33-412d-9fb8-17970131921c": {
+					StatusCode:  http.StatusNotFound,
+					Body:        `{"status":404,"code":1003,"messages":["Entity not found: 3b32e410-2f33-412d-9fb8-17970131921c"]}`,
+					ContentType: "application/json",
+				},
 				"POST:/v2/seed/search": {
 					StatusCode: http.StatusInternalServerError,
 					Body: `{
@@ -24,7 +24,11 @@ func NewExportCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
 			return commands.ExportCommand(d, queryflowClient.BackupRestore(), file, commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"))
 		},
 		Args: cobra.NoArgs,
@@ -24,7 +24,11 @@ func NewImportCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
 			return commands.ImportCommand(d, queryflowClient.BackupRestore(), args[0], discoveryPackage.OnConflict(onConflict), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"))
 		},
 		Args: cobra.ExactArgs(1),
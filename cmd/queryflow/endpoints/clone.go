@@ -0,0 +1,44 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the endpoint clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <endpoint>",
+		Short: "The command that clones endpoints in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongClone, "endpoint", "QueryFlow"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchCloneCommand(args[0], d, queryflowClient.Endpoints(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Clone an endpoint by name
+	discovery queryflow endpoint clone my-endpoint --name my-endpoint-copy --uri /my-endpoint-copy
+
+	# Clone an endpoint by id and add labels to the copy
+	discovery queryflow endpoint clone ea02fc14-f07b-49f2-b185-e9ceaedcb367 --name my-endpoint-copy --uri /my-endpoint-copy --labels env:dev --labels draft`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, true)
+	return clone
+}
@@ -0,0 +1,46 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewDisableCommand creates the endpoint disable command.
+func NewDisableCommand(d cli.Discovery) *cobra.Command {
+	var flags commands.EnableFlags
+	disable := &cobra.Command{
+		Use:   "disable [<endpoint>...]",
+		Short: "The command that disables endpoints in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongDisable, "endpoint", "QueryFlow"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchEnableCommand(args, d, queryflowClient.Endpoints(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), flags, false)
+		},
+		Example: `	# Disable an endpoint by name
+	discovery queryflow endpoint disable my-endpoint
+
+	# Disable several endpoints by name or id
+	discovery queryflow endpoint disable my-endpoint ea02fc14-f07b-49f2-b185-e9ceaedcb367
+
+	# Disable every endpoint with the label env:prod
+	discovery queryflow endpoint disable --filter label=env:prod`,
+	}
+
+	commands.AddEnableFlags(disable, &flags)
+	return disable
+}
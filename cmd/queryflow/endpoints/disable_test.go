@@ -0,0 +1,212 @@
+package endpoints
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewDisableCommand tests the NewDisableCommand() function.
+func TestNewDisableCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Disable by name returns the result",
+			args:      []string{"my-endpoint"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewDisableCommand_Out_DisableByNameReturnsArray",
+			errGolden: "NewDisableCommand_Err_DisableByNameReturnsArray",
+			outBytes:  testutils.Read(t, "NewDisableCommand_Out_DisableByNameReturnsArray"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/entrypoint/endpoint/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"type": "default",
+					"name": "my-endpoint",
+					"labels": [],
+					"active": true,
+					"id": "ea02fc14-f07b-49f2-b185-e9ceaedcb367",
+					"creationTimestamp": "2025-10-17T22:37:57Z",
+					"lastUpdatedTimestamp": "2025-10-17T22:37:57Z"
+				},
+				"highlight": {},
+				"score": 1.4854797
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"pageNumber": 0,
+			"numberOfElements": 1
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+				"GET:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"type": "default",
+				"name": "my-endpoint",
+				"labels": [],
+				"active": true,
+				"id": "ea02fc14-f07b-49f2-b185-e9ceaedcb367",
+				"creationTimestamp": "2025-10-17T22:37:57Z",
+				"lastUpdatedTimestamp": "2025-10-17T22:37:57Z"
+			}`,
+					ContentType: "application/json",
+				},
+				"PATCH:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367/disable": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"acknowledged": true}`,
+					ContentType: "application/json",
+				},
+			},
+			err: nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"my-endpoint"},
+			outGolden: "NewDisableCommand_Out_NoURL",
+			errGolden: "NewDisableCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewDisableCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery QueryFlow URL is missing for profile \"default\".\nTo set the URL for the Discovery QueryFlow API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery queryflow config --profile \"default\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader("")
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.queryflow_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.queryflow_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			disableCmd := NewDisableCommand(d)
+
+			disableCmd.SilenceUsage = true
+			disableCmd.SetIn(ios.In)
+			disableCmd.SetOut(ios.Out)
+			disableCmd.SetErr(ios.Err)
+
+			disableCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			disableCmd.SetArgs(tc.args)
+
+			err := disableCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewDisableCommand_NoProfileFlag tests the NewDisableCommand when the profile flag was not defined.
+func TestNewDisableCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.queryflow_url", "test")
+	vpr.Set("default.queryflow_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	disableCmd := NewDisableCommand(d)
+
+	disableCmd.SetIn(ios.In)
+	disableCmd.SetOut(ios.Out)
+	disableCmd.SetErr(ios.Err)
+
+	disableCmd.SetArgs([]string{"my-endpoint"})
+
+	err := disableCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewDisableCommand_Out_NoProfile", testutils.Read(t, "NewDisableCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewDisableCommand_Err_NoProfile", testutils.Read(t, "NewDisableCommand_Err_NoProfile"), errBuf.Bytes())
+}
@@ -0,0 +1,46 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewEnableCommand creates the endpoint enable command.
+func NewEnableCommand(d cli.Discovery) *cobra.Command {
+	var flags commands.EnableFlags
+	enable := &cobra.Command{
+		Use:   "enable [<endpoint>...]",
+		Short: "The command that enables endpoints in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongEnable, "endpoint", "QueryFlow"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchEnableCommand(args, d, queryflowClient.Endpoints(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), flags, true)
+		},
+		Example: `	# Enable an endpoint by name
+	discovery queryflow endpoint enable my-endpoint
+
+	# Enable several endpoints by name or id
+	discovery queryflow endpoint enable my-endpoint ea02fc14-f07b-49f2-b185-e9ceaedcb367
+
+	# Enable every endpoint with the label env:prod
+	discovery queryflow endpoint enable --filter label=env:prod`,
+	}
+
+	commands.AddEnableFlags(enable, &flags)
+	return enable
+}
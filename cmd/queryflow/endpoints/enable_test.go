@@ -0,0 +1,212 @@
+package endpoints
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewEnableCommand tests the NewEnableCommand() function.
+func TestNewEnableCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Enable by name returns the result",
+			args:      []string{"my-endpoint"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewEnableCommand_Out_EnableByNameReturnsArray",
+			errGolden: "NewEnableCommand_Err_EnableByNameReturnsArray",
+			outBytes:  testutils.Read(t, "NewEnableCommand_Out_EnableByNameReturnsArray"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/entrypoint/endpoint/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"type": "default",
+					"name": "my-endpoint",
+					"labels": [],
+					"active": true,
+					"id": "ea02fc14-f07b-49f2-b185-e9ceaedcb367",
+					"creationTimestamp": "2025-10-17T22:37:57Z",
+					"lastUpdatedTimestamp": "2025-10-17T22:37:57Z"
+				},
+				"highlight": {},
+				"score": 1.4854797
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"pageNumber": 0,
+			"numberOfElements": 1
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+				"GET:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"type": "default",
+				"name": "my-endpoint",
+				"labels": [],
+				"active": true,
+				"id": "ea02fc14-f07b-49f2-b185-e9ceaedcb367",
+				"creationTimestamp": "2025-10-17T22:37:57Z",
+				"lastUpdatedTimestamp": "2025-10-17T22:37:57Z"
+			}`,
+					ContentType: "application/json",
+				},
+				"PATCH:/v2/entrypoint/endpoint/ea02fc14-f07b-49f2-b185-e9ceaedcb367/enable": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"acknowledged": true}`,
+					ContentType: "application/json",
+				},
+			},
+			err: nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"my-endpoint"},
+			outGolden: "NewEnableCommand_Out_NoURL",
+			errGolden: "NewEnableCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewEnableCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery QueryFlow URL is missing for profile \"default\".\nTo set the URL for the Discovery QueryFlow API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery queryflow config --profile \"default\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader("")
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.queryflow_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.queryflow_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			enableCmd := NewEnableCommand(d)
+
+			enableCmd.SilenceUsage = true
+			enableCmd.SetIn(ios.In)
+			enableCmd.SetOut(ios.Out)
+			enableCmd.SetErr(ios.Err)
+
+			enableCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			enableCmd.SetArgs(tc.args)
+
+			err := enableCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewEnableCommand_NoProfileFlag tests the NewEnableCommand when the profile flag was not defined.
+func TestNewEnableCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.queryflow_url", "test")
+	vpr.Set("default.queryflow_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	enableCmd := NewEnableCommand(d)
+
+	enableCmd.SetIn(ios.In)
+	enableCmd.SetOut(ios.Out)
+	enableCmd.SetErr(ios.Err)
+
+	enableCmd.SetArgs([]string{"my-endpoint"})
+
+	err := enableCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewEnableCommand_Out_NoProfile", testutils.Read(t, "NewEnableCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewEnableCommand_Err_NoProfile", testutils.Read(t, "NewEnableCommand_Err_NoProfile"), errBuf.Bytes())
+}
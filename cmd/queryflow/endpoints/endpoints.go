@@ -14,7 +14,11 @@ func NewEndpointCommand(d cli.Discovery) *cobra.Command {
 
 	endpoint.AddCommand(NewGetCommand(d))
 	endpoint.AddCommand(NewStoreCommand(d))
+	endpoint.AddCommand(NewCloneCommand(d))
 	endpoint.AddCommand(NewDeleteCommand(d))
+	endpoint.AddCommand(NewEnableCommand(d))
+	endpoint.AddCommand(NewDisableCommand(d))
+	endpoint.AddCommand(NewInvokeCommand(d))
 
 	return endpoint
 }
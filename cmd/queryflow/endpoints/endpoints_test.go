@@ -47,6 +47,6 @@ func TestNewEndpointCommand(t *testing.T) {
 		}
 	}
 
-	expectedCommands := []string{"delete", "get", "store"}
+	expectedCommands := []string{"clone", "delete", "disable", "enable", "get", "invoke", "store"}
 	assert.Equal(t, expectedCommands, commandNames)
 }
@@ -0,0 +1,137 @@
+package endpoints
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+	"github.com/tidwall/gjson"
+)
+
+// readBody reads the body of the request from the file. If the file is "-", the body is read from the standard input.
+func readBody(d cli.Discovery, file string) (string, error) {
+	var body []byte
+	var err error
+	if file == "-" {
+		body, err = io.ReadAll(d.IOStreams().In)
+		if err != nil {
+			return "", cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not read the body from the standard input")
+		}
+	} else {
+		body, err = os.ReadFile(file)
+		if err != nil {
+			err = cli.NormalizeReadFileError(file, err)
+			return "", cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not read file %q", file)
+		}
+	}
+
+	if strings.TrimSpace(string(body)) == "" {
+		return "", cli.NewError(cli.ErrorExitCode, commands.DataEmptyError)
+	}
+	return string(body), nil
+}
+
+// NewInvokeCommand creates the endpoint invoke command.
+func NewInvokeCommand(d cli.Discovery) *cobra.Command {
+	var (
+		method      string
+		query       []string
+		data        string
+		file        string
+		debug       bool
+		raw         bool
+		debugConfig cli.DebugConfig
+	)
+	invoke := &cobra.Command{
+		Use:   "invoke <uri> [flags]",
+		Short: "The command that calls an endpoint of Discovery QueryFlow.",
+		Long:  "invoke is the command used to call an endpoint of Discovery QueryFlow with the URL and API key of the profile. The --method flag sets the HTTP method of the request, which is GET by default, or POST if the request has a body. The --query flag adds a query parameter in the format {key}={value} and can be used several times. The body is a JSON sent with the --data flag, or read from the file of the --file flag. If the file is \"-\", the body is read from the standard input. With the --debug flag, the Debug version of the endpoint is called, which returns the input and output of every state of its pipeline. The trace is rendered with the states in the order in which they were executed, the names and durations of their processors, and the transitions between them. The payloads longer than the bytes of the --collapse flag are replaced by a summary, and the --processor flag only shows the output of the processor with that name or UUID. With the --raw flag, the trace is printed as the JSON returned by QueryFlow. If the endpoint returns an HTTP error, the command fails with its status and body.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			err = commands.CheckCredentials(d, profile, "QueryFlow", "queryflow_url")
+			if err != nil {
+				return err
+			}
+
+			if !debug && (raw || cmd.Flags().Changed("collapse") || debugConfig.Processor != "") {
+				return cli.NewError(cli.ErrorExitCode, "The --raw, --collapse and --processor flags can only be used with the --debug flag")
+			}
+			if raw && (cmd.Flags().Changed("collapse") || debugConfig.Processor != "") {
+				return cli.NewError(cli.ErrorExitCode, "The --collapse and --processor flags cannot be used with the --raw flag")
+			}
+			if debugConfig.Collapse < 0 {
+				return cli.NewError(cli.ErrorExitCode, "Invalid collapse size %d. The size cannot be negative", debugConfig.Collapse)
+			}
+
+			if data != "" && file != "" {
+				return cli.NewError(cli.ErrorExitCode, "The --data and --file flags cannot be used at the same time")
+			}
+
+			body := data
+			if file != "" {
+				body, err = readBody(d, file)
+				if err != nil {
+					return err
+				}
+			}
+			if body != "" && !gjson.Valid(body) {
+				return cli.NewError(cli.ErrorExitCode, "The body of the request is not a valid JSON")
+			}
+
+			config := cli.InvokeConfig{Method: strings.ToUpper(method), Query: query, Body: body, Debug: debug}
+			if config.Method == "" && body != "" {
+				config.Method = http.MethodPost
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			if debug && !raw {
+				return d.DebugEndpoint(queryflowClient, queryflowClient.Processors(), args[0], config, debugConfig)
+			}
+			printer := cli.GetObjectPrinter(vpr.GetString("output"))
+			return d.InvokeEndpoint(queryflowClient, args[0], config, printer)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Call an endpoint with GET and a query parameter
+	discovery queryflow endpoint invoke my/search -q q=shoes
+
+	# Call an endpoint with a JSON body, which is sent with POST
+	discovery queryflow endpoint invoke my/search -d '{"query":"shoes","size":5}'
+
+	# Call an endpoint with PUT and the body of a file
+	discovery queryflow endpoint invoke my/search -X PUT --file body.json
+
+	# Read the body from the standard input and call the Debug version of the endpoint
+	cat body.json | discovery queryflow endpoint invoke my/search --file - --debug
+
+	# Show only the output of a processor in the trace of the Debug version of the endpoint
+	discovery queryflow endpoint invoke my/search -q q=shoes --debug --processor my-processor
+
+	# Print the trace of the Debug version of the endpoint as the JSON returned by QueryFlow
+	discovery queryflow endpoint invoke my/search -q q=shoes --debug --raw`,
+	}
+
+	invoke.Flags().StringVarP(&method, "method", "X", "", "the HTTP method of the request. It is GET by default, or POST if the request has a body")
+	invoke.Flags().StringArrayVarP(&query, "query", "q", []string{}, "a query parameter in the format {key}={value}. It can be used several times")
+	invoke.Flags().StringVarP(&data, "data", "d", "", "the JSON body of the request")
+	invoke.Flags().StringVar(&file, "file", "", "the file with the JSON body of the request. If it is \"-\", the body is read from the standard input")
+	invoke.Flags().BoolVar(&debug, "debug", false, "call the Debug version of the endpoint and render its trace")
+	invoke.Flags().BoolVar(&raw, "raw", false, "print the trace of the --debug flag as the JSON returned by QueryFlow")
+	invoke.Flags().IntVar(&debugConfig.Collapse, "collapse", 500, "replace the payloads of the trace that are longer than this number of bytes with a summary. 0 shows every payload")
+	invoke.Flags().StringVar(&debugConfig.Processor, "processor", "", "the name or UUID of the processor whose output is shown in the trace. The output of the rest is hidden")
+	return invoke
+}
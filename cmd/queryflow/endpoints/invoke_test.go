@@ -0,0 +1,411 @@
+package endpoints
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestNewInvokeCommand tests the NewInvokeCommand() function.
+func TestNewInvokeCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		in        string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Invoke with query parameters uses GET",
+			args:      []string{"my/search", "-q", "q=shoes", "--query", "tag=a", "-q", "tag=b"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewInvokeCommand_Out_InvokeWithQueryReturnsObject",
+			errGolden: "NewInvokeCommand_Err_InvokeWithQueryReturnsObject",
+			outBytes:  testutils.Read(t, "NewInvokeCommand_Out_InvokeWithQueryReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/api/my/search": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"hits":[{"id":"1","name":"Running shoes"}],"total":1}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+						assert.Equal(t, "shoes", r.URL.Query().Get("q"))
+						assert.Equal(t, []string{"a", "b"}, r.URL.Query()["tag"])
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Invoke with data uses POST",
+			args:      []string{"/my/search", "-d", `{"query":"shoes"}`},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewInvokeCommand_Out_InvokeWithDataReturnsObject",
+			errGolden: "NewInvokeCommand_Err_InvokeWithDataReturnsObject",
+			outBytes:  testutils.Read(t, "NewInvokeCommand_Out_InvokeWithDataReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/api/my/search": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"hits":[],"total":0}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, `{"query":"shoes"}`, string(body))
+						assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Invoke with a file and a method",
+			args:      []string{"my/search", "-X", "put", "--file", filepath.Join("testdata", "invoke-body.json")},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewInvokeCommand_Out_InvokeWithFileReturnsObject",
+			errGolden: "NewInvokeCommand_Err_InvokeWithFileReturnsObject",
+			outBytes:  testutils.Read(t, "NewInvokeCommand_Out_InvokeWithFileReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"PUT:/v2/api/my/search": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"acknowledged":true}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, `{"query":"shoes","size":5}`, string(body))
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Raw debug with the body from the standard input",
+			args:      []string{"my/search", "--file", "-", "--debug", "--raw"},
+			in:        `{"query":"boots"}`,
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewInvokeCommand_Out_RawDebugWithStdinReturnsObject",
+			errGolden: "NewInvokeCommand_Err_RawDebugWithStdinReturnsObject",
+			outBytes:  testutils.Read(t, "NewInvokeCommand_Out_RawDebugWithStdinReturnsObject"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/debug/my/search": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"states":{"initialState":{"duration":12}}}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, `{"query":"boots"}`, string(body))
+					},
+				},
+			},
+			err: nil,
+		},
+
+		{
+			name:      "Debug renders the trace",
+			args:      []string{"my/search", "-q", "q=everest", "--debug", "--collapse", "40"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewInvokeCommand_Out_DebugRendersTrace",
+			errGolden: "NewInvokeCommand_Err_DebugRendersTrace",
+			outBytes:  testutils.Read(t, "NewInvokeCommand_Out_DebugRendersTrace"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/debug/my/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"duration": 1250,
+			"execution": [
+				{
+				"state": "searchState",
+				"result": [
+					{
+					"processor": "a5ee116b-bd95-474e-9d50-db7be988b196",
+					"output": {"mongo": [{"_id": "3016204f-1dba-435a-bbc3-7072aaa22770", "content": "Mount Everest is Earth's highest mountain above sea level"}]},
+					"duration": 1190
+					}
+				]
+				},
+				{
+				"state": "responseState",
+				"result": {"statusCode": 200, "body": {"total": 1}}
+				}
+			]
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "everest", r.URL.Query().Get("q"))
+					},
+				},
+				"GET:/v2/processor/a5ee116b-bd95-474e-9d50-db7be988b196": {
+					StatusCode: http.StatusOK,
+					Body: `{
+				"type": "mongo",
+				"name": "mongo-processor",
+				"labels": [],
+				"active": true,
+				"id": "a5ee116b-bd95-474e-9d50-db7be988b196",
+				"creationTimestamp": "2025-10-17T22:37:57Z",
+				"lastUpdatedTimestamp": "2025-10-17T22:37:57Z"
+			}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"my/search"},
+			outGolden: "NewInvokeCommand_Out_NoURL",
+			errGolden: "NewInvokeCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery QueryFlow URL is missing for profile \"default\".\nTo set the URL for the Discovery QueryFlow API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery queryflow config --profile \"default\""),
+		},
+		{
+			name:      "Data and file flags are sent",
+			args:      []string{"my/search", "-d", `{"query":"shoes"}`, "--file", "-"},
+			outGolden: "NewInvokeCommand_Out_DataAndFile",
+			errGolden: "NewInvokeCommand_Err_DataAndFile",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_DataAndFile"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The --data and --file flags cannot be used at the same time"),
+		},
+		{
+			name:      "Debug flags are sent without the debug flag",
+			args:      []string{"my/search", "--processor", "mongo-processor"},
+			outGolden: "NewInvokeCommand_Out_DebugFlagsWithoutDebug",
+			errGolden: "NewInvokeCommand_Err_DebugFlagsWithoutDebug",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_DebugFlagsWithoutDebug"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The --raw, --collapse and --processor flags can only be used with the --debug flag"),
+		},
+		{
+			name:      "Render flags are sent with the raw flag",
+			args:      []string{"my/search", "--debug", "--raw", "--collapse", "100"},
+			outGolden: "NewInvokeCommand_Out_RenderFlagsWithRaw",
+			errGolden: "NewInvokeCommand_Err_RenderFlagsWithRaw",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_RenderFlagsWithRaw"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The --collapse and --processor flags cannot be used with the --raw flag"),
+		},
+		{
+			name:      "Collapse size is negative",
+			args:      []string{"my/search", "--debug", "--collapse", "-1"},
+			outGolden: "NewInvokeCommand_Out_NegativeCollapse",
+			errGolden: "NewInvokeCommand_Err_NegativeCollapse",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_NegativeCollapse"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "Invalid collapse size -1. The size cannot be negative"),
+		},
+		{
+			name:      "Body is not a valid JSON",
+			args:      []string{"my/search", "-d", `{"query":`},
+			outGolden: "NewInvokeCommand_Out_InvalidBody",
+			errGolden: "NewInvokeCommand_Err_InvalidBody",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_InvalidBody"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The body of the request is not a valid JSON"),
+		},
+		{
+			name:      "Standard input is empty",
+			args:      []string{"my/search", "--file", "-"},
+			in:        "  \n",
+			outGolden: "NewInvokeCommand_Out_EmptyStdin",
+			errGolden: "NewInvokeCommand_Err_EmptyStdin",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_EmptyStdin"),
+			url:       true,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "Data cannot be empty"),
+		},
+		{
+			name:      "Query parameter does not have a value",
+			args:      []string{"my/search", "-q", "q"},
+			outGolden: "NewInvokeCommand_Out_InvalidQuery",
+			errGolden: "NewInvokeCommand_Err_InvalidQuery",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_InvalidQuery"),
+			url:       true,
+			apiKey:    "apiKey123",
+			responses: map[string]testutils.MockResponse{},
+			err:       cli.NewError(cli.ErrorExitCode, "Query parameter \"q\" does not follow the format {key}={value}"),
+		},
+		{
+			name:      "Endpoint returns HTTP error",
+			args:      []string{"my/search", "-q", "q=shoes"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewInvokeCommand_Out_InvokeHTTPError",
+			errGolden: "NewInvokeCommand_Err_InvokeHTTPError",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewInvokeCommand_Err_InvokeHTTPError"),
+			responses: map[string]testutils.MockResponse{
+				"GET:/v2/api/my/search": {
+					StatusCode: http.StatusBadRequest,
+					Body: `{
+			"status": 400,
+			"code": 3002,
+			"messages": [
+				"Required QueryValue [size] not specified"
+			],
+			"timestamp": "2025-10-23T22:35:38.345647200Z"
+			}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{
+			"status": 400,
+			"code": 3002,
+			"messages": [
+				"Required QueryValue [size] not specified"
+			],
+			"timestamp": "2025-10-23T22:35:38.345647200Z"
+			}`)}, "Could not invoke endpoint \"my/search\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader(tc.in)
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.queryflow_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.queryflow_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			invokeCmd := NewInvokeCommand(d)
+
+			invokeCmd.SilenceUsage = true
+			invokeCmd.SetIn(ios.In)
+			invokeCmd.SetOut(ios.Out)
+			invokeCmd.SetErr(ios.Err)
+
+			invokeCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			invokeCmd.SetArgs(tc.args)
+
+			err := invokeCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewInvokeCommand_NoProfileFlag tests the NewInvokeCommand when the profile flag was not defined.
+func TestNewInvokeCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.queryflow_url", "test")
+	vpr.Set("default.queryflow_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	invokeCmd := NewInvokeCommand(d)
+
+	invokeCmd.SetIn(ios.In)
+	invokeCmd.SetOut(ios.Out)
+	invokeCmd.SetErr(ios.Err)
+
+	invokeCmd.SetArgs([]string{"my/search"})
+
+	err := invokeCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewInvokeCommand_Out_NoProfile", testutils.Read(t, "NewInvokeCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewInvokeCommand_Err_NoProfile", testutils.Read(t, "NewInvokeCommand_Err_NoProfile"), errBuf.Bytes())
+}
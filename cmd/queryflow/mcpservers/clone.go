@@ -0,0 +1,44 @@
+package mcpservers
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the mcp-server clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <mcp-server>",
+		Short: "The command that clones MCP servers in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongClone, "MCP server", "QueryFlow"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchCloneCommand(args[0], d, queryflowClient.MCPServers(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Clone an MCP server by name
+	discovery queryflow mcp-server clone my-mcp-server --name my-mcp-server-copy --uri /my-mcp-server-copy
+
+	# Clone an MCP server by id and add labels to the copy
+	discovery queryflow mcp-server clone 4957145b-6192-4862-a5da-e97853974e9f --name my-mcp-server-copy --uri /my-mcp-server-copy --labels env:dev --labels draft`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, true)
+	return clone
+}
@@ -0,0 +1,46 @@
+package mcpservers
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewDisableCommand creates the mcp-server disable command.
+func NewDisableCommand(d cli.Discovery) *cobra.Command {
+	var flags commands.EnableFlags
+	disable := &cobra.Command{
+		Use:   "disable [<mcp-server>...]",
+		Short: "The command that disables MCP servers in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongDisable, "MCP server", "QueryFlow"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchEnableCommand(args, d, queryflowClient.MCPServers(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), flags, false)
+		},
+		Example: `	# Disable an MCP server by name
+	discovery queryflow mcp-server disable my-mcp-server
+
+	# Disable several MCP servers by name or id
+	discovery queryflow mcp-server disable my-mcp-server 4957145b-6192-4862-a5da-e97853974e9f
+
+	# Disable every MCP server with the label env:prod
+	discovery queryflow mcp-server disable --filter label=env:prod`,
+	}
+
+	commands.AddEnableFlags(disable, &flags)
+	return disable
+}
@@ -0,0 +1,46 @@
+package mcpservers
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewEnableCommand creates the mcp-server enable command.
+func NewEnableCommand(d cli.Discovery) *cobra.Command {
+	var flags commands.EnableFlags
+	enable := &cobra.Command{
+		Use:   "enable [<mcp-server>...]",
+		Short: "The command that enables MCP servers in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongEnable, "MCP server", "QueryFlow"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchEnableCommand(args, d, queryflowClient.MCPServers(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), flags, true)
+		},
+		Example: `	# Enable an MCP server by name
+	discovery queryflow mcp-server enable my-mcp-server
+
+	# Enable several MCP servers by name or id
+	discovery queryflow mcp-server enable my-mcp-server 4957145b-6192-4862-a5da-e97853974e9f
+
+	# Enable every MCP server with the label env:prod
+	discovery queryflow mcp-server enable --filter label=env:prod`,
+	}
+
+	commands.AddEnableFlags(enable, &flags)
+	return enable
+}
@@ -11,7 +11,11 @@ import (
 
 // NewGetCommand creates the mcp-server get command.
 func NewGetCommand(d cli.Discovery) *cobra.Command {
-	var filters []string
+	var (
+		filters    []string
+		filterJSON string
+		list       commands.ListFlags
+	)
 	get := &cobra.Command{
 		Use:   "get [<mcp-server>]",
 		Short: "The command that obtains MCP servers from Discovery QueryFlow.",
@@ -24,8 +28,12 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"))
-			return commands.SearchCommand(args, d, queryflowClient.MCPServers(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), &filters)
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchCommand(args, d, queryflowClient.MCPServers(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), &filters, filterJSON, list)
 		},
 		Args: cobra.MaximumNArgs(1),
 		Example: `	# Get an MCP server by name
@@ -33,12 +41,27 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 	
 	# Get MCP servers using filters
 	discovery queryflow mcp-server get --filter label=A:B
+	
+	# Get MCP servers whose name starts with "my-" or that were updated after October 1st, 2026
+	discovery queryflow mcp-server get --filter 'name=my-* | updated>2026-10-01'
+	
+	# Get MCP servers using a filter written in Discovery's DSL
+	discovery queryflow mcp-server get --filter-json '{"exists":{"field":"labels"}}'
 
 	# Get all MCP servers using the configuration in profile "cn"
-	discovery queryflow mcp-server get -p cn`,
+	discovery queryflow mcp-server get -p cn
+
+	# Get the 10 most recently created MCP servers
+	discovery queryflow mcp-server get --sort creationTimestamp,desc --limit 10`,
 	}
 
-	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filters in the format "filter=key:value". The available filter is:
-- Label: The format is label={key}[:{value}], where the value is optional`)
+	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filter expressions. The filters are combined through the "and" operator when the flag is repeated. The available conditions are:
+- Label: The format is label={key}[:{value}], where the value is optional
+- Name: The format is name={name}, where the name can have the * and ? wildcards
+- Field: The format is field={path}{:|>|>=|<|<=}{value}, like field=config.index:products or field=config.batchSize>=10
+- Timestamps: The formats are created{>|>=|<|<=}{date} and updated{>|>=|<|<=}{date}, where the date is YYYY-MM-DD or an RFC 3339 timestamp
+The conditions can be negated with != or !, grouped with parentheses and combined with & (and) and | (or). The values with spaces or special characters can be quoted with double quotes`)
+	get.Flags().StringVar(&filterJSON, "filter-json", "", "apply a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
+	commands.AddListFlags(get, &list)
 	return get
 }
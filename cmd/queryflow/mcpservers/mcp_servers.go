@@ -15,7 +15,10 @@ func NewMCPServerCommand(d cli.Discovery) *cobra.Command {
 
 	mcpServer.AddCommand(NewGetCommand(d))
 	mcpServer.AddCommand(NewStoreCommand(d))
+	mcpServer.AddCommand(NewCloneCommand(d))
 	mcpServer.AddCommand(NewDeleteCommand(d))
+	mcpServer.AddCommand(NewEnableCommand(d))
+	mcpServer.AddCommand(NewDisableCommand(d))
 	mcpServer.AddCommand(tools.NewToolCommand(d))
 
 	return mcpServer
@@ -47,6 +47,6 @@ func TestNewMCPServerCommand(t *testing.T) {
 		}
 	}
 
-	expectedCommands := []string{"delete", "get", "store", "tool"}
+	expectedCommands := []string{"clone", "delete", "disable", "enable", "get", "store", "tool"}
 	assert.Equal(t, expectedCommands, commandNames)
 }
@@ -0,0 +1,54 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the mcp-server tool clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <mcp-server> <mcp-tool>",
+		Short: "The command that clones MCP tools in an MCP server in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongClone, "MCP tool", "QueryFlow") + LongTool,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			err = commands.CheckCredentials(d, profile, "QueryFlow", "queryflow_url")
+			if err != nil {
+				return err
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...).MCPServers()
+			mcpServerID, err := cli.GetEntityId(d, queryflowClient, args[0])
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the MCP server %q", args[0])
+			}
+
+			return commands.SearchCloneCommand(args[1], d, queryflowClient.Tools(mcpServerID), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(2),
+		Example: `	# Clone a tool of an MCP server by name
+	discovery queryflow mcp-server tool clone my-mcp-server my-tool --name my-tool-copy
+
+	# Clone a tool of an MCP server by id and add labels to the copy
+	discovery queryflow mcp-server tool clone my-mcp-server 3d51beef-8b90-40aa-84b5-033241dc6239 --labels env:dev --labels draft`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, false)
+	return clone
+}
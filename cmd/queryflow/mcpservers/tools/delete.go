@@ -28,7 +28,11 @@ func NewDeleteCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key")).MCPServers()
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...).MCPServers()
 			mcpServerID, err := cli.GetEntityId(d, queryflowClient, args[0])
 			if err != nil {
 				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the MCP server %q", args[0])
@@ -68,7 +68,7 @@ func TestNewDeleteCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -224,7 +224,7 @@ func TestNewDeleteCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -411,7 +411,7 @@ func TestNewDeleteCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -559,7 +559,7 @@ func TestNewDeleteCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -733,7 +733,7 @@ func TestNewDeleteCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
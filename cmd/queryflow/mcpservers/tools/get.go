@@ -11,7 +11,11 @@ import (
 
 // NewGetCommand creates the mcp-server tool get command.
 func NewGetCommand(d cli.Discovery) *cobra.Command {
-	var filters []string
+	var (
+		filters    []string
+		filterJSON string
+		list       commands.ListFlags
+	)
 	get := &cobra.Command{
 		Use:   "get <mcp-server> [<tool>]",
 		Short: "The command that obtains tools from MCP servers in Discovery QueryFlow.",
@@ -29,13 +33,17 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key")).MCPServers()
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...).MCPServers()
 			mcpServerID, err := cli.GetEntityId(d, queryflowClient, args[0])
 			if err != nil {
 				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the MCP server %q", args[0])
 			}
 
-			return commands.SearchCommand(args[1:], d, queryflowClient.Tools(mcpServerID), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), &filters)
+			return commands.SearchCommand(args[1:], d, queryflowClient.Tools(mcpServerID), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), &filters, filterJSON, list)
 		},
 		Args: cobra.RangeArgs(1, 2),
 		Example: `	# Get an MCP server's tool by name
@@ -43,12 +51,27 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 	
 	# Get the tools of an MCP server using filters
 	discovery queryflow mcp-server tool get my-mcp-server --filter label=A:B
+	
+	# Get the tools of an MCP server whose name starts with "my-" or that were updated after October 1st, 2026
+	discovery queryflow mcp-server tool get my-mcp-server --filter 'name=my-* | updated>2026-10-01'
+	
+	# Get the tools of an MCP server using a filter written in Discovery's DSL
+	discovery queryflow mcp-server tool get my-mcp-server --filter-json '{"exists":{"field":"labels"}}'
 
 	# Get all the tools of an MCP server using the configuration in profile "cn"
-	discovery queryflow mcp-server tool get my-mcp-server -p cn`,
+	discovery queryflow mcp-server tool get my-mcp-server -p cn
+
+	# Get the 10 most recently created tools of an MCP server
+	discovery queryflow mcp-server tool get my-mcp-server --sort creationTimestamp,desc --limit 10`,
 	}
 
-	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filters in the format "filter=key:value". The available filter is:
-- Label: The format is label={key}[:{value}], where the value is optional`)
+	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filter expressions. The filters are combined through the "and" operator when the flag is repeated. The available conditions are:
+- Label: The format is label={key}[:{value}], where the value is optional
+- Name: The format is name={name}, where the name can have the * and ? wildcards
+- Field: The format is field={path}{:|>|>=|<|<=}{value}, like field=config.index:products or field=config.batchSize>=10
+- Timestamps: The formats are created{>|>=|<|<=}{date} and updated{>|>=|<|<=}{date}, where the date is YYYY-MM-DD or an RFC 3339 timestamp
+The conditions can be negated with != or !, grouped with parentheses and combined with & (and) and | (or). The values with spaces or special characters can be quoted with double quotes`)
+	get.Flags().StringVar(&filterJSON, "filter-json", "", "apply a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
+	commands.AddListFlags(get, &list)
 	return get
 }
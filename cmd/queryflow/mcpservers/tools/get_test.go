@@ -68,7 +68,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -253,7 +253,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -400,7 +400,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -573,7 +573,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -696,6 +696,11 @@ func TestNewGetCommand(t *testing.T) {
 			url:       true,
 			apiKey:    "apiKey123",
 			responses: map[string]testutils.MockResponse{
+				"GET:/v2/entrypoint/mcp-server/4957145b-6192-4862-a5da-e97853974e9f/tool/3b32e410-2f33-412d-9fb8-17970131921c": {
+					StatusCode:  http.StatusNotFound,
+					Body:        `{"status":404,"code":1003,"messages":["Entity not found: 3b32e410-2f33-412d-9fb8-17970131921c"]}`,
+					ContentType: "application/json",
+				},
 				"POST:/v2/entrypoint/mcp-server/search": {
 					StatusCode: http.StatusOK,
 					Body: `{
@@ -721,7 +726,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -844,7 +849,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -953,7 +958,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -1079,7 +1084,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -1188,7 +1193,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -1351,7 +1356,7 @@ func TestNewGetCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
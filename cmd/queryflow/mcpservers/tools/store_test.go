@@ -84,7 +84,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -242,7 +242,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -455,7 +455,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -665,7 +665,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -860,7 +860,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -961,7 +961,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -1064,7 +1064,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -1174,7 +1174,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -1303,7 +1303,7 @@ func TestNewStoreCommand(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-mcp-server",
+					"name": "my-mcp-server clone",
 					"labels": [
 					{
 						"key": "A",
@@ -1499,7 +1499,7 @@ func TestNewStoreCommand_MultipleFiles(t *testing.T) {
 		{
 		"source": {
 			"type": "mongo",
-			"name": "my-mcp-server",
+			"name": "my-mcp-server clone",
 			"labels": [
 			{
 				"key": "A",
@@ -0,0 +1,47 @@
+package pipelines
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the pipeline clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <pipeline>",
+		Short: "The command that clones pipelines in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongClone, "pipeline", "QueryFlow"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchCloneCommand(args[0], d, queryflowClient.Pipelines(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Clone a pipeline by name
+	discovery queryflow pipeline clone my-pipeline --name my-pipeline-copy
+
+	# Clone a pipeline by id and add labels to the copy
+	discovery queryflow pipeline clone 04536687-f083-4353-8ecc-b7348e14b748 --name my-pipeline-copy --labels env:dev --labels draft
+
+	# Clone a pipeline and the entities it references
+	discovery queryflow pipeline clone my-pipeline --name my-pipeline-copy --deep`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, false)
+	return clone
+}
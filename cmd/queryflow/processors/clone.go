@@ -0,0 +1,47 @@
+package processors
+
+import (
+	"fmt"
+
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCloneCommand creates the processor clone command.
+func NewCloneCommand(d cli.Discovery) *cobra.Command {
+	var cloneConfig cli.CloneConfig
+	clone := &cobra.Command{
+		Use:   "clone <processor>",
+		Short: "The command that clones processors in Discovery QueryFlow.",
+		Long:  fmt.Sprintf(commands.LongClone, "processor", "QueryFlow"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "queryflow")
+			if err != nil {
+				return err
+			}
+			queryflowClient := discoveryPackage.NewQueryFlow(vpr.GetString(profile+".queryflow_url"), vpr.GetString(profile+".queryflow_key"), options...)
+			return commands.SearchCloneCommand(args[0], d, queryflowClient.Processors(), commands.GetCommandConfig(profile, vpr.GetString("output"), "QueryFlow", "queryflow_url"), cloneConfig)
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Clone a processor by name
+	discovery queryflow processor clone my-processor --name my-processor-copy
+
+	# Clone a processor by id and add labels to the copy
+	discovery queryflow processor clone 189b3fa5-e011-43aa-ae57-f6e4a6f4b552 --name my-processor-copy --labels env:dev --labels draft
+
+	# Clone a processor and the entities it references
+	discovery queryflow processor clone my-processor --name my-processor-copy --deep`,
+	}
+
+	commands.AddCloneFlags(clone, &cloneConfig, false)
+	return clone
+}
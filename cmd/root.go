@@ -1,9 +1,14 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/pureinsights/discovery-cli/cmd/backuprestore"
+	"github.com/pureinsights/discovery-cli/cmd/commands"
 	"github.com/pureinsights/discovery-cli/cmd/config"
 	"github.com/pureinsights/discovery-cli/cmd/core"
 	"github.com/pureinsights/discovery-cli/cmd/deploy"
@@ -12,6 +17,7 @@ import (
 	"github.com/pureinsights/discovery-cli/cmd/staging"
 	"github.com/pureinsights/discovery-cli/cmd/statuscheck"
 	"github.com/pureinsights/discovery-cli/cmd/version"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/pureinsights/discovery-cli/internal/cli"
 	"github.com/pureinsights/discovery-cli/internal/iostreams"
 	"github.com/spf13/cobra"
@@ -41,6 +47,74 @@ func newRootCommand(d cli.Discovery) *cobra.Command {
 
 	d.Config().BindPFlag("profile", discovery.PersistentFlags().Lookup("profile"))
 
+	discovery.PersistentFlags().Duration(
+		"timeout",
+		0,
+		"maximum duration of each request to Discovery, like 30s or 2m. It overrides the timeout of the profile",
+	)
+
+	d.Config().BindPFlag("timeout", discovery.PersistentFlags().Lookup("timeout"))
+
+	discovery.PersistentFlags().Int(
+		"retries",
+		discoveryPackage.DefaultMaxRetries,
+		"maximum number of times a request to Discovery that fails with a transient error is retried. It overrides the retries of the profile",
+	)
+
+	d.Config().BindPFlag("retries", discovery.PersistentFlags().Lookup("retries"))
+
+	discovery.PersistentFlags().Duration(
+		"retry-wait",
+		discoveryPackage.DefaultWaitTime,
+		"base wait time before retrying a request to Discovery, which doubles with each retry. It overrides the retry_wait of the profile",
+	)
+
+	d.Config().BindPFlag("retry_wait", discovery.PersistentFlags().Lookup("retry-wait"))
+
+	discovery.PersistentFlags().Int(
+		"concurrency",
+		1,
+		"maximum number of pages requested at the same time when listing entities. It overrides the concurrency of the profile",
+	)
+
+	d.Config().BindPFlag("concurrency", discovery.PersistentFlags().Lookup("concurrency"))
+
+	discovery.PersistentFlags().Float64(
+		"rate-limit",
+		0,
+		"maximum number of requests per second sent to each Discovery product. Zero means no limit. It overrides the rate_limit of the profile",
+	)
+
+	d.Config().BindPFlag("rate_limit", discovery.PersistentFlags().Lookup("rate-limit"))
+
+	discovery.PersistentFlags().Bool(
+		"verbose",
+		false,
+		"print the method, URL, status and duration of every request sent to Discovery to the error stream",
+	)
+
+	d.Config().BindPFlag("verbose", discovery.PersistentFlags().Lookup("verbose"))
+
+	discovery.PersistentFlags().Bool(
+		"trace",
+		false,
+		"print every request sent to Discovery and its response, including their headers and bodies, to the error stream. The API keys are redacted",
+	)
+
+	d.Config().BindPFlag("trace", discovery.PersistentFlags().Lookup("trace"))
+
+	discovery.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		profile, err := cmd.Flags().GetString("profile")
+		if err != nil {
+			return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+		}
+
+		if config, _ := commands.RequestTransportConfig(d.Config(), profile); config.InsecureSkipVerify {
+			fmt.Fprintf(ios.Err, "WARNING: TLS certificate verification is disabled by the insecure_skip_verify property of the %q profile. The connections to Discovery are not secure.\n", profile)
+		}
+		return nil
+	}
+
 	discovery.AddCommand(config.NewConfigCommand(d))
 	discovery.AddCommand(backuprestore.NewExportCommand(d))
 	discovery.AddCommand(backuprestore.NewImportCommand(d))
@@ -58,6 +132,7 @@ func newRootCommand(d cli.Discovery) *cobra.Command {
 }
 
 // Run executes the Root command.
+// The command's context is canceled when the CLI receives an interrupt or termination signal, which cancels the in-flight requests to Discovery.
 func Run() (cli.ExitCode, error) {
 	ios := iostreams.IOStreams{
 		In:  os.Stdin,
@@ -78,7 +153,11 @@ func Run() (cli.ExitCode, error) {
 	}
 	d := cli.NewDiscovery(&ios, viper, configPath)
 	root := newRootCommand(d)
-	err = root.Execute()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err = root.ExecuteContext(ctx)
 	if err != nil {
 		cliError := cli.FromError(err)
 		return cliError.ExitCode, cliError
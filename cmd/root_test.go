@@ -61,6 +61,146 @@ func Test_newRootCommand(t *testing.T) {
 	assert.Equal(t, expectedCommands, commandNames)
 }
 
+// Test_newRootCommand_clientOptionFlags tests that the timeout, retry and concurrency flags are bound to Viper and validated by the commands that create Discovery clients.
+func Test_newRootCommand_clientOptionFlags(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		config map[string]string
+		err    error
+	}{
+		{
+			name: "The timeout flag is valid",
+			args: []string{"version", "--timeout", "30s"},
+			err:  nil,
+		},
+		{
+			name: "The profile's timeout is valid",
+			args: []string{"version"},
+			config: map[string]string{
+				"default.timeout": "45",
+			},
+			err: nil,
+		},
+		{
+			name: "The profile's timeout is invalid",
+			args: []string{"core", "label", "get"},
+			config: map[string]string{
+				"default.timeout": "forever",
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("time: invalid duration \"forever\""), "Invalid timeout \"forever\". The timeout must be a duration, like \"30s\" or \"2m\""),
+		},
+		{
+			name: "The profile's invalid timeout does not fail the version command",
+			args: []string{"version"},
+			config: map[string]string{
+				"default.timeout": "forever",
+			},
+			err: nil,
+		},
+		{
+			name: "The invalid retries flag does not fail the config command",
+			args: []string{"config", "get", "--retries", "-2"},
+			err:  nil,
+		},
+		{
+			name: "The retries flag is invalid",
+			args: []string{"core", "label", "get", "--retries", "-2"},
+			err:  cli.NewError(cli.ErrorExitCode, "Invalid number of retries \"-2\". The number of retries must be a non-negative integer"),
+		},
+		{
+			name: "The concurrency flag is valid",
+			args: []string{"version", "--concurrency", "4"},
+			err:  nil,
+		},
+		{
+			name: "The verbose and trace flags are valid",
+			args: []string{"version", "--verbose", "--trace"},
+			err:  nil,
+		},
+		{
+			name: "The profile's TLS settings are invalid",
+			args: []string{"core", "label", "get"},
+			config: map[string]string{
+				"default.insecure_skip_verify": "yes please",
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("strconv.ParseBool: parsing \"yes please\": invalid syntax"), "Invalid value \"yes please\" for insecure_skip_verify. It must be true or false"),
+		},
+		{
+			name: "The rate limit flag is valid",
+			args: []string{"version", "--rate-limit", "2.5"},
+			err:  nil,
+		},
+		{
+			name: "The rate limit flag is invalid",
+			args: []string{"core", "label", "get", "--rate-limit", "-3"},
+			err:  cli.NewError(cli.ErrorExitCode, "Invalid rate limit \"-3\". The rate limit must be a non-negative number of requests per second"),
+		},
+		{
+			name: "The concurrency flag is invalid",
+			args: []string{"core", "label", "get", "--concurrency", "0"},
+			err:  cli.NewError(cli.ErrorExitCode, "Invalid concurrency \"0\". The concurrency must be a positive integer"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ios := iostreams.IOStreams{
+				In:  strings.NewReader(""),
+				Out: &bytes.Buffer{},
+				Err: &bytes.Buffer{},
+			}
+
+			vpr := viper.New()
+			vpr.SetDefault("profile", "default")
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+			discoveryCmd := newRootCommand(d)
+			discoveryCmd.SetArgs(tc.args)
+
+			err := discoveryCmd.Execute()
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if slices.Contains(tc.args, "--timeout") {
+				assert.Equal(t, "30s", vpr.GetString("timeout"))
+			}
+		})
+	}
+}
+
+// Test_newRootCommand_insecureSkipVerify tests that a warning is written to the error stream when the TLS certificate verification is disabled.
+func Test_newRootCommand_insecureSkipVerify(t *testing.T) {
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  strings.NewReader(""),
+		Out: &bytes.Buffer{},
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.SetDefault("profile", "default")
+	vpr.Set("dev.insecure_skip_verify", "true")
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	discoveryCmd := newRootCommand(d)
+	discoveryCmd.SetArgs([]string{"version", "--profile", "dev"})
+	require.NoError(t, discoveryCmd.Execute())
+	assert.Equal(t, "WARNING: TLS certificate verification is disabled by the insecure_skip_verify property of the \"dev\" profile. The connections to Discovery are not secure.\n", errBuf.String())
+
+	errBuf.Reset()
+	discoveryCmd = newRootCommand(d)
+	discoveryCmd.SetArgs([]string{"version", "--profile", "default"})
+	require.NoError(t, discoveryCmd.Execute())
+	assert.Empty(t, errBuf.String())
+}
+
 // Test_newRootCommand_versionFlag tests when the discovery command is run with the version flag.
 func Test_newRootCommand_versionFlag(t *testing.T) {
 	in := strings.NewReader("In Reader")
@@ -1,6 +1,7 @@
 package buckets
 
 import (
+	"github.com/pureinsights/discovery-cli/cmd/staging/buckets/indices"
 	"github.com/pureinsights/discovery-cli/internal/cli"
 	"github.com/spf13/cobra"
 )
@@ -16,6 +17,8 @@ func NewBucketCommand(d cli.Discovery) *cobra.Command {
 	bucket.AddCommand(NewStoreCommand(d))
 	bucket.AddCommand(NewDeleteCommand(d))
 	bucket.AddCommand(NewDumpCommand(d))
+	bucket.AddCommand(NewPurgeCommand(d))
+	bucket.AddCommand(indices.NewIndexCommand(d))
 
 	return bucket
 }
@@ -21,7 +21,11 @@ func NewDeleteCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
 			return commands.SearchDeleteCommand(args[0], d, stagingClient.Buckets(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Staging", "staging_url"))
 		},
 		Args: cobra.ExactArgs(1),
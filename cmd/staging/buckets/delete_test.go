@@ -390,6 +390,7 @@ func TestNewDeleteCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 			vpr.Set("output", "pretty-json")
 			if tc.url {
 				vpr.Set("default.staging_url", srv.URL)
@@ -447,6 +448,7 @@ func TestNewDeleteCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 
 	vpr.Set("default.staging_url", "test")
@@ -484,6 +486,7 @@ func TestNewDeleteCommand_NotExactly1Arg(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 
 	vpr.Set("default.staging_url", "test")
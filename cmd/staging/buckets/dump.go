@@ -33,7 +33,11 @@ func NewDumpCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
 
 			printer := cli.GetObjectPrinter(d.Config().GetString("output"))
 			if cmd.Flags().Changed("page-size") {
@@ -107,6 +107,7 @@ func TestNewDumpCommand_ErrorCases(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 			vpr.Set("output", "pretty-json")
 			if tc.url {
 				vpr.Set("default.staging_url", srv.URL)
@@ -307,6 +308,7 @@ func TestNewDumpCommand_WorkingCase(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 	vpr.Set("default.staging_url", srv.URL)
 
@@ -355,6 +357,7 @@ func TestNewDumpCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 
 	vpr.Set("default.staging_url", "test")
@@ -392,6 +395,7 @@ func TestNewDumpCommand_NotExactly1Arg(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "pretty-json")
 
 	vpr.Set("default.staging_url", "test")
@@ -11,7 +11,11 @@ import (
 
 // NewGetCommand creates the bucket get command.
 func NewGetCommand(d cli.Discovery) *cobra.Command {
-	var filters []string
+	var (
+		filters    []string
+		filterJSON string
+		list       commands.ListFlags
+	)
 	get := &cobra.Command{
 		Use:   "get <bucketName>",
 		Short: "The command that obtains buckets from Discovery Staging.",
@@ -24,8 +28,12 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"))
-			return commands.SearchCommand(args, d, stagingClient.Buckets(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Staging", "staging_url"), &filters)
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
+			return commands.SearchCommand(args, d, stagingClient.Buckets(), commands.GetCommandConfig(profile, vpr.GetString("output"), "Staging", "staging_url"), &filters, filterJSON, list)
 		},
 		Args: cobra.MaximumNArgs(1),
 		Example: `	# Get bucket by name
@@ -34,11 +42,26 @@ func NewGetCommand(d cli.Discovery) *cobra.Command {
 	# Get buckets using filters
 	discovery staging bucket get --filter label=A:A
 
+	# Get buckets whose name starts with "my-" or that were updated after October 1st, 2026
+	discovery staging bucket get --filter 'name=my-* | updated>2026-10-01'
+
+	# Get buckets using a filter written in Discovery's DSL
+	discovery staging bucket get --filter-json '{"exists":{"field":"labels"}}'
+
 	# Get all buckets using the configuration in profile "cn"
-	discovery staging buckets get -p cn`,
+	discovery staging buckets get -p cn
+
+	# Get the 10 most recently created buckets
+	discovery staging buckets get --sort creationTimestamp,desc --limit 10`,
 	}
 
-	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filters in the format "filter=key:value". The available filter is:
-- Label: The format is label={key}[:{value}], where the value is optional`)
+	get.Flags().StringArrayVarP(&filters, "filter", "f", []string{}, `apply filter expressions. The filters are combined through the "and" operator when the flag is repeated. The available conditions are:
+- Label: The format is label={key}[:{value}], where the value is optional
+- Name: The format is name={name}, where the name can have the * and ? wildcards
+- Field: The format is field={path}{:|>|>=|<|<=}{value}, like field=config.index:products or field=config.batchSize>=10
+- Timestamps: The formats are created{>|>=|<|<=}{date} and updated{>|>=|<|<=}{date}, where the date is YYYY-MM-DD or an RFC 3339 timestamp
+The conditions can be negated with != or !, grouped with parentheses and combined with & (and) and | (or). The values with spaces or special characters can be quoted with double quotes`)
+	get.Flags().StringVar(&filterJSON, "filter-json", "", "apply a filter written in Discovery's DSL. It is combined with the --filter flags through the \"and\" operator")
+	commands.AddListFlags(get, &list)
 	return get
 }
@@ -231,6 +231,7 @@ func TestNewGetCommand(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 			vpr.Set("output", "pretty-json")
 			if tc.url {
 				vpr.Set("default.staging_url", srv.URL)
@@ -292,6 +293,7 @@ func TestNewGetCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 	vpr.Set("default.staging_url", "test")
 	vpr.Set("default.staging_key", "test")
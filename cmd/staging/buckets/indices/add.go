@@ -0,0 +1,44 @@
+package indices
+
+import (
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewAddCommand creates the bucket index add command.
+func NewAddCommand(d cli.Discovery) *cobra.Command {
+	add := &cobra.Command{
+		Use:   "add <bucket> <index> <fields>",
+		Short: "The command that adds an index to a bucket in Discovery Staging.",
+		Long:  "add is the command used to create an index in a bucket of the Discovery Staging Repository. The second argument is the name of the index and the third one is its fields, either as a JSON array like [{\"field\":\"ASC\"},{\"field2\":\"DESC\"}] or as {field}:{direction} pairs separated by commas like field:ASC,field2:DESC. The direction is ASC or DESC. If the bucket already has an index with that name, it is replaced." + LongIndex,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			err = commands.CheckCredentials(d, profile, "Staging", "staging_url")
+			if err != nil {
+				return err
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
+			return d.AddBucketIndex(stagingClient.Buckets(), args[0], args[1], args[2], cli.GetObjectPrinter(vpr.GetString("output")))
+		},
+		Args: cobra.ExactArgs(3),
+		Example: `	# Add an index to a bucket with the shorthand of the fields
+	discovery staging bucket index add my-bucket myIndex title:ASC,date:DESC
+
+	# Add an index to a bucket by id with the JSON of the fields
+	discovery staging bucket index add 69eeb20b-8ded-478f-937f-64caa0a3e8c0 myIndex '[{"title":"ASC"},{"date":"DESC"}]'`,
+	}
+	return add
+}
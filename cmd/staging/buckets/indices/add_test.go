@@ -0,0 +1,407 @@
+package indices
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestNewAddCommand tests the NewAddCommand() function.
+func TestNewAddCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Add creates the index with the shorthand of the fields",
+			args:      []string{"my-bucket", "myIndexC", "title:ASC,date:desc"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewAddCommand_Out_AddShorthand",
+			errGolden: "NewAddCommand_Err_AddShorthand",
+			outBytes:  testutils.Read(t, "NewAddCommand_Out_AddShorthand"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z"
+				},
+				"highlight": {},
+				"score": 0.15534057
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"numberOfElements": 1,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0": {
+					StatusCode: http.StatusOK,
+					Body: `{
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z",
+					"documentCount": {},
+					"indices": [
+						{"name": "myIndexA", "fields": [{"fieldName": "ASC"}], "unique": false},
+						{"name": "myIndexB", "fields": [{"fieldName2": "DESC"}, {"fieldName3": "ASC"}], "unique": false}
+					]
+				}`,
+					ContentType: "application/json",
+				},
+				"PUT:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0/index/myIndexC": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"acknowledged":true}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, `[{"title":"ASC"},{"date":"DESC"}]`, string(body))
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:      "Add creates the index with the JSON of the fields",
+			args:      []string{"my-bucket", "myIndexC", `[{"title":"ASC"}]`},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewAddCommand_Out_AddJSON",
+			errGolden: "NewAddCommand_Err_AddJSON",
+			outBytes:  testutils.Read(t, "NewAddCommand_Out_AddJSON"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z"
+				},
+				"highlight": {},
+				"score": 0.15534057
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"numberOfElements": 1,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0": {
+					StatusCode: http.StatusOK,
+					Body: `{
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z",
+					"documentCount": {},
+					"indices": [
+						{"name": "myIndexA", "fields": [{"fieldName": "ASC"}], "unique": false},
+						{"name": "myIndexB", "fields": [{"fieldName2": "DESC"}, {"fieldName3": "ASC"}], "unique": false}
+					]
+				}`,
+					ContentType: "application/json",
+				},
+				"PUT:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0/index/myIndexC": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"acknowledged":true}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						assert.JSONEq(t, `[{"title":"ASC"}]`, string(body))
+					},
+				},
+			},
+			err: nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"my-bucket", "myIndexC", "title:ASC,date:desc"},
+			outGolden: "NewAddCommand_Out_NoURL",
+			errGolden: "NewAddCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewAddCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Staging URL is missing for profile \"default\".\nTo set the URL for the Discovery Staging API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery staging config --profile \"default\""),
+		},
+		{
+			name:      "sent name does not exist",
+			args:      []string{"my-bucket", "myIndexC", "title:ASC,date:desc"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewAddCommand_Out_NameDoesNotExist",
+			errGolden: "NewAddCommand_Err_NameDoesNotExist",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewAddCommand_Err_NameDoesNotExist"),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode:  http.StatusNotFound,
+					ContentType: "application/json",
+					Body: `{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "my-bucket" does not exist"
+	]
+}`,
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "my-bucket" does not exist"
+	]
+}`),
+			}, "Could not find bucket with name or id \"my-bucket\""),
+		},
+		{
+			name:      "The fields do not follow the shorthand",
+			args:      []string{"my-bucket", "myIndexC", "title:UP"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewAddCommand_Out_InvalidFields",
+			errGolden: "NewAddCommand_Err_InvalidFields",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewAddCommand_Err_InvalidFields"),
+			responses: map[string]testutils.MockResponse{},
+			err:       cli.NewError(cli.ErrorExitCode, "Index field \"title:UP\" does not follow the format {field}:{ASC|DESC}"),
+		},
+		{
+			name:      "CreateIndex returns HTTP error",
+			args:      []string{"my-bucket", "myIndexC", "title:ASC,date:desc"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewAddCommand_Out_AddHTTPError",
+			errGolden: "NewAddCommand_Err_AddHTTPError",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewAddCommand_Err_AddHTTPError"),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z"
+				},
+				"highlight": {},
+				"score": 0.15534057
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"numberOfElements": 1,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0": {
+					StatusCode: http.StatusOK,
+					Body: `{
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z",
+					"documentCount": {},
+					"indices": [
+						{"name": "myIndexA", "fields": [{"fieldName": "ASC"}], "unique": false},
+						{"name": "myIndexB", "fields": [{"fieldName2": "DESC"}, {"fieldName3": "ASC"}], "unique": false}
+					]
+				}`,
+					ContentType: "application/json",
+				},
+				"PUT:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0/index/myIndexC": {
+					StatusCode:  http.StatusBadRequest,
+					Body:        `{"status":400,"code":3002,"messages":["Invalid index configuration"]}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{"status":400,"code":3002,"messages":["Invalid index configuration"]}`)}, "Could not create index \"myIndexC\" of bucket \"my-bucket\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader("")
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.staging_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.staging_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			addCmd := NewAddCommand(d)
+
+			addCmd.SilenceUsage = true
+			addCmd.SetIn(ios.In)
+			addCmd.SetOut(ios.Out)
+			addCmd.SetErr(ios.Err)
+
+			addCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			addCmd.SetArgs(tc.args)
+
+			err := addCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewAddCommand_NoProfileFlag tests the NewAddCommand when the profile flag was not defined.
+func TestNewAddCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.staging_url", "test")
+	vpr.Set("default.staging_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	addCmd := NewAddCommand(d)
+
+	addCmd.SetIn(ios.In)
+	addCmd.SetOut(ios.Out)
+	addCmd.SetErr(ios.Err)
+
+	addCmd.SetArgs([]string{"my-bucket", "myIndexC", "title:ASC,date:desc"})
+
+	err := addCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewAddCommand_Out_NoProfile", testutils.Read(t, "NewAddCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewAddCommand_Err_NoProfile", testutils.Read(t, "NewAddCommand_Err_NoProfile"), errBuf.Bytes())
+}
@@ -0,0 +1,25 @@
+package indices
+
+import (
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// LongIndex is the message that explains that the first argument must be the bucket.
+	LongIndex string = " The first argument of this command must be the name or UUID of the bucket that contains the index."
+)
+
+// NewIndexCommand creates the bucket index command.
+func NewIndexCommand(d cli.Discovery) *cobra.Command {
+	index := &cobra.Command{
+		Use:   "index [subcommand] [flags]",
+		Short: "The command to interact with a bucket's indices in Discovery Staging.",
+	}
+
+	index.AddCommand(NewListCommand(d))
+	index.AddCommand(NewAddCommand(d))
+	index.AddCommand(NewRemoveCommand(d))
+
+	return index
+}
@@ -0,0 +1,44 @@
+package indices
+
+import (
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewListCommand creates the bucket index list command.
+func NewListCommand(d cli.Discovery) *cobra.Command {
+	list := &cobra.Command{
+		Use:   "list <bucket>",
+		Short: "The command that lists the indices of a bucket in Discovery Staging.",
+		Long:  "list is the command used to print the indices of a bucket in the Discovery Staging Repository, with their names, fields and whether they are unique." + LongIndex,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			err = commands.CheckCredentials(d, profile, "Staging", "staging_url")
+			if err != nil {
+				return err
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
+			return d.ListBucketIndices(stagingClient.Buckets(), args[0], cli.GetArrayPrinter(vpr.GetString("output")))
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# List the indices of a bucket by name
+	discovery staging bucket index list my-bucket
+
+	# List the indices of a bucket by id
+	discovery staging bucket index list 69eeb20b-8ded-478f-937f-64caa0a3e8c0`,
+	}
+	return list
+}
@@ -0,0 +1,244 @@
+package indices
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestNewListCommand tests the NewListCommand() function.
+func TestNewListCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "List prints the indices of the bucket",
+			args:      []string{"my-bucket"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewListCommand_Out_ListIndices",
+			errGolden: "NewListCommand_Err_ListIndices",
+			outBytes:  testutils.Read(t, "NewListCommand_Out_ListIndices"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z"
+				},
+				"highlight": {},
+				"score": 0.15534057
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"numberOfElements": 1,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0": {
+					StatusCode: http.StatusOK,
+					Body: `{
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z",
+					"documentCount": {},
+					"indices": [
+						{"name": "myIndexA", "fields": [{"fieldName": "ASC"}], "unique": false},
+						{"name": "myIndexB", "fields": [{"fieldName2": "DESC"}, {"fieldName3": "ASC"}], "unique": false}
+					]
+				}`,
+					ContentType: "application/json",
+				},
+			},
+			err: nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"my-bucket"},
+			outGolden: "NewListCommand_Out_NoURL",
+			errGolden: "NewListCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewListCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Staging URL is missing for profile \"default\".\nTo set the URL for the Discovery Staging API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery staging config --profile \"default\""),
+		},
+		{
+			name:      "sent name does not exist",
+			args:      []string{"my-bucket"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewListCommand_Out_NameDoesNotExist",
+			errGolden: "NewListCommand_Err_NameDoesNotExist",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewListCommand_Err_NameDoesNotExist"),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode:  http.StatusNotFound,
+					ContentType: "application/json",
+					Body: `{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "my-bucket" does not exist"
+	]
+}`,
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "my-bucket" does not exist"
+	]
+}`),
+			}, "Could not find bucket with name or id \"my-bucket\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader("")
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.staging_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.staging_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			listCmd := NewListCommand(d)
+
+			listCmd.SilenceUsage = true
+			listCmd.SetIn(ios.In)
+			listCmd.SetOut(ios.Out)
+			listCmd.SetErr(ios.Err)
+
+			listCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			listCmd.SetArgs(tc.args)
+
+			err := listCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewListCommand_NoProfileFlag tests the NewListCommand when the profile flag was not defined.
+func TestNewListCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.staging_url", "test")
+	vpr.Set("default.staging_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	listCmd := NewListCommand(d)
+
+	listCmd.SetIn(ios.In)
+	listCmd.SetOut(ios.Out)
+	listCmd.SetErr(ios.Err)
+
+	listCmd.SetArgs([]string{"my-bucket"})
+
+	err := listCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewListCommand_Out_NoProfile", testutils.Read(t, "NewListCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewListCommand_Err_NoProfile", testutils.Read(t, "NewListCommand_Err_NoProfile"), errBuf.Bytes())
+}
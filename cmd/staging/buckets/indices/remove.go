@@ -0,0 +1,44 @@
+package indices
+
+import (
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewRemoveCommand creates the bucket index remove command.
+func NewRemoveCommand(d cli.Discovery) *cobra.Command {
+	remove := &cobra.Command{
+		Use:   "remove <bucket> <index>",
+		Short: "The command that removes an index from a bucket in Discovery Staging.",
+		Long:  "remove is the command used to delete an index from a bucket of the Discovery Staging Repository. The second argument is the name of the index. If the bucket does not have an index with that name, the command fails." + LongIndex,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			err = commands.CheckCredentials(d, profile, "Staging", "staging_url")
+			if err != nil {
+				return err
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
+			return d.RemoveBucketIndex(stagingClient.Buckets(), args[0], args[1], cli.GetObjectPrinter(vpr.GetString("output")))
+		},
+		Args: cobra.ExactArgs(2),
+		Example: `	# Remove an index from a bucket by name
+	discovery staging bucket index remove my-bucket myIndex
+
+	# Remove an index from a bucket by id
+	discovery staging bucket index remove 69eeb20b-8ded-478f-937f-64caa0a3e8c0 myIndex`,
+	}
+	return remove
+}
@@ -0,0 +1,381 @@
+package indices
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestNewRemoveCommand tests the NewRemoveCommand() function.
+func TestNewRemoveCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		url       bool
+		apiKey    string
+		outGolden string
+		errGolden string
+		outBytes  []byte
+		errBytes  []byte
+		responses map[string]testutils.MockResponse
+		err       error
+	}{
+		// Working case
+		{
+			name:      "Remove deletes the index",
+			args:      []string{"my-bucket", "myIndexB"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewRemoveCommand_Out_RemoveIndex",
+			errGolden: "NewRemoveCommand_Err_RemoveIndex",
+			outBytes:  testutils.Read(t, "NewRemoveCommand_Out_RemoveIndex"),
+			errBytes:  []byte(nil),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z"
+				},
+				"highlight": {},
+				"score": 0.15534057
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"numberOfElements": 1,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0": {
+					StatusCode: http.StatusOK,
+					Body: `{
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z",
+					"documentCount": {},
+					"indices": [
+						{"name": "myIndexA", "fields": [{"fieldName": "ASC"}], "unique": false},
+						{"name": "myIndexB", "fields": [{"fieldName2": "DESC"}, {"fieldName3": "ASC"}], "unique": false}
+					]
+				}`,
+					ContentType: "application/json",
+				},
+				"DELETE:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0/index/myIndexB": {
+					StatusCode:  http.StatusOK,
+					Body:        `{"acknowledged":true}`,
+					ContentType: "application/json",
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, "apiKey123", r.Header.Get("X-API-Key"))
+					},
+				},
+			},
+			err: nil,
+		},
+
+		// Error case
+		{
+			name:      "No URL",
+			args:      []string{"my-bucket", "myIndexB"},
+			outGolden: "NewRemoveCommand_Out_NoURL",
+			errGolden: "NewRemoveCommand_Err_NoURL",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewRemoveCommand_Err_NoURL"),
+			url:       false,
+			apiKey:    "apiKey123",
+			err:       cli.NewError(cli.ErrorExitCode, "The Discovery Staging URL is missing for profile \"default\".\nTo set the URL for the Discovery Staging API, run any of the following commands:\n      discovery config  --profile \"default\"\n      discovery staging config --profile \"default\""),
+		},
+		{
+			name:      "sent name does not exist",
+			args:      []string{"my-bucket", "myIndexB"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewRemoveCommand_Out_NameDoesNotExist",
+			errGolden: "NewRemoveCommand_Err_NameDoesNotExist",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewRemoveCommand_Err_NameDoesNotExist"),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode:  http.StatusNotFound,
+					ContentType: "application/json",
+					Body: `{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "my-bucket" does not exist"
+	]
+}`,
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "my-bucket" does not exist"
+	]
+}`),
+			}, "Could not find bucket with name or id \"my-bucket\""),
+		},
+		{
+			name:      "The bucket does not have the index",
+			args:      []string{"my-bucket", "myIndexC"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewRemoveCommand_Out_IndexDoesNotExist",
+			errGolden: "NewRemoveCommand_Err_IndexDoesNotExist",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewRemoveCommand_Err_IndexDoesNotExist"),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z"
+				},
+				"highlight": {},
+				"score": 0.15534057
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"numberOfElements": 1,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0": {
+					StatusCode: http.StatusOK,
+					Body: `{
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z",
+					"documentCount": {},
+					"indices": [
+						{"name": "myIndexA", "fields": [{"fieldName": "ASC"}], "unique": false},
+						{"name": "myIndexB", "fields": [{"fieldName2": "DESC"}, {"fieldName3": "ASC"}], "unique": false}
+					]
+				}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewError(cli.ErrorExitCode, "Bucket \"my-bucket\" does not have an index with name \"myIndexC\""),
+		},
+		{
+			name:      "DeleteIndex returns HTTP error",
+			args:      []string{"my-bucket", "myIndexB"},
+			url:       true,
+			apiKey:    "apiKey123",
+			outGolden: "NewRemoveCommand_Out_RemoveHTTPError",
+			errGolden: "NewRemoveCommand_Err_RemoveHTTPError",
+			outBytes:  []byte(nil),
+			errBytes:  testutils.Read(t, "NewRemoveCommand_Err_RemoveHTTPError"),
+			responses: map[string]testutils.MockResponse{
+				"POST:/v2/bucket/search": {
+					StatusCode: http.StatusOK,
+					Body: `{
+			"content": [
+				{
+				"source": {
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z"
+				},
+				"highlight": {},
+				"score": 0.15534057
+				}
+			],
+			"pageable": {
+				"page": 0,
+				"size": 25,
+				"sort": []
+			},
+			"totalSize": 1,
+			"totalPages": 1,
+			"empty": false,
+			"size": 25,
+			"offset": 0,
+			"numberOfElements": 1,
+			"pageNumber": 0
+			}`,
+					ContentType: "application/json",
+				},
+				"GET:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0": {
+					StatusCode: http.StatusOK,
+					Body: `{
+					"name": "my-bucket",
+					"labels": [],
+					"active": true,
+					"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+					"creationTimestamp": "2026-06-04T22:06:02Z",
+					"lastUpdatedTimestamp": "2026-06-04T22:06:02Z",
+					"documentCount": {},
+					"indices": [
+						{"name": "myIndexA", "fields": [{"fieldName": "ASC"}], "unique": false},
+						{"name": "myIndexB", "fields": [{"fieldName2": "DESC"}, {"fieldName3": "ASC"}], "unique": false}
+					]
+				}`,
+					ContentType: "application/json",
+				},
+				"DELETE:/v2/bucket/69eeb20b-8ded-478f-937f-64caa0a3e8c0/index/myIndexB": {
+					StatusCode:  http.StatusInternalServerError,
+					Body:        `{"status":500,"code":5000,"messages":["Internal server error"]}`,
+					ContentType: "application/json",
+				},
+			},
+			err: cli.NewErrorWithCause(cli.ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status":500,"code":5000,"messages":["Internal server error"]}`)}, "Could not delete index \"myIndexB\" of bucket \"my-bucket\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, tc.responses))
+
+			defer srv.Close()
+
+			in := strings.NewReader("")
+			out := &bytes.Buffer{}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: out,
+				Err: errBuf,
+			}
+
+			vpr := viper.New()
+			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
+			vpr.Set("output", "pretty-json")
+			if tc.url {
+				vpr.Set("default.staging_url", srv.URL)
+			}
+			if tc.apiKey != "" {
+				vpr.Set("default.staging_key", tc.apiKey)
+			}
+
+			d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+			removeCmd := NewRemoveCommand(d)
+
+			removeCmd.SilenceUsage = true
+			removeCmd.SetIn(ios.In)
+			removeCmd.SetOut(ios.Out)
+			removeCmd.SetErr(ios.Err)
+
+			removeCmd.PersistentFlags().StringP(
+				"profile",
+				"p",
+				d.Config().GetString("profile"),
+				"configuration profile to use",
+			)
+
+			removeCmd.SetArgs(tc.args)
+
+			err := removeCmd.Execute()
+			if tc.err != nil {
+				var errStruct cli.Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				testutils.CompareBytes(t, tc.errGolden, tc.errBytes, errBuf.Bytes())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outBytes != nil {
+				testutils.CompareBytes(t, tc.outGolden, tc.outBytes, out.Bytes())
+			}
+		})
+	}
+}
+
+// TestNewRemoveCommand_NoProfileFlag tests the NewRemoveCommand when the profile flag was not defined.
+func TestNewRemoveCommand_NoProfileFlag(t *testing.T) {
+	in := strings.NewReader("")
+	out := &bytes.Buffer{}
+
+	errBuf := &bytes.Buffer{}
+	ios := iostreams.IOStreams{
+		In:  in,
+		Out: out,
+		Err: errBuf,
+	}
+
+	vpr := viper.New()
+	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
+	vpr.Set("output", "pretty-json")
+
+	vpr.Set("default.staging_url", "test")
+	vpr.Set("default.staging_key", "test")
+
+	d := cli.NewDiscovery(&ios, vpr, t.TempDir())
+
+	removeCmd := NewRemoveCommand(d)
+
+	removeCmd.SetIn(ios.In)
+	removeCmd.SetOut(ios.Out)
+	removeCmd.SetErr(ios.Err)
+
+	removeCmd.SetArgs([]string{"my-bucket", "myIndexB"})
+
+	err := removeCmd.Execute()
+	require.Error(t, err)
+	assert.EqualError(t, err, cli.NewErrorWithCause(cli.ErrorExitCode, errors.New("flag accessed but not defined: profile"), "Could not get the profile").Error())
+
+	testutils.CompareBytes(t, "NewRemoveCommand_Out_NoProfile", testutils.Read(t, "NewRemoveCommand_Out_NoProfile"), out.Bytes())
+	testutils.CompareBytes(t, "NewRemoveCommand_Err_NoProfile", testutils.Read(t, "NewRemoveCommand_Err_NoProfile"), errBuf.Bytes())
+}
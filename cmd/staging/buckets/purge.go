@@ -0,0 +1,48 @@
+package buckets
+
+import (
+	"github.com/pureinsights/discovery-cli/cmd/commands"
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewPurgeCommand creates the bucket purge command to delete the records of a bucket.
+func NewPurgeCommand(d cli.Discovery) *cobra.Command {
+	var yes bool
+	purge := &cobra.Command{
+		Use:   "purge <bucket>",
+		Short: "The command that deletes the records of a bucket in Discovery Staging.",
+		Long:  "purge is the command used to delete every record of a bucket in the Discovery Staging Repository by its name or UUID. The bucket, its configuration and its indices are kept. The command asks for confirmation before the records are deleted, unless the --yes flag is set.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := cmd.Flags().GetString("profile")
+			if err != nil {
+				return cli.NewErrorWithCause(cli.ErrorExitCode, err, "Could not get the profile")
+			}
+
+			err = commands.CheckCredentials(d, profile, "Staging", "staging_url")
+			if err != nil {
+				return err
+			}
+
+			vpr := d.Config()
+
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
+			return d.PurgeBucket(stagingClient.Buckets(), args[0], yes, cli.GetObjectPrinter(vpr.GetString("output")))
+		},
+		Args: cobra.ExactArgs(1),
+		Example: `	# Purge a bucket by name after confirming it
+	discovery staging bucket purge my-bucket
+
+	# Purge a bucket by id without confirmation
+	discovery staging bucket purge 69eeb20b-8ded-478f-937f-64caa0a3e8c0 --yes`,
+	}
+
+	purge.Flags().BoolVarP(&yes, "yes", "y", false, "purge the bucket without asking for confirmation")
+
+	return purge
+}
@@ -25,7 +25,11 @@ func NewStoreCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
 			return commands.SearchStoreCommand(d, stagingClient.Buckets(), commands.StoreCommandConfig(commands.GetCommandConfig(profile, vpr.GetString("output"), "Staging", "staging_url"), abortOnError, data, args))
 		},
 		Example: `	# Store a bucket with the JSON configuration in a file
@@ -23,7 +23,11 @@ func NewStatusCommand(d cli.Discovery) *cobra.Command {
 
 			vpr := d.Config()
 
-			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"))
+			options, err := commands.ClientOptions(cmd, d, profile, "staging")
+			if err != nil {
+				return err
+			}
+			stagingClient := discoveryPackage.NewStaging(vpr.GetString(profile+".staging_url"), vpr.GetString(profile+".staging_key"), options...)
 			return commands.StatusCheckCommand(d, stagingClient.StatusChecker(), "Staging", commands.GetCommandConfig(profile, vpr.GetString("output"), "Staging", "staging_url"))
 		},
 		Args: cobra.NoArgs,
@@ -129,6 +129,7 @@ func TestNewStatusCommand_ProfileFlag(t *testing.T) {
 
 			vpr := viper.New()
 			vpr.Set("profile", "default")
+			vpr.Set("retry_wait", "0s")
 			vpr.Set("output", "pretty-json")
 
 			vpr.Set("default.core_url", coreServer.URL)
@@ -188,6 +189,7 @@ func TestNewStatusCommand_NoProfileFlag(t *testing.T) {
 
 	vpr := viper.New()
 	vpr.Set("profile", "default")
+	vpr.Set("retry_wait", "0s")
 	vpr.Set("output", "json")
 
 	vpr.Set("default.ingestion_url", "test")
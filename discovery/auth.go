@@ -0,0 +1,185 @@
+package discovery
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// Authenticator adds the credentials of the client to the headers of every request sent to Discovery.
+// It is called before every attempt of a request, so it can refresh the credentials when they expire.
+type Authenticator interface {
+	Authenticate(ctx context.Context, header http.Header) error
+}
+
+// WithAuthenticator sets the authenticator that adds the credentials to every request executed by the client.
+// When an authenticator is set, the API key of the client is not sent in the X-API-Key header.
+func WithAuthenticator(auth Authenticator) ClientOption {
+	return func(c *client) {
+		c.auth = auth
+	}
+}
+
+// apiKeyAuthenticator sends an API key in the X-API-Key header.
+type apiKeyAuthenticator struct {
+	apiKey string
+}
+
+// APIKeyAuth returns an authenticator that sends the API key in the X-API-Key header.
+// It is the authentication used by default by the Discovery products.
+func APIKeyAuth(apiKey string) Authenticator {
+	return apiKeyAuthenticator{apiKey: apiKey}
+}
+
+// Authenticate sets the X-API-Key header.
+func (a apiKeyAuthenticator) Authenticate(_ context.Context, header http.Header) error {
+	if a.apiKey != "" {
+		header.Set("X-API-Key", a.apiKey)
+	}
+	return nil
+}
+
+// bearerAuthenticator sends a static token in the Authorization header.
+type bearerAuthenticator struct {
+	token string
+}
+
+// BearerAuth returns an authenticator that sends the token in the Authorization header with the Bearer scheme.
+func BearerAuth(token string) Authenticator {
+	return bearerAuthenticator{token: token}
+}
+
+// Authenticate sets the Authorization header.
+func (a bearerAuthenticator) Authenticate(_ context.Context, header http.Header) error {
+	header.Set("Authorization", "Bearer "+a.token)
+	return nil
+}
+
+// basicAuthenticator sends a username and a password in the Authorization header.
+type basicAuthenticator struct {
+	username string
+	password string
+}
+
+// BasicAuth returns an authenticator that sends the username and password in the Authorization header with the Basic scheme.
+func BasicAuth(username, password string) Authenticator {
+	return basicAuthenticator{username: username, password: password}
+}
+
+// Authenticate sets the Authorization header.
+func (a basicAuthenticator) Authenticate(_ context.Context, header http.Header) error {
+	header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(a.username+":"+a.password)))
+	return nil
+}
+
+// OAuth2Config contains the settings of the OAuth2 client credentials grant.
+type OAuth2Config struct {
+	// TokenURL is the URL of the token endpoint of the authorization server.
+	TokenURL string
+	// ClientID and ClientSecret are the credentials of the client. They are sent with HTTP basic authentication.
+	ClientID     string
+	ClientSecret string
+	// Scopes are the scopes requested for the token. If empty, the scope parameter is not sent.
+	Scopes []string
+	// HTTPClient is used to request the tokens. If nil, a client with the default transport is used.
+	HTTPClient *http.Client
+}
+
+// tokenExpiryMargin is how long before its expiration a token is refreshed, so that it does not expire while a request is sent.
+const tokenExpiryMargin = 30 * time.Second
+
+// oauth2Authenticator obtains tokens with the OAuth2 client credentials grant and caches them until they expire.
+type oauth2Authenticator struct {
+	config OAuth2Config
+	now    func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// OAuth2ClientCredentialsAuth returns an authenticator that obtains an access token with the OAuth2 client credentials grant
+// and sends it in the Authorization header with the Bearer scheme.
+// The token is cached and shared by the requests of every client that uses the authenticator.
+// A new token is requested when the cached one is about to expire.
+func OAuth2ClientCredentialsAuth(config OAuth2Config) Authenticator {
+	return &oauth2Authenticator{config: config, now: time.Now}
+}
+
+// Authenticate sets the Authorization header with the cached token or with a new one if it expired.
+func (a *oauth2Authenticator) Authenticate(ctx context.Context, header http.Header) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token == "" || (!a.expires.IsZero() && !a.now().Before(a.expires.Add(-tokenExpiryMargin))) {
+		if err := a.refresh(ctx); err != nil {
+			return err
+		}
+	}
+
+	header.Set("Authorization", "Bearer "+a.token)
+	return nil
+}
+
+// refresh requests a new token to the token endpoint and caches it.
+// If the response does not have the expires_in field, the token is cached until the process ends.
+func (a *oauth2Authenticator) refresh(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(a.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(a.config.Scopes, " "))
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, a.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("could not create the OAuth2 token request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	request.SetBasicAuth(url.QueryEscape(a.config.ClientID), url.QueryEscape(a.config.ClientSecret))
+
+	httpClient := a.config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Transport: defaultTransport}
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("could not obtain an OAuth2 token: %w", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("could not read the OAuth2 token response: %w", err)
+	}
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("could not obtain an OAuth2 token: %w", NewError(response.StatusCode, gjson.ParseBytes(body)))
+	}
+
+	result := gjson.ParseBytes(body)
+	token := result.Get("access_token").String()
+	if token == "" {
+		return errors.New("could not obtain an OAuth2 token: the response does not have an access_token")
+	}
+
+	a.token = token
+	a.expires = time.Time{}
+	if expiresIn := result.Get("expires_in").Int(); expiresIn > 0 {
+		a.expires = a.now().Add(time.Duration(expiresIn) * time.Second)
+	}
+	return nil
+}
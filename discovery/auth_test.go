@@ -0,0 +1,207 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthenticators tests that the authenticators set the headers with the credentials.
+func TestAuthenticators(t *testing.T) {
+	tests := []struct {
+		name     string
+		auth     Authenticator
+		header   string
+		expected string
+	}{
+		{name: "API key", auth: APIKeyAuth("apiKey"), header: "X-API-Key", expected: "apiKey"},
+		{name: "Empty API key", auth: APIKeyAuth(""), header: "X-API-Key", expected: ""},
+		{name: "Bearer token", auth: BearerAuth("token"), header: "Authorization", expected: "Bearer token"},
+		{name: "Basic", auth: BasicAuth("user", "pass"), header: "Authorization", expected: "Basic dXNlcjpwYXNz"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{}
+			require.NoError(t, tc.auth.Authenticate(context.Background(), header))
+			assert.Equal(t, tc.expected, header.Get(tc.header))
+		})
+	}
+}
+
+// TestWithAuthenticator tests that the client and its sub-clients send the credentials of the authenticator instead of the API key.
+func TestWithAuthenticator(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{}`, func(t *testing.T, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Empty(t, r.Header.Get("X-API-Key"))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "apiKey", WithAuthenticator(BearerAuth("token")))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	require.NoError(t, err)
+
+	_, err = newSubClient(c, "/seed").execute(context.Background(), http.MethodGet, "")
+	require.NoError(t, err)
+}
+
+// failingAuthenticator is an authenticator that always fails.
+type failingAuthenticator struct{}
+
+// Authenticate returns an error.
+func (failingAuthenticator) Authenticate(context.Context, http.Header) error {
+	return errors.New("no credentials")
+}
+
+// TestWithAuthenticator_Fails tests that the request is not sent when the authenticator fails.
+func TestWithAuthenticator_Fails(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "", WithAuthenticator(failingAuthenticator{}))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	assert.EqualError(t, err, "no credentials")
+	assert.Zero(t, calls.Load())
+}
+
+// tokenServer starts a test server that issues OAuth2 tokens that expire in the given number of seconds.
+// The number of issued tokens is stored in the calls parameter.
+func tokenServer(t *testing.T, expiresIn string, calls *atomic.Int32) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "discovery.read discovery.write", r.PostForm.Get("scope"))
+		clientId, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "client", clientId)
+		assert.Equal(t, "secret", clientSecret)
+
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"token-` + string(rune('0'+n)) + `","token_type":"Bearer"` + expiresIn + `}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// TestOAuth2ClientCredentialsAuth_CachesToken tests that the token is requested once and reused until it is about to expire.
+func TestOAuth2ClientCredentialsAuth_CachesToken(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := tokenServer(t, `,"expires_in":3600`, calls)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	auth := OAuth2ClientCredentialsAuth(OAuth2Config{
+		TokenURL:     srv.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scopes:       []string{"discovery.read", "discovery.write"},
+	}).(*oauth2Authenticator)
+	auth.now = func() time.Time { return now }
+
+	header := http.Header{}
+	require.NoError(t, auth.Authenticate(context.Background(), header))
+	assert.Equal(t, "Bearer token-1", header.Get("Authorization"))
+
+	now = now.Add(time.Hour - tokenExpiryMargin - time.Second)
+	require.NoError(t, auth.Authenticate(context.Background(), header))
+	assert.Equal(t, "Bearer token-1", header.Get("Authorization"))
+	assert.Equal(t, int32(1), calls.Load())
+
+	now = now.Add(time.Second)
+	require.NoError(t, auth.Authenticate(context.Background(), header))
+	assert.Equal(t, "Bearer token-2", header.Get("Authorization"))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// TestOAuth2ClientCredentialsAuth_NoExpiration tests that a token without an expiration is cached indefinitely.
+func TestOAuth2ClientCredentialsAuth_NoExpiration(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := tokenServer(t, "", calls)
+
+	auth := OAuth2ClientCredentialsAuth(OAuth2Config{
+		TokenURL:     srv.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scopes:       []string{"discovery.read", "discovery.write"},
+	})
+
+	for range 3 {
+		header := http.Header{}
+		require.NoError(t, auth.Authenticate(context.Background(), header))
+		assert.Equal(t, "Bearer token-1", header.Get("Authorization"))
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// TestOAuth2ClientCredentialsAuth_Errors tests the errors returned when the token cannot be obtained.
+func TestOAuth2ClientCredentialsAuth_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		response testutils.MockResponse
+		check    func(t *testing.T, err error)
+	}{
+		{
+			name:     "The token endpoint rejects the client",
+			response: testutils.MockResponse{StatusCode: http.StatusUnauthorized, ContentType: "application/json", Body: `{"error":"invalid_client"}`},
+			check: func(t *testing.T, err error) {
+				assert.True(t, IsUnauthorized(err))
+				assert.ErrorContains(t, err, "could not obtain an OAuth2 token")
+			},
+		},
+		{
+			name:     "The response does not have a token",
+			response: testutils.MockResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: `{"token_type":"Bearer"}`},
+			check: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "could not obtain an OAuth2 token: the response does not have an access_token")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpHandler(t, tc.response.StatusCode, tc.response.ContentType, tc.response.Body, nil))
+			t.Cleanup(srv.Close)
+
+			auth := OAuth2ClientCredentialsAuth(OAuth2Config{TokenURL: srv.URL, ClientID: "client", ClientSecret: "secret"})
+			err := auth.Authenticate(context.Background(), http.Header{})
+			require.Error(t, err)
+			tc.check(t, err)
+		})
+	}
+}
+
+// TestOAuth2ClientCredentialsAuth_Client tests that a client with the OAuth2 authenticator sends the token to Discovery.
+func TestOAuth2ClientCredentialsAuth_Client(t *testing.T) {
+	calls := &atomic.Int32{}
+	tokens := tokenServer(t, `,"expires_in":3600`, calls)
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{}`, func(t *testing.T, r *http.Request) {
+		assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+	}))
+	t.Cleanup(srv.Close)
+
+	auth := OAuth2ClientCredentialsAuth(OAuth2Config{
+		TokenURL:     tokens.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scopes:       []string{"discovery.read", "discovery.write"},
+	})
+	core := newCore(srv.URL, "", WithAuthenticator(auth))
+	for range 2 {
+		_, err := core.Servers().Get(uuid.Nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
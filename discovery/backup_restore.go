@@ -1,6 +1,8 @@
 package discovery
 
 import (
+	"context"
+	"io"
 	"mime"
 	"net/http"
 
@@ -25,26 +27,33 @@ const (
 	OnConflictUpdate OnConflict = "UPDATE"
 )
 
-// Export obtains the bytes with the information of the exported entities.
-// This data can later be written to a ZIP file with the filename received in the Content Disposition header.
-func (backup backupRestore) Export() ([]byte, string, error) {
-	c := backup.client
-	request := c.client.R()
+// exportErrorLimit is the maximum number of bytes of an error response of the export endpoint that are read.
+const exportErrorLimit = 1 << 20
 
-	if c.ApiKey != "" {
-		request.SetHeader("X-API-Key", c.ApiKey)
-	}
+// Export writes the ZIP file with the exported entities to the given writer as it is received, so the export is never held in memory.
+// It returns the filename received in the Content Disposition header, or discovery.zip if the header does not have one.
+// Nothing is written to the writer if Discovery responds with an error.
+func (backup backupRestore) Export(w io.Writer) (string, error) {
+	return backup.ExportContext(backup.client.requestContext(), w)
+}
 
-	response, err := request.Execute(http.MethodGet, c.client.BaseURL+"/export")
+// ExportContext is like Export, but it uses the given context for its requests instead of the one set with WithContext().
+func (backup backupRestore) ExportContext(ctx context.Context, w io.Writer) (string, error) {
+	c := backup.client
+	request := c.newRequest(ctx).SetDoNotParseResponse(true)
+
+	response, err := c.send(request, http.MethodGet, c.client.BaseURL+"/export")
 	if err != nil {
-		return nil, "", err
+		closeRawBody(response)
+		return "", err
 	}
 
+	body := response.RawBody()
+	defer body.Close()
+
 	if response.IsError() {
-		return nil, "", Error{
-			Status: response.StatusCode(),
-			Body:   gjson.ParseBytes(response.Body()),
-		}
+		errorBody, _ := io.ReadAll(io.LimitReader(body, exportErrorLimit))
+		return "", NewError(response.StatusCode(), gjson.ParseBytes(errorBody))
 	}
 
 	contentDisposition := response.Header().Get("Content-Disposition")
@@ -56,15 +65,24 @@ func (backup backupRestore) Export() ([]byte, string, error) {
 				filename = value
 			}
 		} else {
-			return nil, filename, err
+			return filename, err
 		}
 	}
 
-	return response.Body(), filename, nil
+	if _, err := io.Copy(w, body); err != nil {
+		return filename, err
+	}
+
+	return filename, nil
 }
 
 // Import reads the given file containing the entities to be imported, and then calls the endpoint to do so.
 // It sets the conflict resolution strategy to the one sent as a parameter and returns the status of the imported entities.
 func (restore backupRestore) Import(onConflict OnConflict, file string) (gjson.Result, error) {
-	return execute(restore.client, http.MethodPost, "/import", WithFile(file), WithQueryParameters(map[string][]string{"onConflict": {string(onConflict)}}))
+	return restore.ImportContext(restore.client.requestContext(), onConflict, file)
+}
+
+// ImportContext is like Import, but it uses the given context for its requests instead of the one set with WithContext().
+func (restore backupRestore) ImportContext(ctx context.Context, onConflict OnConflict, file string) (gjson.Result, error) {
+	return execute(ctx, restore.client, http.MethodPost, "/import", WithFile(file), WithQueryParameters(map[string][]string{"onConflict": {string(onConflict)}}))
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/pureinsights/discovery-cli/internal/testutils"
@@ -245,16 +246,18 @@ func Test_backupRestore_Export(t *testing.T) {
 			defer srv.Close()
 
 			b := backupRestore{client: newClient(srv.URL, tc.apiKey)}
-			response, filename, err := b.Export()
+			response := &strings.Builder{}
+			filename, err := b.Export(response)
 			assert.Equal(t, tc.expectedFileName, filename)
 			if tc.err == nil {
 				require.NoError(t, err)
-				assert.Equal(t, bytes, response)
+				assert.Equal(t, string(bytes), response.String())
 				for _, text := range tc.expectedTexts {
-					assert.Contains(t, string(response), text)
+					assert.Contains(t, response.String(), text)
 				}
 			} else {
 				assert.EqualError(t, err, tc.err.Error())
+				assert.Empty(t, response.String())
 			}
 		})
 	}
@@ -266,10 +269,59 @@ func Test_backupRestore_Export_RestyReturnsError(t *testing.T) {
 	base := srv.URL
 	srv.Close()
 
-	b := backupRestore{client: newClient(srv.URL, "")}
-	response, filename, err := b.Export()
+	b := backupRestore{client: newClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))}
+	response := &strings.Builder{}
+	filename, err := b.Export(response)
 	require.Error(t, err)
-	assert.Nil(t, response)
+	assert.Empty(t, response.String())
 	assert.Empty(t, filename)
 	assert.Contains(t, err.Error(), base+"/export")
 }
+
+// Test_backupRestore_Export_Streams tests that the export is written to the writer while it is received.
+func Test_backupRestore_Export_Streams(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("first"))
+		w.(http.Flusher).Flush()
+		<-release
+		w.Write([]byte("second"))
+	}))
+	t.Cleanup(srv.Close)
+
+	writer := &notifyWriter{written: make(chan string, 2)}
+	b := backupRestore{client: newClient(srv.URL, "")}
+	done := make(chan error)
+	go func() {
+		_, err := b.Export(writer)
+		done <- err
+	}()
+
+	assert.Equal(t, "first", <-writer.written)
+	close(release)
+	assert.Equal(t, "second", <-writer.written)
+	require.NoError(t, <-done)
+}
+
+// notifyWriter sends every write to a channel.
+type notifyWriter struct {
+	written chan string
+}
+
+// Write sends the bytes to the channel.
+func (w *notifyWriter) Write(p []byte) (int, error) {
+	w.written <- string(p)
+	return len(p), nil
+}
+
+// Test_backupRestore_Export_WriteFails tests that the error of the writer is returned.
+func Test_backupRestore_Export_WriteFails(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/octet-stream", "zip", nil))
+	t.Cleanup(srv.Close)
+
+	b := backupRestore{client: newClient(srv.URL, "")}
+	filename, err := b.Export(&testutils.FailOnNWriter{Writer: &strings.Builder{}, N: 1})
+	assert.Equal(t, "discovery.zip", filename)
+	assert.EqualError(t, err, "write failed")
+}
@@ -1,8 +1,12 @@
 package discovery
 
 import (
+	"context"
+	"iter"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/tidwall/gjson"
 
@@ -40,42 +44,162 @@ func WithJSONBody(body string) RequestOption {
 	}
 }
 
+// SortDirection is the direction in which the results of a request are sorted.
+type SortDirection string
+
+// The constants represent the ascending and descending orders.
+const (
+	// SortAscending sorts the results from the lowest to the highest value.
+	SortAscending SortDirection = "asc"
+	// SortDescending sorts the results from the highest to the lowest value.
+	SortDescending SortDirection = "desc"
+)
+
+// WithSort sorts the results of the request by the given field in the given direction.
+// It can be used several times to sort by several fields, which are applied in the given order.
+// For example: ?sort=name,asc&sort=creationTimestamp,desc
+func WithSort(field string, direction SortDirection) RequestOption {
+	return func(r *resty.Request) error {
+		r.QueryParam.Add("sort", field+","+string(direction))
+		return nil
+	}
+}
+
+// WithPageSize sets the number of results in each page of the request.
+// If it is not used, Discovery returns pages of its default size.
+func WithPageSize(size int) RequestOption {
+	return func(r *resty.Request) error {
+		r.SetQueryParam("size", strconv.Itoa(size))
+		return nil
+	}
+}
+
+// ClientOption is a type definition used for the functional options pattern.
+// It configures the clients that execute the requests, like setting their context and timeout.
+type ClientOption func(*client)
+
+// WithContext sets the context of the requests executed by the methods of the client that do not receive a context, like Get().
+// The methods that receive a context, like GetContext(), use that context instead.
+// When the context is canceled or its deadline is exceeded, the in-flight request is aborted.
+func WithContext(ctx context.Context) ClientOption {
+	return func(c *client) {
+		c.ctx = ctx
+	}
+}
+
+// WithTimeout sets the maximum duration of every request executed by the client.
+// A timeout of zero means the requests have no timeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *client) {
+		c.timeout = &timeout
+	}
+}
+
+// WithConcurrency sets the maximum number of pages that are requested at the same time when the client obtains every page of an endpoint.
+// The pages are still returned in order. A concurrency lower than 2 means the pages are requested one after another.
+func WithConcurrency(concurrency int) ClientOption {
+	return func(c *client) {
+		c.concurrency = concurrency
+	}
+}
+
 // client is a struct that contains the API Key to connect to Discovery and the Resty Client to execute the requests.
+// The context is used to cancel the requests and the retry policy is used to retry the requests that fail with a transient error.
+// The concurrency is the maximum number of pages requested at the same time.
+// If set, the tracer writes the requests and their responses and the authenticator adds the credentials to the requests instead of the API key.
+// The rate limiter, if set, delays the requests so that they do not exceed its rate.
+// The client options are kept so that they can be applied to the sub-clients.
 type client struct {
-	ApiKey string
-	client *resty.Client
+	ApiKey      string
+	client      *resty.Client
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     *time.Duration
+	ctx         context.Context
+	retry       RetryPolicy
+	concurrency int
+	tracer      *tracer
+	auth        Authenticator
+	limiter     *RateLimiter
+	options     []ClientOption
 }
 
 // newClient returns an instance of a [client] struct.
 // The url parameter is the url to which the request is sent.
 // For example, http://localhost:12010/v2
-func newClient(url, apiKey string) client {
-	restyClient := resty.New()
+// The client uses the shared default transport and is configured with the given client options.
+// The HTTP client is created once every option was applied, so the options that change it, like WithTimeout(), do not depend on their order.
+func newClient(url, apiKey string, options ...ClientOption) client {
+	c := client{ApiKey: apiKey, retry: DefaultRetryPolicy(), options: options}
+	for _, opt := range options {
+		opt(&c)
+	}
+
+	transport := c.transport
+	if transport == nil && (c.httpClient == nil || c.httpClient.Transport == nil) {
+		transport = defaultTransport
+	}
+
+	var restyClient *resty.Client
+	if c.httpClient != nil {
+		restyClient = resty.NewWithClient(c.httpClient)
+	} else {
+		restyClient = resty.New()
+	}
 	restyClient.SetBaseURL(url)
-	return client{apiKey, restyClient}
+	if transport != nil {
+		restyClient.SetTransport(transport)
+	}
+
+	if c.timeout != nil {
+		restyClient.SetTimeout(*c.timeout)
+	}
+
+	c.client = restyClient
+	return c
 }
 
 // newSubClient returns an instance of a [client] struct whose base URL is the parent client’s base URL with an added path.
 // For example, http://localhost:12010/v2/seed
+// The sub-client is configured with the parent's client options.
 func newSubClient(c client, path string) client {
 	newUrl := strings.TrimRight(c.client.BaseURL, "/") + "/" + strings.TrimLeft(path, "/")
-	subClient := resty.New()
-	subClient.SetBaseURL(newUrl)
-	return client{c.ApiKey, subClient}
+	return newClient(newUrl, c.ApiKey, c.options...)
+}
+
+// requestContext returns the context set with the WithContext() option, which is used by the methods that do not receive a context.
+// If the option was not set, it returns the background context.
+func (c client) requestContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// newRequest creates a request with the given context.
+// If the client does not have an authenticator, the client's API key is set as the X-API-Key header.
+func (c client) newRequest(ctx context.Context) *resty.Request {
+	request := c.client.R()
+
+	if ctx != nil {
+		request.SetContext(ctx)
+	}
+
+	if c.auth == nil && c.ApiKey != "" {
+		request.SetHeader("X-API-Key", c.ApiKey)
+	}
+
+	return request
 }
 
 // execute runs an HTTP request with the client.
 // The method parameter is the HTTP verb to be executed.
 // The path is added to the client's base URL.
 // The request is modified with the specified request options.
-// If set, the client's API key is set as the X-API-Key header.
+// The request is created with the given context and the client's API key, and it is retried according to the client's retry policy.
 // This function returns the response as a byte array or an error if it failed.
-func (c client) execute(method, path string, options ...RequestOption) ([]byte, error) {
-	request := c.client.R()
-
-	if c.ApiKey != "" {
-		request.SetHeader("X-API-Key", c.ApiKey)
-	}
+func (c client) execute(ctx context.Context, method, path string, options ...RequestOption) ([]byte, error) {
+	request := c.newRequest(ctx)
 
 	for _, opt := range options {
 		if err := opt(request); err != nil {
@@ -83,16 +207,13 @@ func (c client) execute(method, path string, options ...RequestOption) ([]byte,
 		}
 	}
 
-	response, err := request.Execute(method, c.client.BaseURL+path)
+	response, err := c.send(request, method, c.client.BaseURL+path)
 	if err != nil {
 		return nil, err
 	}
 
 	if response.IsError() {
-		return nil, Error{
-			Status: response.StatusCode(),
-			Body:   gjson.ParseBytes(response.Body()),
-		}
+		return nil, NewError(response.StatusCode(), gjson.ParseBytes(response.Body()))
 	}
 
 	return response.Body(), nil
@@ -100,8 +221,8 @@ func (c client) execute(method, path string, options ...RequestOption) ([]byte,
 
 // execute runs the client.execute(function), but returns a parsed gjson.Result object instead of a byte array.
 // This function is only recommended if the response is known to return a JSON object or array.
-func execute(client client, method, path string, options ...RequestOption) (gjson.Result, error) {
-	response, err := client.execute(method, path, options...)
+func execute(ctx context.Context, client client, method, path string, options ...RequestOption) (gjson.Result, error) {
+	response, err := client.execute(ctx, method, path, options...)
 	if err != nil {
 		return gjson.Result{}, err
 	}
@@ -109,38 +230,155 @@ func execute(client client, method, path string, options ...RequestOption) (gjso
 	return resultJson, nil
 }
 
-// executeWithPagination obtains all of the content when the endpoint returns its results in pages.
-// It requests the data in every page and returns an array with all of the JSON results.
-func executeWithPagination(client client, method, path string, options ...RequestOption) ([]gjson.Result, error) {
-	response, err := execute(client, method, path, options...)
-	if err != nil {
-		return []gjson.Result(nil), err
+// Collect iterates through the sequence and returns an array with all of its JSON results.
+// It stops at the first error yielded by the sequence and returns it.
+func Collect(seq iter.Seq2[gjson.Result, error]) ([]gjson.Result, error) {
+	elements := []gjson.Result{}
+	for element, err := range seq {
+		if err != nil {
+			return []gjson.Result(nil), err
+		}
+		elements = append(elements, element)
 	}
+	return elements, nil
+}
 
-	if !(response.Get("content").Exists()) {
-		return []gjson.Result{}, nil
+// Limit returns a sequence that yields at most the given number of results of the sequence.
+// It stops consuming the sequence once the limit is reached, so the sequences that request their pages as they are consumed, like GetAllSeq(), do not request the remaining pages.
+// It works with the sequences of JSON results and with the sequences of the typed wrappers.
+// A limit lower than 1 does not limit the results.
+func Limit[T any](seq iter.Seq2[T, error], limit int) iter.Seq2[T, error] {
+	if limit < 1 {
+		return seq
 	}
 
-	elementNumber := response.Get("numberOfElements").Int()
-	pageNumber := response.Get("pageNumber").Int()
-	totalPages := response.Get("totalPages").Int()
-	totalSize := response.Get("totalSize").Int()
-	elements := response.Get("content").Array()
-	pageNumber++
-	var requestOptions []RequestOption
-	for pageNumber < totalPages && elementNumber < totalSize {
-		requestOptions = append(options, WithQueryParameters(map[string][]string{"page": {strconv.FormatInt(pageNumber, 10)}}))
-		response, err = execute(client, method, path, requestOptions...)
+	return func(yield func(T, error) bool) {
+		count := 0
+		for element, err := range seq {
+			if !yield(element, err) || err != nil {
+				return
+			}
+
+			count++
+			if count >= limit {
+				return
+			}
+		}
+	}
+}
+
+// pageOptions returns a copy of the request options with the page query parameter.
+func pageOptions(options []RequestOption, pageNumber int64) []RequestOption {
+	return append(options[:len(options):len(options)], WithQueryParameters(map[string][]string{"page": {strconv.FormatInt(pageNumber, 10)}}))
+}
+
+// paginate returns a sequence that iterates through the content of every page when the endpoint returns its results in pages.
+// Each page is requested only when the elements of the previous page have been consumed.
+// If the client has a concurrency greater than one, the remaining pages are prefetched with the prefetchPages() function once the first page is received.
+// If a request fails, the sequence yields the error and stops.
+func paginate(ctx context.Context, client client, method, path string, options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		response, err := execute(ctx, client, method, path, options...)
 		if err != nil {
-			return []gjson.Result(nil), err
+			yield(gjson.Result{}, err)
+			return
 		}
 
-		pageElements := response.Get("content").Array()
-		elements = append(elements, pageElements...)
+		if !(response.Get("content").Exists()) {
+			return
+		}
+
+		elementNumber := response.Get("numberOfElements").Int()
+		pageNumber := response.Get("pageNumber").Int()
+		totalPages := response.Get("totalPages").Int()
+		totalSize := response.Get("totalSize").Int()
+		for _, element := range response.Get("content").Array() {
+			if !yield(element, nil) {
+				return
+			}
+		}
 
 		pageNumber++
-		pageElementNumber := response.Get("numberOfElements").Int()
-		elementNumber += pageElementNumber
+		if client.concurrency > 1 && elementNumber < totalSize {
+			for element, err := range prefetchPages(ctx, client, method, path, options, pageNumber, totalPages) {
+				if !yield(element, err) || err != nil {
+					return
+				}
+			}
+			return
+		}
+
+		for pageNumber < totalPages && elementNumber < totalSize {
+			response, err = execute(ctx, client, method, path, pageOptions(options, pageNumber)...)
+			if err != nil {
+				yield(gjson.Result{}, err)
+				return
+			}
+
+			for _, element := range response.Get("content").Array() {
+				if !yield(element, nil) {
+					return
+				}
+			}
+
+			pageNumber++
+			pageElementNumber := response.Get("numberOfElements").Int()
+			elementNumber += pageElementNumber
+		}
 	}
-	return elements, nil
+}
+
+// pageResult contains the response or the error of the request of a page.
+type pageResult struct {
+	response gjson.Result
+	err      error
+}
+
+// prefetchPages returns a sequence that iterates through the content of the pages in the range [firstPage, lastPage).
+// Up to the client's concurrency pages are requested at the same time, but their elements are yielded in the order of the pages.
+// A new page is requested every time the oldest page in flight is received, so the number of pages kept in memory is bounded.
+// If a request fails, the sequence yields the error and stops. The pages that are still in flight are discarded.
+func prefetchPages(ctx context.Context, client client, method, path string, options []RequestOption, firstPage, lastPage int64) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		nextPage := firstPage
+		var inFlight []chan pageResult
+		request := func() {
+			result := make(chan pageResult, 1)
+			inFlight = append(inFlight, result)
+			go func(pageNumber int64) {
+				response, err := execute(ctx, client, method, path, pageOptions(options, pageNumber)...)
+				result <- pageResult{response: response, err: err}
+			}(nextPage)
+			nextPage++
+		}
+
+		for nextPage < lastPage && len(inFlight) < client.concurrency {
+			request()
+		}
+
+		for len(inFlight) > 0 {
+			result := <-inFlight[0]
+			inFlight = inFlight[1:]
+			if result.err != nil {
+				yield(gjson.Result{}, result.err)
+				return
+			}
+
+			if nextPage < lastPage {
+				request()
+			}
+
+			for _, element := range result.response.Get("content").Array() {
+				if !yield(element, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// executeWithPagination obtains all of the content when the endpoint returns its results in pages.
+// It requests the data in every page and returns an array with all of the JSON results.
+func executeWithPagination(ctx context.Context, client client, method, path string, options ...RequestOption) ([]gjson.Result, error) {
+	return Collect(paginate(ctx, client, method, path, options...))
 }
@@ -1,14 +1,19 @@
 package discovery
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/pureinsights/discovery-cli/internal/fileutils"
@@ -98,6 +103,28 @@ func Test_newSubClient_BaseURLJoin(t *testing.T) {
 	}
 }
 
+// Test_newClient_ClientOptions tests that the client options configure the client's context and timeout.
+func Test_newClient_ClientOptions(t *testing.T) {
+	ctx := context.WithValue(context.Background(), contextKey("key"), "value")
+	c := newClient("http://localhost:12010/v2", "apiKey", WithContext(ctx), WithTimeout(5*time.Second))
+
+	assert.Equal(t, ctx, c.ctx)
+	assert.Equal(t, 5*time.Second, c.client.GetClient().Timeout)
+}
+
+// Test_newSubClient_InheritsClientOptions tests that a sub-client keeps the parent's context and timeout.
+func Test_newSubClient_InheritsClientOptions(t *testing.T) {
+	ctx := context.WithValue(context.Background(), contextKey("key"), "value")
+	parent := newClient("http://localhost:12010/v2", "apiKey", WithContext(ctx), WithTimeout(5*time.Second))
+	sub := newSubClient(parent, "/seed")
+
+	assert.Equal(t, ctx, sub.ctx)
+	assert.Equal(t, 5*time.Second, sub.client.GetClient().Timeout)
+}
+
+// contextKey is the type of the keys used to add values to the contexts in the tests.
+type contextKey string
+
 // Test_client_execute_SendsAPIKeyReturnsBody tests when execute() sets the API key and returns the response's body.
 func Test_client_execute_SendsAPIKeyReturnsBody(t *testing.T) {
 	const apiKey = "api-key"
@@ -111,7 +138,7 @@ func Test_client_execute_SendsAPIKeyReturnsBody(t *testing.T) {
 
 	c := newClient(srv.URL, apiKey)
 
-	res, err := c.execute(http.MethodGet, "/seed")
+	res, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
 	require.NoError(t, err)
 
 	assert.IsType(t, []byte(nil), res)
@@ -173,9 +200,9 @@ func Test_client_execute_HTTPErrorTypedError(t *testing.T) {
 				testutils.HttpHandler(t, tt.status, tt.contentType, tt.body, nil))
 			t.Cleanup(srv.Close)
 
-			c := newClient(srv.URL, "")
+			c := newClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))
 
-			res, err := c.execute(http.MethodGet, "/fail")
+			res, err := c.execute(c.requestContext(), http.MethodGet, "/fail")
 			assert.Nil(t, res, "result should be nil on response error")
 			require.Error(t, err, "expected an error")
 
@@ -190,8 +217,8 @@ func Test_client_execute_RestyReturnsError(t *testing.T) {
 	base := srv.URL
 	srv.Close()
 
-	c := newClient(base, "")
-	res, err := c.execute(http.MethodGet, "/down")
+	c := newClient(base, "", WithRetryPolicy(RetryPolicy{}))
+	res, err := c.execute(c.requestContext(), http.MethodGet, "/down")
 	require.Error(t, err)
 	assert.Nil(t, res, "result should be nil on execute error")
 	assert.Contains(t, err.Error(), base+"/down")
@@ -203,11 +230,65 @@ func Test_client_execute_NoContent(t *testing.T) {
 	defer srv.Close()
 
 	c := newClient(srv.URL, "")
-	response, err := c.execute(http.MethodGet, "")
+	response, err := c.execute(c.requestContext(), http.MethodGet, "")
 	require.NoError(t, err)
 	assert.Len(t, response, 0)
 }
 
+// Test_client_execute_ContextCanceled tests that a canceled context aborts the request.
+func Test_client_execute_ContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	c := newClient(srv.URL, "", WithContext(ctx))
+	res, err := c.execute(c.requestContext(), http.MethodGet, "/hang")
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// Test_client_execute_ContextDeadlineExceeded tests that a request fails when the context's deadline is exceeded.
+func Test_client_execute_ContextDeadlineExceeded(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	c := newClient(srv.URL, "", WithContext(ctx))
+	res, err := c.execute(c.requestContext(), http.MethodGet, "/hang")
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// Test_client_execute_Timeout tests that a request fails when it takes longer than the client's timeout.
+func Test_client_execute_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	c := newClient(srv.URL, "", WithTimeout(20*time.Millisecond))
+	res, err := c.execute(c.requestContext(), http.MethodGet, "/hang")
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+}
+
 // Test_client_execute_FunctionalOptionsFail tests when one of the functional options returns an error.
 func Test_client_execute_FunctionalOptionsFail(t *testing.T) {
 	failingOption := func(r *resty.Request) error {
@@ -218,7 +299,7 @@ func Test_client_execute_FunctionalOptionsFail(t *testing.T) {
 	srv.Close()
 
 	c := newClient(base, "")
-	res, err := c.execute(http.MethodGet, "/down", failingOption)
+	res, err := c.execute(c.requestContext(), http.MethodGet, "/down", failingOption)
 	assert.EqualError(t, err, "The option failed")
 	assert.Nil(t, res, "result should be nil on execute error")
 }
@@ -236,7 +317,25 @@ func TestWithQueryParameters(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	c := newClient(srv.URL, "")
-	response, err := c.execute("POST", "", WithQueryParameters(map[string][]string{"q": {"Google"}, "items": {"item1", "item2", "item3"}}))
+	response, err := c.execute(c.requestContext(), "POST", "", WithQueryParameters(map[string][]string{"q": {"Google"}, "items": {"item1", "item2", "item3"}}))
+	require.NoError(t, err)
+	require.True(t, gjson.Parse(string(response)).Get("ok").Bool())
+}
+
+// TestWithSortAndPageSize tests the WithSort() and WithPageSize() options.
+// It verifies that every sort is sent in the given order with the size of the page.
+func TestWithSortAndPageSize(t *testing.T) {
+	srv := httptest.NewServer(
+		testutils.HttpHandler(t,
+			http.StatusOK, "application/json", `{"ok":true}`,
+			func(t *testing.T, r *http.Request) {
+				assert.Equal(t, []string{"name,asc", "creationTimestamp,desc"}, r.URL.Query()["sort"])
+				assert.Equal(t, "25", r.URL.Query().Get("size"))
+			}))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "")
+	response, err := c.execute(c.requestContext(), http.MethodGet, "", WithSort("name", SortAscending), WithSort("creationTimestamp", SortDescending), WithPageSize(25))
 	require.NoError(t, err)
 	require.True(t, gjson.Parse(string(response)).Get("ok").Bool())
 }
@@ -257,7 +356,7 @@ func TestWithJSONBody(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	c := newClient(srv.URL, "")
-	response, err := c.execute("POST", "",
+	response, err := c.execute(c.requestContext(), "POST", "",
 		WithJSONBody(`{
 		"name": "test-secret",
 		"active": true
@@ -282,7 +381,7 @@ func TestWithFile(t *testing.T) {
 	defer os.Remove(tmpFile)
 
 	c := newClient(srv.URL, "")
-	response, err := c.execute("PUT", "", WithFile(tmpFile))
+	response, err := c.execute(c.requestContext(), "PUT", "", WithFile(tmpFile))
 	require.NoError(t, err)
 	require.True(t, gjson.Parse(string(response)).Get("ok").Bool())
 }
@@ -300,7 +399,7 @@ func Test_execute_ParsedResult(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	c := newClient(srv.URL, "")
-	response, err := execute(c, "GET", "")
+	response, err := execute(c.requestContext(), c, "GET", "")
 	require.NoError(t, err)
 	assert.Equal(t, "test-secret", response.Get("name").String())
 	assert.Equal(t, "user", response.Get("content.username").String())
@@ -312,7 +411,7 @@ func Test_execute_NoContent(t *testing.T) {
 	defer srv.Close()
 
 	c := newClient(srv.URL, "")
-	response, err := execute(c, http.MethodGet, "")
+	response, err := execute(c.requestContext(), c, http.MethodGet, "")
 	require.NoError(t, err)
 	assert.Equal(t, gjson.Null, response.Type)
 	assert.Equal(t, "", response.Raw)
@@ -325,7 +424,7 @@ func Test_execute_HTTPError(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	c := newClient(srv.URL, "")
-	response, err := execute(c, "GET", "")
+	response, err := execute(c.requestContext(), c, "GET", "")
 	assert.Equal(t, response, gjson.Result{})
 	assert.EqualError(t, err, fmt.Sprintf("status: %d, body: %s\n", http.StatusNotFound, []byte(`{"message":"missing"}`)))
 }
@@ -336,8 +435,8 @@ func Test_execute_RestyReturnsError(t *testing.T) {
 	base := srv.URL
 	srv.Close()
 
-	c := newClient(base, "")
-	response, err := execute(c, http.MethodGet, "/down")
+	c := newClient(base, "", WithRetryPolicy(RetryPolicy{}))
+	response, err := execute(c.requestContext(), c, http.MethodGet, "/down")
 	require.Error(t, err)
 	assert.Equal(t, response, gjson.Result{})
 	assert.Contains(t, err.Error(), base+"/down")
@@ -446,7 +545,7 @@ func Test_executeWithPagination_HTTPResponseCases(t *testing.T) {
 			defer srv.Close()
 
 			c := newClient(srv.URL, "")
-			results, err := executeWithPagination(c, tc.method, "")
+			results, err := executeWithPagination(c.requestContext(), c, tc.method, "")
 			if tc.err == nil {
 				require.NoError(t, err)
 				assert.Len(t, results, tc.expectedLen)
@@ -520,8 +619,8 @@ func Test_executeWithPagination_ErrorInSecondPage(t *testing.T) {
 		}))
 	t.Cleanup(srv.Close)
 
-	c := newClient(srv.URL, "")
-	response, err := executeWithPagination(c, http.MethodGet, "/getall")
+	c := newClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))
+	response, err := executeWithPagination(c.requestContext(), c, http.MethodGet, "/getall")
 	assert.Equal(t, []gjson.Result(nil), response)
 	var errStruct Error
 	require.ErrorAs(t, err, &errStruct)
@@ -534,8 +633,8 @@ func Test_executeWithPagination_RestyReturnsError(t *testing.T) {
 	base := srv.URL
 	srv.Close()
 
-	c := newClient(base, "")
-	response, err := executeWithPagination(c, http.MethodGet, "/down")
+	c := newClient(base, "", WithRetryPolicy(RetryPolicy{}))
+	response, err := executeWithPagination(c.requestContext(), c, http.MethodGet, "/down")
 	require.Error(t, err)
 	assert.Equal(t, response, []gjson.Result(nil))
 	assert.Contains(t, err.Error(), base+"/down")
@@ -654,7 +753,7 @@ func Test_executeWithPagination_ContentInSecondPage(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	c := newClient(srv.URL, "")
-	response, err := executeWithPagination(c, http.MethodPost, "/getall", WithJSONBody(body))
+	response, err := executeWithPagination(c.requestContext(), c, http.MethodPost, "/getall", WithJSONBody(body))
 	require.NoError(t, err)
 	assert.Len(t, response, 6)
 }
@@ -719,7 +818,293 @@ func Test_executeWithPagination_NoContentInSecondPage(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	c := newClient(srv.URL, "")
-	response, err := executeWithPagination(c, http.MethodGet, "/getall")
+	response, err := executeWithPagination(c.requestContext(), c, http.MethodGet, "/getall")
 	require.NoError(t, err)
 	assert.Len(t, response, 3)
 }
+
+// pagedHandler returns a handler that responds with the page of elements requested with the page query parameter.
+// The number of received requests is stored in the calls parameter.
+func pagedHandler(t *testing.T, pages [][]string, calls *atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		require.Less(t, pageNumber, len(pages))
+
+		totalSize := 0
+		for _, page := range pages {
+			totalSize += len(page)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"content":[%s],"totalSize":%d,"totalPages":%d,"numberOfElements":%d,"pageNumber":%d}`,
+			strings.Join(pages[pageNumber], ","), totalSize, len(pages), len(pages[pageNumber]), pageNumber)
+	}
+}
+
+// TestCollect tests the Collect() function.
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name     string
+		seq      iter.Seq2[gjson.Result, error]
+		expected []gjson.Result
+		err      error
+	}{
+		{
+			name: "Collect returns every element",
+			seq: func(yield func(gjson.Result, error) bool) {
+				_ = yield(gjson.Parse(`{"id":1}`), nil) && yield(gjson.Parse(`{"id":2}`), nil)
+			},
+			expected: []gjson.Result{gjson.Parse(`{"id":1}`), gjson.Parse(`{"id":2}`)},
+			err:      nil,
+		},
+		{
+			name:     "Collect returns an empty array",
+			seq:      func(yield func(gjson.Result, error) bool) {},
+			expected: []gjson.Result{},
+			err:      nil,
+		},
+		{
+			name: "Collect returns the error",
+			seq: func(yield func(gjson.Result, error) bool) {
+				_ = yield(gjson.Parse(`{"id":1}`), nil) && yield(gjson.Result{}, errors.New("page failed"))
+			},
+			expected: []gjson.Result(nil),
+			err:      errors.New("page failed"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := Collect(tc.seq)
+			if tc.err != nil {
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, results)
+		})
+	}
+}
+
+// TestLimit tests that Limit() yields up to the limit and does not request the pages after it.
+func TestLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit         int
+		expectedIds   []int64
+		expectedCalls int32
+	}{
+		{name: "The limit is in the first page", limit: 1, expectedIds: []int64{1}, expectedCalls: 1},
+		{name: "The limit is in the second page", limit: 3, expectedIds: []int64{1, 2, 3}, expectedCalls: 2},
+		{name: "The limit is greater than the number of elements", limit: 10, expectedIds: []int64{1, 2, 3, 4, 5}, expectedCalls: 3},
+		{name: "No limit", limit: 0, expectedIds: []int64{1, 2, 3, 4, 5}, expectedCalls: 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := &atomic.Int32{}
+			srv := httptest.NewServer(pagedHandler(t, [][]string{{`{"id":1}`, `{"id":2}`}, {`{"id":3}`, `{"id":4}`}, {`{"id":5}`}}, calls))
+			t.Cleanup(srv.Close)
+
+			c := newClient(srv.URL, "")
+			var ids []int64
+			for element, err := range Limit(paginate(c.requestContext(), c, http.MethodGet, ""), tc.limit) {
+				require.NoError(t, err)
+				ids = append(ids, element.Get("id").Int())
+			}
+
+			assert.Equal(t, tc.expectedIds, ids)
+			assert.Equal(t, tc.expectedCalls, calls.Load())
+		})
+	}
+}
+
+// Test_paginate_StreamsPages tests that paginate() yields the elements of every page in order.
+func Test_paginate_StreamsPages(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(pagedHandler(t, [][]string{{`{"id":1}`, `{"id":2}`}, {`{"id":3}`, `{"id":4}`}, {`{"id":5}`}}, calls))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "")
+	var ids []int64
+	for element, err := range paginate(c.requestContext(), c, http.MethodGet, "") {
+		require.NoError(t, err)
+		ids = append(ids, element.Get("id").Int())
+	}
+
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+// Test_paginate_StopsEarly tests that paginate() does not request more pages when the consumer stops iterating.
+func Test_paginate_StopsEarly(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(pagedHandler(t, [][]string{{`{"id":1}`, `{"id":2}`}, {`{"id":3}`, `{"id":4}`}, {`{"id":5}`}}, calls))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "")
+	var ids []int64
+	for element, err := range paginate(c.requestContext(), c, http.MethodGet, "") {
+		require.NoError(t, err)
+		ids = append(ids, element.Get("id").Int())
+		if len(ids) == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// Test_paginate_ErrorInSecondPage tests that paginate() yields the elements of the first page before the error of the second page.
+func Test_paginate_ErrorInSecondPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"Internal Server Error"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"content":[{"id":1}],"totalSize":2,"totalPages":2,"numberOfElements":1,"pageNumber":0}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))
+	var ids []int64
+	var iterErr error
+	for element, err := range paginate(c.requestContext(), c, http.MethodGet, "") {
+		if err != nil {
+			iterErr = err
+			continue
+		}
+		ids = append(ids, element.Get("id").Int())
+	}
+
+	assert.Equal(t, []int64{1}, ids)
+	assert.EqualError(t, iterErr, Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"error":"Internal Server Error"}`)}.Error())
+}
+
+// Test_paginate_Concurrency tests that paginate() prefetches the pages concurrently and keeps their order.
+func Test_paginate_Concurrency(t *testing.T) {
+	pages := [][]string{{`{"id":1}`, `{"id":2}`}, {`{"id":3}`, `{"id":4}`}, {`{"id":5}`, `{"id":6}`}, {`{"id":7}`, `{"id":8}`}, {`{"id":9}`}}
+	tests := []struct {
+		name        string
+		concurrency int
+	}{
+		{name: "Sequential pages", concurrency: 1},
+		{name: "Two pages at the same time", concurrency: 2},
+		{name: "More workers than pages", concurrency: 10},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := &atomic.Int32{}
+			active := &atomic.Int32{}
+			maxActive := &atomic.Int32{}
+			handler := pagedHandler(t, pages, calls)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				current := active.Add(1)
+				defer active.Add(-1)
+				for {
+					max := maxActive.Load()
+					if current <= max || maxActive.CompareAndSwap(max, current) {
+						break
+					}
+				}
+
+				// The later pages answer faster to check that the order is kept.
+				pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				time.Sleep(time.Duration(len(pages)-pageNumber) * 5 * time.Millisecond)
+				handler(w, r)
+			}))
+			t.Cleanup(srv.Close)
+
+			c := newClient(srv.URL, "", WithConcurrency(tc.concurrency))
+			var ids []int64
+			for element, err := range paginate(c.requestContext(), c, http.MethodGet, "") {
+				require.NoError(t, err)
+				ids = append(ids, element.Get("id").Int())
+			}
+
+			assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, ids)
+			assert.Equal(t, int32(len(pages)), calls.Load())
+			assert.LessOrEqual(t, maxActive.Load(), int32(max(tc.concurrency, 1)))
+		})
+	}
+}
+
+// Test_paginate_ConcurrencyErrorInPage tests that paginate() yields the elements of the previous pages and then the error of the failing page.
+func Test_paginate_ConcurrencyErrorInPage(t *testing.T) {
+	calls := &atomic.Int32{}
+	handler := pagedHandler(t, [][]string{{`{"id":1}`}, {`{"id":2}`}, {`{"id":3}`}, {`{"id":4}`}}, calls)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"Internal Server Error"}`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "", WithConcurrency(3), WithRetryPolicy(RetryPolicy{}))
+	var ids []int64
+	var iterErr error
+	for element, err := range paginate(c.requestContext(), c, http.MethodGet, "") {
+		if err != nil {
+			iterErr = err
+			continue
+		}
+		ids = append(ids, element.Get("id").Int())
+	}
+
+	assert.Equal(t, []int64{1, 2}, ids)
+	assert.EqualError(t, iterErr, Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"error":"Internal Server Error"}`)}.Error())
+
+	results, err := executeWithPagination(c.requestContext(), c, http.MethodGet, "")
+	assert.Equal(t, []gjson.Result(nil), results)
+	require.Error(t, err)
+}
+
+// Test_paginate_ConcurrencyStopsEarly tests that paginate() stops requesting pages when the consumer stops iterating.
+func Test_paginate_ConcurrencyStopsEarly(t *testing.T) {
+	calls := &atomic.Int32{}
+	pages := make([][]string, 20)
+	for i := range pages {
+		pages[i] = []string{fmt.Sprintf(`{"id":%d}`, i)}
+	}
+	srv := httptest.NewServer(pagedHandler(t, pages, calls))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "", WithConcurrency(2))
+	for element, err := range paginate(c.requestContext(), c, http.MethodGet, "") {
+		require.NoError(t, err)
+		if element.Get("id").Int() == 1 {
+			break
+		}
+	}
+
+	// The first page, the second page and up to two prefetched pages were requested.
+	assert.LessOrEqual(t, calls.Load(), int32(4))
+}
+
+// Test_client_execute_StructuredError tests that execute() returns a Discovery error with the fields of the error payload.
+func Test_client_execute_StructuredError(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusNotFound, "application/json", `{"status":404,"code":1003,"messages":["Seed not found: 3b32e410-2f33-412d-9fb8-17970131921c"],"timestamp":"2025-11-17T19:32:01.555127800Z"}`, nil))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "apiKey")
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed/3b32e410-2f33-412d-9fb8-17970131921c")
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+
+	var errStruct Error
+	require.ErrorAs(t, err, &errStruct)
+	assert.Equal(t, 1003, errStruct.Code)
+	assert.Equal(t, []string{"Seed not found: 3b32e410-2f33-412d-9fb8-17970131921c"}, errStruct.Messages)
+	assert.Equal(t, time.Date(2025, time.November, 17, 19, 32, 1, 555127800, time.UTC), errStruct.Timestamp)
+}
@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -17,5 +18,10 @@ type cloner struct {
 // It receives the UUID of the entity's identifier and the query parameters that can be used as arguments for the different clone endpoints in Discovery's entities.
 // For example, those parameters can be the name of the cloned entity, the URI of a cloned QueryFlow endpoint, or the depth of the copy.
 func (c cloner) Clone(id uuid.UUID, params map[string][]string) (gjson.Result, error) {
-	return execute(c.client, http.MethodPost, "/"+id.String()+"/clone", WithQueryParameters(params))
+	return c.CloneContext(c.client.requestContext(), id, params)
+}
+
+// CloneContext is like Clone, but it uses the given context for its requests instead of the one set with WithContext().
+func (c cloner) CloneContext(ctx context.Context, id uuid.UUID, params map[string][]string) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodPost, "/"+id.String()+"/clone", WithQueryParameters(params))
 }
@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -9,33 +10,48 @@ import (
 )
 
 // labelsClient is the struct that performs the CRUD of labels.
+// The labels are searched on the client, by their key and in the format {key}:{value}.
 type labelsClient struct {
 	crud
+	localSearcher
 }
 
 // newLabelsClient is the constructor of a labelsClient.
-func newLabelsClient(url, apiKey string) labelsClient {
+func newLabelsClient(url, apiKey string, options ...ClientOption) labelsClient {
+	labelsGetter := getter{
+		client: newClient(url+"/label", apiKey, options...),
+	}
 	return labelsClient{
-		crud{
-			getter{
-				client: newClient(url+"/label", apiKey),
-			},
+		crud: crud{
+			labelsGetter,
+		},
+		localSearcher: localSearcher{
+			getter:         labelsGetter,
+			nameField:      "key",
+			qualifierField: "value",
 		},
 	}
 }
 
 // secretsClient is the struct that performs the CRUD of secrets.
+// The secrets are searched on the client, by their name.
 type secretsClient struct {
 	crud
+	localSearcher
 }
 
 // newSecretsClient creates a new secretsClient.
-func newSecretsClient(url, apiKey string) secretsClient {
+func newSecretsClient(url, apiKey string, options ...ClientOption) secretsClient {
+	secretsGetter := getter{
+		client: newClient(url+"/secret", apiKey, options...),
+	}
 	return secretsClient{
-		crud{
-			getter{
-				client: newClient(url+"/secret", apiKey),
-			},
+		crud: crud{
+			secretsGetter,
+		},
+		localSearcher: localSearcher{
+			getter:    secretsGetter,
+			nameField: "name",
 		},
 	}
 }
@@ -48,8 +64,8 @@ type credentialsClient struct {
 }
 
 // newCredentialsClient creates a new credentialsClient.
-func newCredentialsClient(url, apiKey string) credentialsClient {
-	client := newClient(url+"/credential", apiKey)
+func newCredentialsClient(url, apiKey string, options ...ClientOption) credentialsClient {
+	client := newClient(url+"/credential", apiKey, options...)
 	return credentialsClient{
 		crud: crud{
 			getter{
@@ -73,8 +89,8 @@ type serversClient struct {
 }
 
 // newServersClient creates a new serversClient.
-func newServersClient(url, apiKey string) serversClient {
-	client := newClient(url+"/server", apiKey)
+func newServersClient(url, apiKey string, options ...ClientOption) serversClient {
+	client := newClient(url+"/server", apiKey, options...)
 	return serversClient{
 		crud: crud{
 			getter{
@@ -93,7 +109,12 @@ func newServersClient(url, apiKey string) serversClient {
 // Ping calls the endpoint to verify the connection to a server.
 // It returns acknowledged: true if the connection was successful.
 func (sc serversClient) Ping(id uuid.UUID) (gjson.Result, error) {
-	return execute(sc.crud.client, http.MethodGet, "/"+id.String()+"/ping")
+	return sc.PingContext(sc.crud.client.requestContext(), id)
+}
+
+// PingContext is like Ping, but it uses the given context for its requests instead of the one set with WithContext().
+func (sc serversClient) PingContext(ctx context.Context, id uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, sc.crud.client, http.MethodGet, "/"+id.String()+"/ping")
 }
 
 // filesClient is the struct that performs the CRUD of files.
@@ -102,8 +123,8 @@ type filesClient struct {
 }
 
 // newFilesClient is the constructor of the filesClient struct.
-func newFilesClient(url, apiKey string) filesClient {
-	client := newClient(url+"/file", apiKey)
+func newFilesClient(url, apiKey string, options ...ClientOption) filesClient {
+	client := newClient(url+"/file", apiKey, options...)
 	return filesClient{
 		client: client,
 	}
@@ -112,26 +133,46 @@ func newFilesClient(url, apiKey string) filesClient {
 // Upload receives a key and file and sends it to Discovery.
 // It returns acknowledged: true if the upload was successful.
 func (fc filesClient) Upload(key, file string) (gjson.Result, error) {
-	return execute(fc.client, http.MethodPut, "/"+key, WithFile(file))
+	return fc.UploadContext(fc.client.requestContext(), key, file)
+}
+
+// UploadContext is like Upload, but it uses the given context for its requests instead of the one set with WithContext().
+func (fc filesClient) UploadContext(ctx context.Context, key, file string) (gjson.Result, error) {
+	return execute(ctx, fc.client, http.MethodPut, "/"+key, WithFile(file))
 }
 
 // Retrieve obtains a file's data and returns it as an array of bytes.
 // It receives the key that corresponds to the file.
 func (fc filesClient) Retrieve(key string) ([]byte, error) {
-	return fc.execute(http.MethodGet, "/"+key)
+	return fc.RetrieveContext(fc.client.requestContext(), key)
+}
+
+// RetrieveContext is like Retrieve, but it uses the given context for its requests instead of the one set with WithContext().
+func (fc filesClient) RetrieveContext(ctx context.Context, key string) ([]byte, error) {
+	return fc.execute(ctx, http.MethodGet, "/"+key)
 }
 
 // List gets the json containing the list of all the files stored in Discovery Core, then converts it to
 // An array for ease of use.
 func (fc filesClient) List() ([]gjson.Result, error) {
-	result, err := execute(fc.client, http.MethodGet, "")
+	return fc.ListContext(fc.client.requestContext())
+}
+
+// ListContext is like List, but it uses the given context for its requests instead of the one set with WithContext().
+func (fc filesClient) ListContext(ctx context.Context) ([]gjson.Result, error) {
+	result, err := execute(ctx, fc.client, http.MethodGet, "")
 	return result.Array(), err
 }
 
 // Delete removes a file from Discovery based on the sent key.
 // It returns a JSON with the deletion acknowledgement or an error if any occured.
 func (fc filesClient) Delete(key string) (gjson.Result, error) {
-	return execute(fc.client, http.MethodDelete, "/"+key)
+	return fc.DeleteContext(fc.client.requestContext(), key)
+}
+
+// DeleteContext is like Delete, but it uses the given context for its requests instead of the one set with WithContext().
+func (fc filesClient) DeleteContext(ctx context.Context, key string) (gjson.Result, error) {
+	return execute(ctx, fc.client, http.MethodDelete, "/"+key)
 }
 
 // LogLevel is used as an enum to easily represent the logging levels.
@@ -157,9 +198,9 @@ type maintenanceClient struct {
 }
 
 // newMaintenanceClient creates a maintenanceClient.
-func newMaintenanceClient(url, apiKey string) maintenanceClient {
+func newMaintenanceClient(url, apiKey string, options ...ClientOption) maintenanceClient {
 	return maintenanceClient{
-		client: newClient(url+"/maintenance", apiKey),
+		client: newClient(url+"/maintenance", apiKey, options...),
 	}
 }
 
@@ -169,60 +210,72 @@ func newMaintenanceClient(url, apiKey string) maintenanceClient {
 // The log endpoint often returns an acknowledged: true, even if the component does not exist.
 // If the request to change the log level failed, a specific log with details of what happens appear in the Discovery component's logs, not on the response to the request.
 func (mc maintenanceClient) Log(componentName string, level LogLevel, loggerName string) (gjson.Result, error) {
-	return execute(mc.client, http.MethodPost, "/log", WithQueryParameters(map[string][]string{"componentName": {componentName}, "level": {string(level)}, "loggerName": {loggerName}}))
+	return mc.LogContext(mc.client.requestContext(), componentName, level, loggerName)
+}
+
+// LogContext is like Log, but it uses the given context for its requests instead of the one set with WithContext().
+func (mc maintenanceClient) LogContext(ctx context.Context, componentName string, level LogLevel, loggerName string) (gjson.Result, error) {
+	return execute(ctx, mc.client, http.MethodPost, "/log", WithQueryParameters(map[string][]string{"componentName": {componentName}, "level": {string(level)}, "loggerName": {loggerName}}))
 }
 
 // core is the struct for the client that can execute every Core operation.
 type core struct {
 	Url, ApiKey string
+	options     []ClientOption
 }
 
 // Servers creates a serversClient with the core's URL and API Key.
 func (c core) Servers() serversClient {
-	return newServersClient(c.Url, c.ApiKey)
+	return newServersClient(c.Url, c.ApiKey, c.options...)
 }
 
 // Credentials creates a credentialsClient with the core's URL and API Key.
 func (c core) Credentials() credentialsClient {
-	return newCredentialsClient(c.Url, c.ApiKey)
+	return newCredentialsClient(c.Url, c.ApiKey, c.options...)
 }
 
 // Secrets creates a secretsClient with the core's URL and API Key.
 func (c core) Secrets() secretsClient {
-	return newSecretsClient(c.Url, c.ApiKey)
+	return newSecretsClient(c.Url, c.ApiKey, c.options...)
 }
 
 // Labels creates a labelsClient with the core's URL and API Key.
 func (c core) Labels() labelsClient {
-	return newLabelsClient(c.Url, c.ApiKey)
+	return newLabelsClient(c.Url, c.ApiKey, c.options...)
 }
 
 // Files creates a filesClient with the core's URL and API Key.
 func (c core) Files() filesClient {
-	return newFilesClient(c.Url, c.ApiKey)
+	return newFilesClient(c.Url, c.ApiKey, c.options...)
 }
 
 // Maintenance creates a maintenanceClient with the core's URL and API Key.
 func (c core) Maintenance() maintenanceClient {
-	return newMaintenanceClient(c.Url, c.ApiKey)
+	return newMaintenanceClient(c.Url, c.ApiKey, c.options...)
 }
 
 // BackupRestore creates a backupRestore with the core's URL and API Key.
 func (c core) BackupRestore() backupRestore {
 	return backupRestore{
-		client: newClient(c.Url, c.ApiKey),
+		client: newClient(c.Url, c.ApiKey, c.options...),
 	}
 }
 
 // StatusChecker creates a statusChecker with the Core's URL and API Key.
 func (c core) StatusChecker() statusChecker {
 	return statusChecker{
-		client: newClient(c.Url[:len(c.Url)-3], c.ApiKey),
+		client: newClient(c.Url[:len(c.Url)-3], c.ApiKey, c.options...),
 	}
 }
 
-// NewCore is the constructor for the core struct.
+// NewCore creates the client of Discovery Core, which implements the Core interface.
 // It adds a /v2 path to the URL in order to properly connect to Discovery.
-func NewCore(url, apiKey string) core {
-	return core{Url: strings.TrimRight(url, "/") + "/v2", ApiKey: apiKey}
+// The client options are applied to every client created by it.
+func NewCore(url, apiKey string, options ...ClientOption) Core {
+	return coreSDK{newCore(url, apiKey, options...)}
+}
+
+// newCore is the constructor for the core struct.
+func newCore(url, apiKey string, options ...ClientOption) core {
+	return core{Url: strings.TrimRight(url, "/") + "/v2", ApiKey: apiKey, options: options}
 }
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pureinsights/discovery-cli/internal/fileutils"
@@ -23,6 +24,9 @@ func Test_newLabelsClient(t *testing.T) {
 
 	assert.Equal(t, c.ApiKey, lc.ApiKey)
 	assert.Equal(t, c.client.BaseURL+"/label", lc.client.client.BaseURL)
+	assert.Equal(t, c.client.BaseURL+"/label", lc.localSearcher.getter.client.client.BaseURL)
+	assert.Equal(t, "key", lc.nameField)
+	assert.Equal(t, "value", lc.qualifierField)
 }
 
 // Test_newSecretsClient tests the constructor of newSecretsClient.
@@ -32,6 +36,9 @@ func Test_newSecretsClient(t *testing.T) {
 
 	assert.Equal(t, c.ApiKey, sc.ApiKey)
 	assert.Equal(t, c.client.BaseURL+"/secret", sc.client.client.BaseURL)
+	assert.Equal(t, c.client.BaseURL+"/secret", sc.localSearcher.getter.client.client.BaseURL)
+	assert.Equal(t, "name", sc.nameField)
+	assert.Empty(t, sc.qualifierField)
 }
 
 // Test_newCredentialsClient tests the constructor of newCredentialsClient.
@@ -208,7 +215,7 @@ func Test_serversClient_Ping(t *testing.T) {
 				}))
 			defer srv.Close()
 
-			serverClient := newServersClient(srv.URL, "")
+			serverClient := newServersClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))
 			id := uuid.MustParse("f6950327-3175-4a98-a570-658df852424a")
 			response, err := serverClient.Ping(id)
 			assert.Equal(t, tc.expectedResponse, response)
@@ -456,7 +463,7 @@ func Test_filesClient_List(t *testing.T) {
 			}))
 			defer srv.Close()
 
-			filesClient := newFilesClient(srv.URL, "")
+			filesClient := newFilesClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))
 			response, err := filesClient.List()
 			assert.Equal(t, tc.expectedResponse, response)
 			if tc.err == nil {
@@ -526,7 +533,7 @@ func Test_filesClient_Delete(t *testing.T) {
 			}))
 			defer srv.Close()
 
-			filesClient := newFilesClient(srv.URL, "")
+			filesClient := newFilesClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))
 			response, err := filesClient.Delete("testFile")
 			assert.Equal(t, tc.expectedResponse, response)
 			if tc.err == nil {
@@ -653,7 +660,7 @@ func Test_maintenanceClient_Log(t *testing.T) {
 
 // Test_core_Labels tests the core.Labels() function.
 func Test_core_Labels(t *testing.T) {
-	c := NewCore("http://localhost:12010", "Api Key")
+	c := newCore("http://localhost:12010", "Api Key")
 	lc := c.Labels()
 
 	assert.Equal(t, c.ApiKey, lc.ApiKey)
@@ -662,7 +669,7 @@ func Test_core_Labels(t *testing.T) {
 
 // Test_core_Secrets tests the core.Secrets() function.
 func Test_core_Secrets(t *testing.T) {
-	c := NewCore("http://localhost:12010", "Api Key")
+	c := newCore("http://localhost:12010", "Api Key")
 	sc := c.Secrets()
 
 	assert.Equal(t, c.ApiKey, sc.ApiKey)
@@ -671,7 +678,7 @@ func Test_core_Secrets(t *testing.T) {
 
 // Test_core_Credentials tests the core.Credentials() function.
 func Test_core_Credentials(t *testing.T) {
-	c := NewCore("http://localhost:12010", "Api Key")
+	c := newCore("http://localhost:12010", "Api Key")
 	cc := c.Credentials()
 
 	assert.Equal(t, c.ApiKey, cc.crud.ApiKey)
@@ -684,7 +691,7 @@ func Test_core_Credentials(t *testing.T) {
 
 // Test_core_Servers tests the core.Servers() function.
 func Test_core_Servers(t *testing.T) {
-	c := NewCore("http://localhost:12010", "Api Key")
+	c := newCore("http://localhost:12010", "Api Key")
 	sc := c.Servers()
 
 	assert.Equal(t, c.ApiKey, sc.crud.ApiKey)
@@ -697,7 +704,7 @@ func Test_core_Servers(t *testing.T) {
 
 // Test_core_Files tests the core.Files() function.
 func Test_core_Files(t *testing.T) {
-	c := NewCore("http://localhost:12010", "Api Key")
+	c := newCore("http://localhost:12010", "Api Key")
 	fc := c.Files()
 
 	assert.Equal(t, c.ApiKey, fc.ApiKey)
@@ -706,7 +713,7 @@ func Test_core_Files(t *testing.T) {
 
 // Test_core_Maintenance tests the core.Maintenance() function.
 func Test_core_Maintenance(t *testing.T) {
-	c := NewCore("http://localhost:12010", "Api Key")
+	c := newCore("http://localhost:12010", "Api Key")
 	mc := c.Maintenance()
 
 	assert.Equal(t, c.ApiKey, mc.ApiKey)
@@ -715,7 +722,7 @@ func Test_core_Maintenance(t *testing.T) {
 
 // Test_core_BackupRestore tests the core.BackupRestore() function.
 func Test_core_BackupRestore(t *testing.T) {
-	c := NewCore("http://localhost:12010", "Api Key")
+	c := newCore("http://localhost:12010", "Api Key")
 	bc := c.BackupRestore()
 
 	assert.Equal(t, c.ApiKey, bc.ApiKey)
@@ -724,7 +731,7 @@ func Test_core_BackupRestore(t *testing.T) {
 
 // Test_core_StatusChecker tests the core.StatusChecker() function.
 func Test_core_StatusChecker(t *testing.T) {
-	c := NewCore("http://localhost:12010", "Api Key")
+	c := newCore("http://localhost:12010", "Api Key")
 	bc := c.StatusChecker()
 
 	assert.Equal(t, c.ApiKey, bc.ApiKey)
@@ -734,8 +741,18 @@ func Test_core_StatusChecker(t *testing.T) {
 // Test_NewCore_UrlAndAPIKey tests the function to create a new core client.
 // It verifies that the API Key and base URL correctly match.
 func Test_NewCore_UrlAndAPIKey(t *testing.T) {
-	c := NewCore("http://localhost:12010////////////", "secret-key")
+	c := newCore("http://localhost:12010////////////", "secret-key")
 
 	assert.Equal(t, "secret-key", c.ApiKey, "ApiKey should be stored")
 	assert.Equal(t, "http://localhost:12010/v2", c.Url, "BaseURL should match server URL")
 }
+
+// Test_NewCore_ClientOptions tests that the client options are applied to the clients created by the core struct.
+func Test_NewCore_ClientOptions(t *testing.T) {
+	c := newCore("http://localhost:12010", "secret-key", WithTimeout(5*time.Second))
+
+	assert.Equal(t, 5*time.Second, c.Servers().crud.client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, c.Labels().client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, c.BackupRestore().client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, c.StatusChecker().client.client.GetClient().Timeout)
+}
@@ -1,6 +1,8 @@
 package discovery
 
 import (
+	"context"
+	"iter"
 	"net/http"
 
 	"github.com/tidwall/gjson"
@@ -16,13 +18,35 @@ type getter struct {
 // Get executes a GET request to the client's endpoint to get a single entity.
 // It returns the JSON object it receives or an error if the request failed.
 func (getter getter) Get(id uuid.UUID) (gjson.Result, error) {
-	return execute(getter.client, http.MethodGet, "/"+id.String())
+	return getter.GetContext(getter.client.requestContext(), id)
+}
+
+// GetContext is like Get, but it uses the given context for its requests instead of the one set with WithContext().
+func (getter getter) GetContext(ctx context.Context, id uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, getter.client, http.MethodGet, "/"+id.String())
+}
+
+// GetAllSeq returns a sequence that iterates through every entity.
+// The pages are requested as the sequence is consumed, so the entities can be processed before all of them are retrieved.
+// The request options can change the pages, like WithSort() and WithPageSize().
+func (getter getter) GetAllSeq(options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return getter.GetAllSeqContext(getter.client.requestContext(), options...)
+}
+
+// GetAllSeqContext is like GetAllSeq, but it uses the given context for its requests instead of the one set with WithContext().
+func (getter getter) GetAllSeqContext(ctx context.Context, options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return paginate(ctx, getter.client, http.MethodGet, "", options...)
 }
 
 // GetAll retrieves every entity. It iterates through every page to get all of the results.
 // It returns an array of JSON objects or an error if the request failed.
-func (getter getter) GetAll() ([]gjson.Result, error) {
-	return executeWithPagination(getter.client, http.MethodGet, "")
+func (getter getter) GetAll(options ...RequestOption) ([]gjson.Result, error) {
+	return getter.GetAllContext(getter.client.requestContext(), options...)
+}
+
+// GetAllContext is like GetAll, but it uses the given context for its requests instead of the one set with WithContext().
+func (getter getter) GetAllContext(ctx context.Context, options ...RequestOption) ([]gjson.Result, error) {
+	return executeWithPagination(ctx, getter.client, http.MethodGet, "", options...)
 }
 
 // crud is a struct that has creates, reads, updates, and deletes entities.
@@ -33,17 +57,32 @@ type crud struct {
 // Create creates an entity.
 // It returns the body of the entity if it was created or an error if the request failed.
 func (crud crud) Create(config gjson.Result) (gjson.Result, error) {
-	return execute(crud.client, http.MethodPost, "", WithJSONBody(config.Raw))
+	return crud.CreateContext(crud.client.requestContext(), config)
+}
+
+// CreateContext is like Create, but it uses the given context for its requests instead of the one set with WithContext().
+func (crud crud) CreateContext(ctx context.Context, config gjson.Result) (gjson.Result, error) {
+	return execute(ctx, crud.client, http.MethodPost, "", WithJSONBody(config.Raw))
 }
 
 // Update updates an entity.
 // It returns the body of the entity if it was updated or an error if the request failed.
 func (crud crud) Update(id uuid.UUID, config gjson.Result) (gjson.Result, error) {
-	return execute(crud.client, http.MethodPut, "/"+id.String(), WithJSONBody(config.Raw))
+	return crud.UpdateContext(crud.client.requestContext(), id, config)
+}
+
+// UpdateContext is like Update, but it uses the given context for its requests instead of the one set with WithContext().
+func (crud crud) UpdateContext(ctx context.Context, id uuid.UUID, config gjson.Result) (gjson.Result, error) {
+	return execute(ctx, crud.client, http.MethodPut, "/"+id.String(), WithJSONBody(config.Raw))
 }
 
 // Delete deletes an entity.
 // It returns the the acknowledged message if it was deleted or an error if the request failed.
 func (crud crud) Delete(id uuid.UUID) (gjson.Result, error) {
-	return execute(crud.client, http.MethodDelete, "/"+id.String())
+	return crud.DeleteContext(crud.client.requestContext(), id)
+}
+
+// DeleteContext is like Delete, but it uses the given context for its requests instead of the one set with WithContext().
+func (crud crud) DeleteContext(ctx context.Context, id uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, crud.client, http.MethodDelete, "/"+id.String())
 }
@@ -1,9 +1,11 @@
 package discovery
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/google/uuid"
@@ -302,7 +304,7 @@ func Test_crud_Update(t *testing.T) {
 
 			defer srv.Close()
 
-			c := crud{getter{newClient(srv.URL, "")}}
+			c := crud{getter{newClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))}}
 			id := uuid.MustParse("5f125024-1e5e-4591-9fee-365dc20eeeed")
 			config := gjson.Parse(tc.body)
 			response, err := c.Update(id, config)
@@ -377,3 +379,85 @@ func Test_crud_Delete(t *testing.T) {
 		})
 	}
 }
+
+// Test_getter_GetAllSeq tests that getter.GetAllSeq() yields the entities of every page.
+func Test_getter_GetAllSeq(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(pagedHandler(t, [][]string{{`{"name":"a"}`, `{"name":"b"}`}, {`{"name":"c"}`}}, calls))
+	t.Cleanup(srv.Close)
+
+	g := getter{client: newClient(srv.URL, "")}
+	var names []string
+	for entity, err := range g.GetAllSeq() {
+		require.NoError(t, err)
+		names = append(names, entity.Get("name").String())
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// Test_getter_GetAllSeq_Options tests that the request options are sent in the request of every page.
+func Test_getter_GetAllSeq_Options(t *testing.T) {
+	calls := &atomic.Int32{}
+	handler := pagedHandler(t, [][]string{{`{"name":"c"}`, `{"name":"b"}`}, {`{"name":"a"}`}}, calls)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "name,desc", r.URL.Query().Get("sort"))
+		assert.Equal(t, "2", r.URL.Query().Get("size"))
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	g := getter{client: newClient(srv.URL, "")}
+	results, err := g.GetAll(WithSort("name", SortDescending), WithPageSize(2))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, "a", results[2].Get("name").String())
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// Test_getter_GetContext tests that getter.GetContext() uses the given context instead of the one set with WithContext().
+func Test_getter_GetContext(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{"id":"5f125024-1e5e-4591-9fee-365dc20eeeed"}`, nil))
+	t.Cleanup(srv.Close)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	id := uuid.MustParse("5f125024-1e5e-4591-9fee-365dc20eeeed")
+
+	g := getter{client: newClient(srv.URL, "", WithContext(canceled))}
+	_, err := g.Get(id)
+	require.ErrorIs(t, err, context.Canceled)
+
+	response, err := g.GetContext(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, id.String(), response.Get("id").String())
+
+	g = getter{client: newClient(srv.URL, "")}
+	_, err = g.GetContext(canceled, id)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// Test_getter_GetAllSeqContext tests that getter.GetAllSeqContext() uses the given context for the request of every page.
+func Test_getter_GetAllSeqContext(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(pagedHandler(t, [][]string{{`{"name":"a"}`}, {`{"name":"b"}`}}, calls))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	g := getter{client: newClient(srv.URL, "")}
+	var names []string
+	for entity, err := range g.GetAllSeqContext(ctx) {
+		if err != nil {
+			require.ErrorIs(t, err, context.Canceled)
+			break
+		}
+		names = append(names, entity.Get("name").String())
+		cancel()
+	}
+
+	assert.Equal(t, []string{"a"}, names)
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,839 @@
+package discoverytest
+
+import (
+	"context"
+	"iter"
+
+	"github.com/google/uuid"
+	"github.com/pureinsights/discovery-cli/discovery"
+	"github.com/tidwall/gjson"
+)
+
+// The following assertions check that the mocks implement the interfaces of the discovery package.
+var (
+	_ discovery.LabelsClient               = LabelsClient{}
+	_ discovery.SecretsClient              = SecretsClient{}
+	_ discovery.CredentialsClient          = CredentialsClient{}
+	_ discovery.ServersClient              = ServersClient{}
+	_ discovery.FilesClient                = FilesClient{}
+	_ discovery.MaintenanceClient          = MaintenanceClient{}
+	_ discovery.BackupRestorer             = BackupRestorer{}
+	_ discovery.StatusChecker              = StatusChecker{}
+	_ discovery.Core                       = Core{}
+	_ discovery.IngestionProcessorsClient  = IngestionProcessorsClient{}
+	_ discovery.IngestionPipelinesClient   = IngestionPipelinesClient{}
+	_ discovery.SeedRecordsClient          = SeedRecordsClient{}
+	_ discovery.SeedExecutionRecordsClient = SeedExecutionRecordsClient{}
+	_ discovery.SeedExecutionJobsClient    = SeedExecutionJobsClient{}
+	_ discovery.SeedExecutionsClient       = SeedExecutionsClient{}
+	_ discovery.SeedsClient                = SeedsClient{}
+	_ discovery.SeedSchedulesClient        = SeedSchedulesClient{}
+	_ discovery.Ingestion                  = Ingestion{}
+	_ discovery.QueryFlowProcessorsClient  = QueryFlowProcessorsClient{}
+	_ discovery.QueryFlowPipelinesClient   = QueryFlowPipelinesClient{}
+	_ discovery.EndpointsClient            = EndpointsClient{}
+	_ discovery.ToolsClient                = ToolsClient{}
+	_ discovery.MCPServersClient           = MCPServersClient{}
+	_ discovery.QueryFlow                  = QueryFlow{}
+	_ discovery.BucketsClient              = BucketsClient{}
+	_ discovery.ContentClient              = ContentClient{}
+	_ discovery.Staging                    = Staging{}
+)
+
+// orDefault calls the function if it is set. Otherwise, it returns the default value.
+func orDefault[T any](f func() T, value T) T {
+	if f == nil {
+		return value
+	}
+	return f()
+}
+
+// LabelsClient mocks the discovery.LabelsClient interface.
+type LabelsClient struct {
+	CRUD
+	Searcher
+}
+
+// SecretsClient mocks the discovery.SecretsClient interface.
+type SecretsClient struct {
+	CRUD
+	Searcher
+}
+
+// CredentialsClient mocks the discovery.CredentialsClient interface.
+type CredentialsClient struct {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// ServersClient mocks the discovery.ServersClient interface.
+type ServersClient struct {
+	CRUD
+	Cloner
+	Searcher
+	PingFunc func(id uuid.UUID) (gjson.Result, error)
+}
+
+// Ping calls PingFunc.
+func (m ServersClient) Ping(id uuid.UUID) (gjson.Result, error) {
+	if m.PingFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.PingFunc(id)
+}
+
+// PingContext calls Ping. The context is ignored.
+func (m ServersClient) PingContext(_ context.Context, id uuid.UUID) (gjson.Result, error) {
+	return m.Ping(id)
+}
+
+// FilesClient mocks the discovery.FilesClient interface.
+type FilesClient struct {
+	UploadFunc   func(key, file string) (gjson.Result, error)
+	RetrieveFunc func(key string) ([]byte, error)
+	ListFunc     func() ([]gjson.Result, error)
+	DeleteFunc   func(key string) (gjson.Result, error)
+}
+
+// Upload calls UploadFunc.
+func (m FilesClient) Upload(key, file string) (gjson.Result, error) {
+	if m.UploadFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.UploadFunc(key, file)
+}
+
+// UploadContext calls Upload. The context is ignored.
+func (m FilesClient) UploadContext(_ context.Context, key, file string) (gjson.Result, error) {
+	return m.Upload(key, file)
+}
+
+// Retrieve calls RetrieveFunc.
+func (m FilesClient) Retrieve(key string) ([]byte, error) {
+	if m.RetrieveFunc == nil {
+		return nil, ErrNotMocked
+	}
+	return m.RetrieveFunc(key)
+}
+
+// RetrieveContext calls Retrieve. The context is ignored.
+func (m FilesClient) RetrieveContext(_ context.Context, key string) ([]byte, error) {
+	return m.Retrieve(key)
+}
+
+// List calls ListFunc.
+func (m FilesClient) List() ([]gjson.Result, error) {
+	if m.ListFunc == nil {
+		return nil, ErrNotMocked
+	}
+	return m.ListFunc()
+}
+
+// ListContext calls List. The context is ignored.
+func (m FilesClient) ListContext(_ context.Context) ([]gjson.Result, error) {
+	return m.List()
+}
+
+// Delete calls DeleteFunc.
+func (m FilesClient) Delete(key string) (gjson.Result, error) {
+	if m.DeleteFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.DeleteFunc(key)
+}
+
+// DeleteContext calls Delete. The context is ignored.
+func (m FilesClient) DeleteContext(_ context.Context, key string) (gjson.Result, error) {
+	return m.Delete(key)
+}
+
+// MaintenanceClient mocks the discovery.MaintenanceClient interface.
+type MaintenanceClient struct {
+	LogFunc func(componentName string, level discovery.LogLevel, loggerName string) (gjson.Result, error)
+}
+
+// Log calls LogFunc.
+func (m MaintenanceClient) Log(componentName string, level discovery.LogLevel, loggerName string) (gjson.Result, error) {
+	if m.LogFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.LogFunc(componentName, level, loggerName)
+}
+
+// LogContext calls Log. The context is ignored.
+func (m MaintenanceClient) LogContext(_ context.Context, componentName string, level discovery.LogLevel, loggerName string) (gjson.Result, error) {
+	return m.Log(componentName, level, loggerName)
+}
+
+// Core mocks the discovery.Core interface.
+// If a function is not set, the method returns an empty mock.
+type Core struct {
+	ServersFunc       func() discovery.ServersClient
+	CredentialsFunc   func() discovery.CredentialsClient
+	SecretsFunc       func() discovery.SecretsClient
+	LabelsFunc        func() discovery.LabelsClient
+	FilesFunc         func() discovery.FilesClient
+	MaintenanceFunc   func() discovery.MaintenanceClient
+	BackupRestoreFunc func() discovery.BackupRestorer
+	StatusCheckerFunc func() discovery.StatusChecker
+}
+
+// Servers calls ServersFunc.
+func (m Core) Servers() discovery.ServersClient {
+	return orDefault[discovery.ServersClient](m.ServersFunc, ServersClient{})
+}
+
+// Credentials calls CredentialsFunc.
+func (m Core) Credentials() discovery.CredentialsClient {
+	return orDefault[discovery.CredentialsClient](m.CredentialsFunc, CredentialsClient{})
+}
+
+// Secrets calls SecretsFunc.
+func (m Core) Secrets() discovery.SecretsClient {
+	return orDefault[discovery.SecretsClient](m.SecretsFunc, SecretsClient{})
+}
+
+// Labels calls LabelsFunc.
+func (m Core) Labels() discovery.LabelsClient {
+	return orDefault[discovery.LabelsClient](m.LabelsFunc, LabelsClient{})
+}
+
+// Files calls FilesFunc.
+func (m Core) Files() discovery.FilesClient {
+	return orDefault[discovery.FilesClient](m.FilesFunc, FilesClient{})
+}
+
+// Maintenance calls MaintenanceFunc.
+func (m Core) Maintenance() discovery.MaintenanceClient {
+	return orDefault[discovery.MaintenanceClient](m.MaintenanceFunc, MaintenanceClient{})
+}
+
+// BackupRestore calls BackupRestoreFunc.
+func (m Core) BackupRestore() discovery.BackupRestorer {
+	return orDefault[discovery.BackupRestorer](m.BackupRestoreFunc, BackupRestorer{})
+}
+
+// StatusChecker calls StatusCheckerFunc.
+func (m Core) StatusChecker() discovery.StatusChecker {
+	return orDefault[discovery.StatusChecker](m.StatusCheckerFunc, StatusChecker{})
+}
+
+// IngestionProcessorsClient mocks the discovery.IngestionProcessorsClient interface.
+type IngestionProcessorsClient struct {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// IngestionPipelinesClient mocks the discovery.IngestionPipelinesClient interface.
+type IngestionPipelinesClient struct {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// SeedRecordsClient mocks the discovery.SeedRecordsClient interface.
+type SeedRecordsClient struct {
+	Summarizer
+	GetFunc       func(id string) (gjson.Result, error)
+	GetAllFunc    func(options ...discovery.RequestOption) ([]gjson.Result, error)
+	GetAllSeqFunc func(options ...discovery.RequestOption) iter.Seq2[gjson.Result, error]
+}
+
+// Get calls GetFunc.
+func (m SeedRecordsClient) Get(id string) (gjson.Result, error) {
+	if m.GetFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.GetFunc(id)
+}
+
+// GetContext calls Get. The context is ignored.
+func (m SeedRecordsClient) GetContext(_ context.Context, id string) (gjson.Result, error) {
+	return m.Get(id)
+}
+
+// GetAll calls GetAllFunc.
+func (m SeedRecordsClient) GetAll(options ...discovery.RequestOption) ([]gjson.Result, error) {
+	if m.GetAllFunc == nil {
+		return nil, ErrNotMocked
+	}
+	return m.GetAllFunc(options...)
+}
+
+// GetAllContext calls GetAll. The context is ignored.
+func (m SeedRecordsClient) GetAllContext(_ context.Context, options ...discovery.RequestOption) ([]gjson.Result, error) {
+	return m.GetAll(options...)
+}
+
+// GetAllSeq calls GetAllSeqFunc.
+// If it is not set, the sequence iterates through the results of GetAll.
+func (m SeedRecordsClient) GetAllSeq(options ...discovery.RequestOption) iter.Seq2[gjson.Result, error] {
+	if m.GetAllSeqFunc != nil {
+		return m.GetAllSeqFunc(options...)
+	}
+	return sliceSeq(func() ([]gjson.Result, error) { return m.GetAll(options...) })
+}
+
+// GetAllSeqContext calls GetAllSeq. The context is ignored.
+func (m SeedRecordsClient) GetAllSeqContext(_ context.Context, options ...discovery.RequestOption) iter.Seq2[gjson.Result, error] {
+	return m.GetAllSeq(options...)
+}
+
+// SeedExecutionRecordsClient mocks the discovery.SeedExecutionRecordsClient interface.
+type SeedExecutionRecordsClient struct {
+	Summarizer
+}
+
+// SeedExecutionJobsClient mocks the discovery.SeedExecutionJobsClient interface.
+type SeedExecutionJobsClient struct {
+	Summarizer
+}
+
+// SeedExecutionsClient mocks the discovery.SeedExecutionsClient interface.
+// If RecordsFunc or JobsFunc are not set, the Records and Jobs methods return empty mocks.
+type SeedExecutionsClient struct {
+	Getter
+	GetLast5ExecutionsFunc func() (gjson.Result, error)
+	HaltFunc               func(executionId uuid.UUID) (gjson.Result, error)
+	AuditFunc              func(executionId uuid.UUID) ([]gjson.Result, error)
+	AuditSeqFunc           func(executionId uuid.UUID) iter.Seq2[gjson.Result, error]
+	SeedFunc               func(executionId uuid.UUID) (gjson.Result, error)
+	PipelineFunc           func(executionId, pipelineId uuid.UUID) (gjson.Result, error)
+	ProcessorFunc          func(executionId, processorId uuid.UUID) (gjson.Result, error)
+	ServerFunc             func(executionId, serverId uuid.UUID) (gjson.Result, error)
+	CredentialFunc         func(executionId, credentialId uuid.UUID) (gjson.Result, error)
+	RecordsFunc            func(executionId uuid.UUID) discovery.SeedExecutionRecordsClient
+	JobsFunc               func(executionId uuid.UUID) discovery.SeedExecutionJobsClient
+}
+
+// GetLast5Executions calls GetLast5ExecutionsFunc.
+func (m SeedExecutionsClient) GetLast5Executions() (gjson.Result, error) {
+	if m.GetLast5ExecutionsFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.GetLast5ExecutionsFunc()
+}
+
+// GetLast5ExecutionsContext calls GetLast5Executions. The context is ignored.
+func (m SeedExecutionsClient) GetLast5ExecutionsContext(_ context.Context) (gjson.Result, error) {
+	return m.GetLast5Executions()
+}
+
+// Halt calls HaltFunc.
+func (m SeedExecutionsClient) Halt(executionId uuid.UUID) (gjson.Result, error) {
+	if m.HaltFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.HaltFunc(executionId)
+}
+
+// HaltContext calls Halt. The context is ignored.
+func (m SeedExecutionsClient) HaltContext(_ context.Context, executionId uuid.UUID) (gjson.Result, error) {
+	return m.Halt(executionId)
+}
+
+// Audit calls AuditFunc.
+func (m SeedExecutionsClient) Audit(executionId uuid.UUID) ([]gjson.Result, error) {
+	if m.AuditFunc == nil {
+		return nil, ErrNotMocked
+	}
+	return m.AuditFunc(executionId)
+}
+
+// AuditContext calls Audit. The context is ignored.
+func (m SeedExecutionsClient) AuditContext(_ context.Context, executionId uuid.UUID) ([]gjson.Result, error) {
+	return m.Audit(executionId)
+}
+
+// AuditSeq calls AuditSeqFunc.
+// If it is not set, the sequence iterates through the results of Audit.
+func (m SeedExecutionsClient) AuditSeq(executionId uuid.UUID) iter.Seq2[gjson.Result, error] {
+	if m.AuditSeqFunc != nil {
+		return m.AuditSeqFunc(executionId)
+	}
+	return sliceSeq(func() ([]gjson.Result, error) { return m.Audit(executionId) })
+}
+
+// AuditSeqContext calls AuditSeq. The context is ignored.
+func (m SeedExecutionsClient) AuditSeqContext(_ context.Context, executionId uuid.UUID) iter.Seq2[gjson.Result, error] {
+	return m.AuditSeq(executionId)
+}
+
+// Seed calls SeedFunc.
+func (m SeedExecutionsClient) Seed(executionId uuid.UUID) (gjson.Result, error) {
+	if m.SeedFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.SeedFunc(executionId)
+}
+
+// SeedContext calls Seed. The context is ignored.
+func (m SeedExecutionsClient) SeedContext(_ context.Context, executionId uuid.UUID) (gjson.Result, error) {
+	return m.Seed(executionId)
+}
+
+// Pipeline calls PipelineFunc.
+func (m SeedExecutionsClient) Pipeline(executionId, pipelineId uuid.UUID) (gjson.Result, error) {
+	if m.PipelineFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.PipelineFunc(executionId, pipelineId)
+}
+
+// PipelineContext calls Pipeline. The context is ignored.
+func (m SeedExecutionsClient) PipelineContext(_ context.Context, executionId, pipelineId uuid.UUID) (gjson.Result, error) {
+	return m.Pipeline(executionId, pipelineId)
+}
+
+// Processor calls ProcessorFunc.
+func (m SeedExecutionsClient) Processor(executionId, processorId uuid.UUID) (gjson.Result, error) {
+	if m.ProcessorFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.ProcessorFunc(executionId, processorId)
+}
+
+// ProcessorContext calls Processor. The context is ignored.
+func (m SeedExecutionsClient) ProcessorContext(_ context.Context, executionId, processorId uuid.UUID) (gjson.Result, error) {
+	return m.Processor(executionId, processorId)
+}
+
+// Server calls ServerFunc.
+func (m SeedExecutionsClient) Server(executionId, serverId uuid.UUID) (gjson.Result, error) {
+	if m.ServerFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.ServerFunc(executionId, serverId)
+}
+
+// ServerContext calls Server. The context is ignored.
+func (m SeedExecutionsClient) ServerContext(_ context.Context, executionId, serverId uuid.UUID) (gjson.Result, error) {
+	return m.Server(executionId, serverId)
+}
+
+// Credential calls CredentialFunc.
+func (m SeedExecutionsClient) Credential(executionId, credentialId uuid.UUID) (gjson.Result, error) {
+	if m.CredentialFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.CredentialFunc(executionId, credentialId)
+}
+
+// CredentialContext calls Credential. The context is ignored.
+func (m SeedExecutionsClient) CredentialContext(_ context.Context, executionId, credentialId uuid.UUID) (gjson.Result, error) {
+	return m.Credential(executionId, credentialId)
+}
+
+// Records calls RecordsFunc.
+func (m SeedExecutionsClient) Records(executionId uuid.UUID) discovery.SeedExecutionRecordsClient {
+	if m.RecordsFunc == nil {
+		return SeedExecutionRecordsClient{}
+	}
+	return m.RecordsFunc(executionId)
+}
+
+// Jobs calls JobsFunc.
+func (m SeedExecutionsClient) Jobs(executionId uuid.UUID) discovery.SeedExecutionJobsClient {
+	if m.JobsFunc == nil {
+		return SeedExecutionJobsClient{}
+	}
+	return m.JobsFunc(executionId)
+}
+
+// SeedsClient mocks the discovery.SeedsClient interface.
+// If RecordsFunc or ExecutionsFunc are not set, the Records and Executions methods return empty mocks.
+type SeedsClient struct {
+	CRUD
+	Cloner
+	Searcher
+	StartFunc      func(id uuid.UUID, scan discovery.ScanType, executionProperties gjson.Result) (gjson.Result, error)
+	HaltFunc       func(id uuid.UUID) ([]gjson.Result, error)
+	ResetFunc      func(id uuid.UUID) (gjson.Result, error)
+	RecordsFunc    func(seedId uuid.UUID) discovery.SeedRecordsClient
+	ExecutionsFunc func(seedId uuid.UUID) discovery.SeedExecutionsClient
+}
+
+// Start calls StartFunc.
+func (m SeedsClient) Start(id uuid.UUID, scan discovery.ScanType, executionProperties gjson.Result) (gjson.Result, error) {
+	if m.StartFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.StartFunc(id, scan, executionProperties)
+}
+
+// StartContext calls Start. The context is ignored.
+func (m SeedsClient) StartContext(_ context.Context, id uuid.UUID, scan discovery.ScanType, executionProperties gjson.Result) (gjson.Result, error) {
+	return m.Start(id, scan, executionProperties)
+}
+
+// Halt calls HaltFunc.
+func (m SeedsClient) Halt(id uuid.UUID) ([]gjson.Result, error) {
+	if m.HaltFunc == nil {
+		return nil, ErrNotMocked
+	}
+	return m.HaltFunc(id)
+}
+
+// HaltContext calls Halt. The context is ignored.
+func (m SeedsClient) HaltContext(_ context.Context, id uuid.UUID) ([]gjson.Result, error) {
+	return m.Halt(id)
+}
+
+// Reset calls ResetFunc.
+func (m SeedsClient) Reset(id uuid.UUID) (gjson.Result, error) {
+	if m.ResetFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.ResetFunc(id)
+}
+
+// ResetContext calls Reset. The context is ignored.
+func (m SeedsClient) ResetContext(_ context.Context, id uuid.UUID) (gjson.Result, error) {
+	return m.Reset(id)
+}
+
+// Records calls RecordsFunc.
+func (m SeedsClient) Records(seedId uuid.UUID) discovery.SeedRecordsClient {
+	if m.RecordsFunc == nil {
+		return SeedRecordsClient{}
+	}
+	return m.RecordsFunc(seedId)
+}
+
+// Executions calls ExecutionsFunc.
+func (m SeedsClient) Executions(seedId uuid.UUID) discovery.SeedExecutionsClient {
+	if m.ExecutionsFunc == nil {
+		return SeedExecutionsClient{}
+	}
+	return m.ExecutionsFunc(seedId)
+}
+
+// SeedSchedulesClient mocks the discovery.SeedSchedulesClient interface.
+type SeedSchedulesClient struct {
+	CRUD
+	Cloner
+	Searcher
+	Enabler
+}
+
+// Ingestion mocks the discovery.Ingestion interface.
+// If a function is not set, the method returns an empty mock.
+type Ingestion struct {
+	ProcessorsFunc    func() discovery.IngestionProcessorsClient
+	PipelinesFunc     func() discovery.IngestionPipelinesClient
+	SeedsFunc         func() discovery.SeedsClient
+	SeedSchedulesFunc func() discovery.SeedSchedulesClient
+	BackupRestoreFunc func() discovery.BackupRestorer
+	StatusCheckerFunc func() discovery.StatusChecker
+}
+
+// Processors calls ProcessorsFunc.
+func (m Ingestion) Processors() discovery.IngestionProcessorsClient {
+	return orDefault[discovery.IngestionProcessorsClient](m.ProcessorsFunc, IngestionProcessorsClient{})
+}
+
+// Pipelines calls PipelinesFunc.
+func (m Ingestion) Pipelines() discovery.IngestionPipelinesClient {
+	return orDefault[discovery.IngestionPipelinesClient](m.PipelinesFunc, IngestionPipelinesClient{})
+}
+
+// Seeds calls SeedsFunc.
+func (m Ingestion) Seeds() discovery.SeedsClient {
+	return orDefault[discovery.SeedsClient](m.SeedsFunc, SeedsClient{})
+}
+
+// SeedSchedules calls SeedSchedulesFunc.
+func (m Ingestion) SeedSchedules() discovery.SeedSchedulesClient {
+	return orDefault[discovery.SeedSchedulesClient](m.SeedSchedulesFunc, SeedSchedulesClient{})
+}
+
+// BackupRestore calls BackupRestoreFunc.
+func (m Ingestion) BackupRestore() discovery.BackupRestorer {
+	return orDefault[discovery.BackupRestorer](m.BackupRestoreFunc, BackupRestorer{})
+}
+
+// StatusChecker calls StatusCheckerFunc.
+func (m Ingestion) StatusChecker() discovery.StatusChecker {
+	return orDefault[discovery.StatusChecker](m.StatusCheckerFunc, StatusChecker{})
+}
+
+// QueryFlowProcessorsClient mocks the discovery.QueryFlowProcessorsClient interface.
+type QueryFlowProcessorsClient struct {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// QueryFlowPipelinesClient mocks the discovery.QueryFlowPipelinesClient interface.
+type QueryFlowPipelinesClient struct {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// EndpointsClient mocks the discovery.EndpointsClient interface.
+type EndpointsClient struct {
+	CRUD
+	Cloner
+	Enabler
+	Searcher
+}
+
+// ToolsClient mocks the discovery.ToolsClient interface.
+type ToolsClient struct {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// MCPServersClient mocks the discovery.MCPServersClient interface.
+// If ToolsFunc is not set, the Tools method returns an empty mock.
+type MCPServersClient struct {
+	CRUD
+	Cloner
+	Enabler
+	Searcher
+	ToolsFunc func(serverId uuid.UUID) discovery.ToolsClient
+}
+
+// Tools calls ToolsFunc.
+func (m MCPServersClient) Tools(serverId uuid.UUID) discovery.ToolsClient {
+	if m.ToolsFunc == nil {
+		return ToolsClient{}
+	}
+	return m.ToolsFunc(serverId)
+}
+
+// QueryFlow mocks the discovery.QueryFlow interface.
+// If a function that returns a client is not set, the method returns an empty mock.
+type QueryFlow struct {
+	ProcessorsFunc    func() discovery.QueryFlowProcessorsClient
+	PipelinesFunc     func() discovery.QueryFlowPipelinesClient
+	EndpointsFunc     func() discovery.EndpointsClient
+	MCPServersFunc    func() discovery.MCPServersClient
+	BackupRestoreFunc func() discovery.BackupRestorer
+	InvokeFunc        func(method, uri string, options ...discovery.RequestOption) (gjson.Result, error)
+	DebugFunc         func(method, uri string, options ...discovery.RequestOption) (gjson.Result, error)
+	StatusCheckerFunc func() discovery.StatusChecker
+}
+
+// Processors calls ProcessorsFunc.
+func (m QueryFlow) Processors() discovery.QueryFlowProcessorsClient {
+	return orDefault[discovery.QueryFlowProcessorsClient](m.ProcessorsFunc, QueryFlowProcessorsClient{})
+}
+
+// Pipelines calls PipelinesFunc.
+func (m QueryFlow) Pipelines() discovery.QueryFlowPipelinesClient {
+	return orDefault[discovery.QueryFlowPipelinesClient](m.PipelinesFunc, QueryFlowPipelinesClient{})
+}
+
+// Endpoints calls EndpointsFunc.
+func (m QueryFlow) Endpoints() discovery.EndpointsClient {
+	return orDefault[discovery.EndpointsClient](m.EndpointsFunc, EndpointsClient{})
+}
+
+// MCPServers calls MCPServersFunc.
+func (m QueryFlow) MCPServers() discovery.MCPServersClient {
+	return orDefault[discovery.MCPServersClient](m.MCPServersFunc, MCPServersClient{})
+}
+
+// BackupRestore calls BackupRestoreFunc.
+func (m QueryFlow) BackupRestore() discovery.BackupRestorer {
+	return orDefault[discovery.BackupRestorer](m.BackupRestoreFunc, BackupRestorer{})
+}
+
+// Invoke calls InvokeFunc.
+func (m QueryFlow) Invoke(method, uri string, options ...discovery.RequestOption) (gjson.Result, error) {
+	if m.InvokeFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.InvokeFunc(method, uri, options...)
+}
+
+// InvokeContext calls Invoke. The context is ignored.
+func (m QueryFlow) InvokeContext(_ context.Context, method, uri string, options ...discovery.RequestOption) (gjson.Result, error) {
+	return m.Invoke(method, uri, options...)
+}
+
+// Debug calls DebugFunc.
+func (m QueryFlow) Debug(method, uri string, options ...discovery.RequestOption) (gjson.Result, error) {
+	if m.DebugFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.DebugFunc(method, uri, options...)
+}
+
+// DebugContext calls Debug. The context is ignored.
+func (m QueryFlow) DebugContext(_ context.Context, method, uri string, options ...discovery.RequestOption) (gjson.Result, error) {
+	return m.Debug(method, uri, options...)
+}
+
+// StatusChecker calls StatusCheckerFunc.
+func (m QueryFlow) StatusChecker() discovery.StatusChecker {
+	return orDefault[discovery.StatusChecker](m.StatusCheckerFunc, StatusChecker{})
+}
+
+// BucketsClient mocks the discovery.BucketsClient interface.
+type BucketsClient struct {
+	CRUD
+	Searcher
+	PurgeFunc       func(bucket uuid.UUID) (gjson.Result, error)
+	CreateIndexFunc func(id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error)
+	DeleteIndexFunc func(id uuid.UUID, index string) (gjson.Result, error)
+}
+
+// Purge calls PurgeFunc.
+func (m BucketsClient) Purge(bucket uuid.UUID) (gjson.Result, error) {
+	if m.PurgeFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.PurgeFunc(bucket)
+}
+
+// PurgeContext calls Purge. The context is ignored.
+func (m BucketsClient) PurgeContext(_ context.Context, bucket uuid.UUID) (gjson.Result, error) {
+	return m.Purge(bucket)
+}
+
+// CreateIndex calls CreateIndexFunc.
+func (m BucketsClient) CreateIndex(id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error) {
+	if m.CreateIndexFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.CreateIndexFunc(id, index, config)
+}
+
+// CreateIndexContext calls CreateIndex. The context is ignored.
+func (m BucketsClient) CreateIndexContext(_ context.Context, id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error) {
+	return m.CreateIndex(id, index, config)
+}
+
+// DeleteIndex calls DeleteIndexFunc.
+func (m BucketsClient) DeleteIndex(id uuid.UUID, index string) (gjson.Result, error) {
+	if m.DeleteIndexFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.DeleteIndexFunc(id, index)
+}
+
+// DeleteIndexContext calls DeleteIndex. The context is ignored.
+func (m BucketsClient) DeleteIndexContext(_ context.Context, id uuid.UUID, index string) (gjson.Result, error) {
+	return m.DeleteIndex(id, index)
+}
+
+// ContentClient mocks the discovery.ContentClient interface.
+type ContentClient struct {
+	StoreFunc      func(contentId, parentId string, content gjson.Result) (gjson.Result, error)
+	GetFunc        func(contentId string, options ...discovery.ContentGetOption) (gjson.Result, error)
+	ScrollFunc     func(filters, projections gjson.Result, size *int) ([]gjson.Result, error)
+	ScrollSeqFunc  func(filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error]
+	DeleteFunc     func(contentId string) (gjson.Result, error)
+	DeleteManyFunc func(parentId string, filter gjson.Result) (gjson.Result, error)
+}
+
+// Store calls StoreFunc.
+func (m ContentClient) Store(contentId, parentId string, content gjson.Result) (gjson.Result, error) {
+	if m.StoreFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.StoreFunc(contentId, parentId, content)
+}
+
+// StoreContext calls Store. The context is ignored.
+func (m ContentClient) StoreContext(_ context.Context, contentId, parentId string, content gjson.Result) (gjson.Result, error) {
+	return m.Store(contentId, parentId, content)
+}
+
+// Get calls GetFunc.
+func (m ContentClient) Get(contentId string, options ...discovery.ContentGetOption) (gjson.Result, error) {
+	if m.GetFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.GetFunc(contentId, options...)
+}
+
+// GetContext calls Get. The context is ignored.
+func (m ContentClient) GetContext(_ context.Context, contentId string, options ...discovery.ContentGetOption) (gjson.Result, error) {
+	return m.Get(contentId, options...)
+}
+
+// Scroll calls ScrollFunc.
+func (m ContentClient) Scroll(filters, projections gjson.Result, size *int) ([]gjson.Result, error) {
+	if m.ScrollFunc == nil {
+		return nil, ErrNotMocked
+	}
+	return m.ScrollFunc(filters, projections, size)
+}
+
+// ScrollContext calls Scroll. The context is ignored.
+func (m ContentClient) ScrollContext(_ context.Context, filters, projections gjson.Result, size *int) ([]gjson.Result, error) {
+	return m.Scroll(filters, projections, size)
+}
+
+// ScrollSeq calls ScrollSeqFunc.
+// If it is not set, the sequence iterates through the results of Scroll.
+func (m ContentClient) ScrollSeq(filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error] {
+	if m.ScrollSeqFunc != nil {
+		return m.ScrollSeqFunc(filters, projections, size)
+	}
+	return sliceSeq(func() ([]gjson.Result, error) { return m.Scroll(filters, projections, size) })
+}
+
+// ScrollSeqContext calls ScrollSeq. The context is ignored.
+func (m ContentClient) ScrollSeqContext(_ context.Context, filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error] {
+	return m.ScrollSeq(filters, projections, size)
+}
+
+// Delete calls DeleteFunc.
+func (m ContentClient) Delete(contentId string) (gjson.Result, error) {
+	if m.DeleteFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.DeleteFunc(contentId)
+}
+
+// DeleteContext calls Delete. The context is ignored.
+func (m ContentClient) DeleteContext(_ context.Context, contentId string) (gjson.Result, error) {
+	return m.Delete(contentId)
+}
+
+// DeleteMany calls DeleteManyFunc.
+func (m ContentClient) DeleteMany(parentId string, filter gjson.Result) (gjson.Result, error) {
+	if m.DeleteManyFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.DeleteManyFunc(parentId, filter)
+}
+
+// DeleteManyContext calls DeleteMany. The context is ignored.
+func (m ContentClient) DeleteManyContext(_ context.Context, parentId string, filter gjson.Result) (gjson.Result, error) {
+	return m.DeleteMany(parentId, filter)
+}
+
+// Staging mocks the discovery.Staging interface.
+// If a function is not set, the method returns an empty mock.
+type Staging struct {
+	BucketsFunc       func() discovery.BucketsClient
+	ContentFunc       func(bucket string) discovery.ContentClient
+	StatusCheckerFunc func() discovery.StatusChecker
+}
+
+// Buckets calls BucketsFunc.
+func (m Staging) Buckets() discovery.BucketsClient {
+	return orDefault[discovery.BucketsClient](m.BucketsFunc, BucketsClient{})
+}
+
+// Content calls ContentFunc.
+func (m Staging) Content(bucket string) discovery.ContentClient {
+	if m.ContentFunc == nil {
+		return ContentClient{}
+	}
+	return m.ContentFunc(bucket)
+}
+
+// StatusChecker calls StatusCheckerFunc.
+func (m Staging) StatusChecker() discovery.StatusChecker {
+	return orDefault[discovery.StatusChecker](m.StatusCheckerFunc, StatusChecker{})
+}
@@ -0,0 +1,301 @@
+// Package discoverytest provides mocks of the interfaces of the discovery package.
+// Every mock has a function field per method, which is called when the method is invoked.
+// If the field is nil, the method fails with ErrNotMocked, so that tests only have to set the functions they use.
+// The methods that receive a context, like GetContext(), call the same function as the methods that do not, like Get().
+package discoverytest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/google/uuid"
+	"github.com/pureinsights/discovery-cli/discovery"
+	"github.com/tidwall/gjson"
+)
+
+// ErrNotMocked is returned by the methods whose function is not set in the mock.
+var ErrNotMocked = errors.New("discoverytest: the method is not mocked")
+
+// failedSeq returns a sequence that yields ErrNotMocked.
+func failedSeq() iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		yield(gjson.Result{}, ErrNotMocked)
+	}
+}
+
+// Getter mocks the discovery.Getter interface.
+type Getter struct {
+	GetFunc       func(id uuid.UUID) (gjson.Result, error)
+	GetAllFunc    func(options ...discovery.RequestOption) ([]gjson.Result, error)
+	GetAllSeqFunc func(options ...discovery.RequestOption) iter.Seq2[gjson.Result, error]
+}
+
+// Get calls GetFunc.
+func (m Getter) Get(id uuid.UUID) (gjson.Result, error) {
+	if m.GetFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.GetFunc(id)
+}
+
+// GetContext calls Get. The context is ignored.
+func (m Getter) GetContext(_ context.Context, id uuid.UUID) (gjson.Result, error) {
+	return m.Get(id)
+}
+
+// GetAll calls GetAllFunc.
+func (m Getter) GetAll(options ...discovery.RequestOption) ([]gjson.Result, error) {
+	if m.GetAllFunc == nil {
+		return nil, ErrNotMocked
+	}
+	return m.GetAllFunc(options...)
+}
+
+// GetAllContext calls GetAll. The context is ignored.
+func (m Getter) GetAllContext(_ context.Context, options ...discovery.RequestOption) ([]gjson.Result, error) {
+	return m.GetAll(options...)
+}
+
+// GetAllSeq calls GetAllSeqFunc.
+// If it is not set, but GetAllFunc is, the sequence iterates through the results of GetAllFunc.
+func (m Getter) GetAllSeq(options ...discovery.RequestOption) iter.Seq2[gjson.Result, error] {
+	if m.GetAllSeqFunc != nil {
+		return m.GetAllSeqFunc(options...)
+	}
+	return sliceSeq(func() ([]gjson.Result, error) { return m.GetAll(options...) })
+}
+
+// GetAllSeqContext calls GetAllSeq. The context is ignored.
+func (m Getter) GetAllSeqContext(_ context.Context, options ...discovery.RequestOption) iter.Seq2[gjson.Result, error] {
+	return m.GetAllSeq(options...)
+}
+
+// sliceSeq returns a sequence that iterates through the results of the function, or yields its error.
+func sliceSeq(f func() ([]gjson.Result, error)) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		results, err := f()
+		if err != nil {
+			yield(gjson.Result{}, err)
+			return
+		}
+		for _, result := range results {
+			if !yield(result, nil) {
+				return
+			}
+		}
+	}
+}
+
+// CRUD mocks the discovery.CRUD interface.
+type CRUD struct {
+	Getter
+	CreateFunc func(config gjson.Result) (gjson.Result, error)
+	UpdateFunc func(id uuid.UUID, config gjson.Result) (gjson.Result, error)
+	DeleteFunc func(id uuid.UUID) (gjson.Result, error)
+}
+
+// Create calls CreateFunc.
+func (m CRUD) Create(config gjson.Result) (gjson.Result, error) {
+	if m.CreateFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.CreateFunc(config)
+}
+
+// CreateContext calls Create. The context is ignored.
+func (m CRUD) CreateContext(_ context.Context, config gjson.Result) (gjson.Result, error) {
+	return m.Create(config)
+}
+
+// Update calls UpdateFunc.
+func (m CRUD) Update(id uuid.UUID, config gjson.Result) (gjson.Result, error) {
+	if m.UpdateFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.UpdateFunc(id, config)
+}
+
+// UpdateContext calls Update. The context is ignored.
+func (m CRUD) UpdateContext(_ context.Context, id uuid.UUID, config gjson.Result) (gjson.Result, error) {
+	return m.Update(id, config)
+}
+
+// Delete calls DeleteFunc.
+func (m CRUD) Delete(id uuid.UUID) (gjson.Result, error) {
+	if m.DeleteFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.DeleteFunc(id)
+}
+
+// DeleteContext calls Delete. The context is ignored.
+func (m CRUD) DeleteContext(_ context.Context, id uuid.UUID) (gjson.Result, error) {
+	return m.Delete(id)
+}
+
+// Cloner mocks the discovery.Cloner interface.
+type Cloner struct {
+	CloneFunc func(id uuid.UUID, params map[string][]string) (gjson.Result, error)
+}
+
+// Clone calls CloneFunc.
+func (m Cloner) Clone(id uuid.UUID, params map[string][]string) (gjson.Result, error) {
+	if m.CloneFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.CloneFunc(id, params)
+}
+
+// CloneContext calls Clone. The context is ignored.
+func (m Cloner) CloneContext(_ context.Context, id uuid.UUID, params map[string][]string) (gjson.Result, error) {
+	return m.Clone(id, params)
+}
+
+// Searcher mocks the discovery.Searcher interface.
+type Searcher struct {
+	SearchFunc       func(filter gjson.Result, options ...discovery.RequestOption) ([]gjson.Result, error)
+	SearchSeqFunc    func(filter gjson.Result, options ...discovery.RequestOption) iter.Seq2[gjson.Result, error]
+	SearchByNameFunc func(name string) (gjson.Result, error)
+}
+
+// Search calls SearchFunc.
+func (m Searcher) Search(filter gjson.Result, options ...discovery.RequestOption) ([]gjson.Result, error) {
+	if m.SearchFunc == nil {
+		return nil, ErrNotMocked
+	}
+	return m.SearchFunc(filter, options...)
+}
+
+// SearchContext calls Search. The context is ignored.
+func (m Searcher) SearchContext(_ context.Context, filter gjson.Result, options ...discovery.RequestOption) ([]gjson.Result, error) {
+	return m.Search(filter, options...)
+}
+
+// SearchSeq calls SearchSeqFunc.
+// If it is not set, but SearchFunc is, the sequence iterates through the results of SearchFunc.
+func (m Searcher) SearchSeq(filter gjson.Result, options ...discovery.RequestOption) iter.Seq2[gjson.Result, error] {
+	if m.SearchSeqFunc != nil {
+		return m.SearchSeqFunc(filter, options...)
+	}
+	return sliceSeq(func() ([]gjson.Result, error) { return m.Search(filter, options...) })
+}
+
+// SearchSeqContext calls SearchSeq. The context is ignored.
+func (m Searcher) SearchSeqContext(_ context.Context, filter gjson.Result, options ...discovery.RequestOption) iter.Seq2[gjson.Result, error] {
+	return m.SearchSeq(filter, options...)
+}
+
+// SearchByName calls SearchByNameFunc.
+func (m Searcher) SearchByName(name string) (gjson.Result, error) {
+	if m.SearchByNameFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.SearchByNameFunc(name)
+}
+
+// SearchByNameContext calls SearchByName. The context is ignored.
+func (m Searcher) SearchByNameContext(_ context.Context, name string) (gjson.Result, error) {
+	return m.SearchByName(name)
+}
+
+// Enabler mocks the discovery.Enabler interface.
+type Enabler struct {
+	EnableFunc  func(id uuid.UUID) (gjson.Result, error)
+	DisableFunc func(id uuid.UUID) (gjson.Result, error)
+}
+
+// Enable calls EnableFunc.
+func (m Enabler) Enable(id uuid.UUID) (gjson.Result, error) {
+	if m.EnableFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.EnableFunc(id)
+}
+
+// EnableContext calls Enable. The context is ignored.
+func (m Enabler) EnableContext(_ context.Context, id uuid.UUID) (gjson.Result, error) {
+	return m.Enable(id)
+}
+
+// Disable calls DisableFunc.
+func (m Enabler) Disable(id uuid.UUID) (gjson.Result, error) {
+	if m.DisableFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.DisableFunc(id)
+}
+
+// DisableContext calls Disable. The context is ignored.
+func (m Enabler) DisableContext(_ context.Context, id uuid.UUID) (gjson.Result, error) {
+	return m.Disable(id)
+}
+
+// Summarizer mocks the discovery.Summarizer interface.
+type Summarizer struct {
+	SummarizeFunc func() (gjson.Result, error)
+}
+
+// Summarize calls SummarizeFunc.
+func (m Summarizer) Summarize() (gjson.Result, error) {
+	if m.SummarizeFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.SummarizeFunc()
+}
+
+// SummarizeContext calls Summarize. The context is ignored.
+func (m Summarizer) SummarizeContext(_ context.Context) (gjson.Result, error) {
+	return m.Summarize()
+}
+
+// BackupRestorer mocks the discovery.BackupRestorer interface.
+type BackupRestorer struct {
+	ExportFunc func(w io.Writer) (string, error)
+	ImportFunc func(onConflict discovery.OnConflict, file string) (gjson.Result, error)
+}
+
+// Export calls ExportFunc.
+func (m BackupRestorer) Export(w io.Writer) (string, error) {
+	if m.ExportFunc == nil {
+		return "", ErrNotMocked
+	}
+	return m.ExportFunc(w)
+}
+
+// ExportContext calls Export. The context is ignored.
+func (m BackupRestorer) ExportContext(_ context.Context, w io.Writer) (string, error) {
+	return m.Export(w)
+}
+
+// Import calls ImportFunc.
+func (m BackupRestorer) Import(onConflict discovery.OnConflict, file string) (gjson.Result, error) {
+	if m.ImportFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.ImportFunc(onConflict, file)
+}
+
+// ImportContext calls Import. The context is ignored.
+func (m BackupRestorer) ImportContext(_ context.Context, onConflict discovery.OnConflict, file string) (gjson.Result, error) {
+	return m.Import(onConflict, file)
+}
+
+// StatusChecker mocks the discovery.StatusChecker interface.
+type StatusChecker struct {
+	StatusCheckFunc func() (gjson.Result, error)
+}
+
+// StatusCheck calls StatusCheckFunc.
+func (m StatusChecker) StatusCheck() (gjson.Result, error) {
+	if m.StatusCheckFunc == nil {
+		return gjson.Result{}, ErrNotMocked
+	}
+	return m.StatusCheckFunc()
+}
+
+// StatusCheckContext calls StatusCheck. The context is ignored.
+func (m StatusChecker) StatusCheckContext(_ context.Context) (gjson.Result, error) {
+	return m.StatusCheck()
+}
@@ -0,0 +1,69 @@
+package discoverytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pureinsights/discovery-cli/discovery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestMocks_CallFunctions tests that the mocks call the functions that are set.
+func TestMocks_CallFunctions(t *testing.T) {
+	id := uuid.MustParse("3d51beef-8b90-40aa-84b5-033241dc6239")
+	var core discovery.Core = Core{
+		ServersFunc: func() discovery.ServersClient {
+			return ServersClient{
+				CRUD: CRUD{Getter: Getter{GetFunc: func(got uuid.UUID) (gjson.Result, error) {
+					assert.Equal(t, id, got)
+					return gjson.Parse(`{"name":"my-server"}`), nil
+				}}},
+				PingFunc: func(uuid.UUID) (gjson.Result, error) {
+					return gjson.Parse(`{"acknowledged":true}`), nil
+				},
+			}
+		},
+	}
+
+	server, err := core.Servers().Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, "my-server", server.Get("name").String())
+
+	ping, err := core.Servers().Ping(id)
+	require.NoError(t, err)
+	assert.True(t, ping.Get("acknowledged").Bool())
+
+	server, err = core.Servers().GetContext(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, "my-server", server.Get("name").String())
+}
+
+// TestMocks_NotMocked tests that the methods whose functions are not set fail with ErrNotMocked.
+func TestMocks_NotMocked(t *testing.T) {
+	var ingestion discovery.Ingestion = Ingestion{}
+
+	_, err := ingestion.Seeds().Get(uuid.Nil)
+	assert.ErrorIs(t, err, ErrNotMocked)
+
+	_, err = ingestion.Seeds().Executions(uuid.Nil).Records(uuid.Nil).Summarize()
+	assert.ErrorIs(t, err, ErrNotMocked)
+
+	_, err = discovery.Collect(ingestion.Pipelines().SearchSeq(gjson.Result{}))
+	assert.ErrorIs(t, err, ErrNotMocked)
+}
+
+// TestMocks_SeqFromSlice tests that the sequences iterate through the results of the slice functions when they are not set.
+func TestMocks_SeqFromSlice(t *testing.T) {
+	labels := LabelsClient{CRUD: CRUD{Getter: Getter{GetAllFunc: func(...discovery.RequestOption) ([]gjson.Result, error) {
+		return gjson.Parse(`[{"key":"A"},{"key":"B"}]`).Array(), nil
+	}}}}
+
+	results, err := discovery.Collect(labels.GetAllSeq())
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "A", results[0].Get("key").String())
+	assert.Equal(t, "B", results[1].Get("key").String())
+}
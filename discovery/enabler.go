@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -14,10 +15,20 @@ type enabler struct {
 
 // Enable runs a request to enable the entity with the given ID. The client's URL needs to already have the path that points to the correct type of entity.
 func (e enabler) Enable(id uuid.UUID) (gjson.Result, error) {
-	return execute(e.client, http.MethodPatch, "/"+id.String()+"/enable")
+	return e.EnableContext(e.client.requestContext(), id)
+}
+
+// EnableContext is like Enable, but it uses the given context for its requests instead of the one set with WithContext().
+func (e enabler) EnableContext(ctx context.Context, id uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, e.client, http.MethodPatch, "/"+id.String()+"/enable")
 }
 
 // Disable runs a request to disable the entity with the given ID. The client's URL needs to already have the path that points to the correct type of entity.
 func (e enabler) Disable(id uuid.UUID) (gjson.Result, error) {
-	return execute(e.client, http.MethodPatch, "/"+id.String()+"/disable")
+	return e.DisableContext(e.client.requestContext(), id)
+}
+
+// DisableContext is like Disable, but it uses the given context for its requests instead of the one set with WithContext().
+func (e enabler) DisableContext(ctx context.Context, id uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, e.client, http.MethodPatch, "/"+id.String()+"/disable")
 }
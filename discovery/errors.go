@@ -1,18 +1,121 @@
 package discovery
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/tidwall/gjson"
 )
 
 // Error represents an error response with an HTTP status and a JSON body.
+// If the body is a standard Discovery error payload, its code, messages and timestamp are also parsed.
 type Error struct {
 	Status int
 	Body   gjson.Result
+	// Code is the Discovery error code of the payload, like 1003 when an entity is not found.
+	Code int
+	// Messages are the descriptions of the error in the payload.
+	Messages []string
+	// Timestamp is the moment in which the error occurred, according to the payload.
+	Timestamp time.Time
+}
+
+// The following errors can be used with errors.Is() to check the status of a Discovery error.
+var (
+	// ErrBadRequest matches the errors with a 400 Bad Request status.
+	ErrBadRequest = errors.New("bad request")
+	// ErrUnauthorized matches the errors with a 401 Unauthorized status.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches the errors with a 403 Forbidden status.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound matches the errors with a 404 Not Found status.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches the errors with a 409 Conflict status.
+	ErrConflict = errors.New("conflict")
+)
+
+// NewError creates an Error with the given status and body.
+// The code, messages and timestamp are obtained from the status, code, messages and timestamp fields of the body, if it has them.
+func NewError(status int, body gjson.Result) Error {
+	e := Error{
+		Status: status,
+		Body:   body,
+		Code:   int(body.Get("code").Int()),
+	}
+
+	for _, message := range body.Get("messages").Array() {
+		e.Messages = append(e.Messages, message.String())
+	}
+
+	if timestamp, err := time.Parse(time.RFC3339Nano, body.Get("timestamp").String()); err == nil {
+		e.Timestamp = timestamp
+	}
+
+	return e
 }
 
 // Error implements the error interface.
 func (e Error) Error() string {
 	return fmt.Sprintf("status: %d, body: %s\n", e.Status, e.Body.String())
 }
+
+// Is allows errors.Is() to compare the error with the sentinel errors, like ErrNotFound, which match its status.
+// It also matches another Error with the same status and, if the target has one, the same code.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.Status == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.Status == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.Status == http.StatusForbidden
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrConflict:
+		return e.Status == http.StatusConflict
+	}
+
+	if t, ok := target.(Error); ok {
+		return e.Status == t.Status && (t.Code == 0 || e.Code == t.Code)
+	}
+
+	return false
+}
+
+// AmbiguousNameError is returned when several entities have the name that was searched, so it cannot be resolved to a single entity.
+// The candidates are the entities that have the name, which can be told apart by their id or by the format of the qualified names, like {type}/{name}.
+// The search of Discovery's API stops after the second candidate, so the candidates may not include every entity with the name.
+type AmbiguousNameError struct {
+	Name       string
+	Candidates []gjson.Result
+	// Format is the format of the names that are qualified to tell apart the entities. It is empty if the names cannot be qualified.
+	Format string
+}
+
+// Error implements the error interface.
+func (e AmbiguousNameError) Error() string {
+	return fmt.Sprintf("the name %q matches several entities", e.Name)
+}
+
+// IsNotFound checks if the error, or any error it wraps, is a Discovery error with a 404 Not Found status.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict checks if the error, or any error it wraps, is a Discovery error with a 409 Conflict status.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsUnauthorized checks if the error, or any error it wraps, is a Discovery error with a 401 Unauthorized or a 403 Forbidden status.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden)
+}
+
+// IsBadRequest checks if the error, or any error it wraps, is a Discovery error with a 400 Bad Request or a 422 Unprocessable Entity status.
+func IsBadRequest(err error) bool {
+	var e Error
+	return errors.As(err, &e) && (e.Status == http.StatusBadRequest || e.Status == http.StatusUnprocessableEntity)
+}
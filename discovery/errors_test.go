@@ -1,10 +1,14 @@
 package discovery
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 )
 
@@ -41,3 +45,128 @@ func TestError_Error_StringBody(t *testing.T) {
 		})
 	}
 }
+
+// TestNewError tests that the NewError() function parses the standard Discovery error payload.
+func TestNewError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		json     string
+		expected Error
+	}{
+		{
+			name:   "Standard error payload",
+			status: http.StatusNotFound,
+			json: `{
+				"status": 404,
+				"code": 1003,
+				"messages": ["Seed not found: 3b32e410-2f33-412d-9fb8-17970131921c"],
+				"timestamp": "2025-11-17T19:32:01.555127800Z"
+			}`,
+			expected: Error{
+				Status:    http.StatusNotFound,
+				Code:      1003,
+				Messages:  []string{"Seed not found: 3b32e410-2f33-412d-9fb8-17970131921c"},
+				Timestamp: time.Date(2025, time.November, 17, 19, 32, 1, 555127800, time.UTC),
+			},
+		},
+		{
+			name:   "Payload with several messages and no timestamp",
+			status: http.StatusBadRequest,
+			json:   `{"status":400,"code":1001,"messages":["name: must not be blank","type: must not be null"]}`,
+			expected: Error{
+				Status:   http.StatusBadRequest,
+				Code:     1001,
+				Messages: []string{"name: must not be blank", "type: must not be null"},
+			},
+		},
+		{
+			name:   "Body that is not a Discovery error payload",
+			status: http.StatusBadGateway,
+			json:   `"Bad Gateway"`,
+			expected: Error{
+				Status: http.StatusBadGateway,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body := gjson.Parse(tc.json)
+			e := NewError(tc.status, body)
+
+			assert.Equal(t, tc.expected.Status, e.Status)
+			assert.Equal(t, body, e.Body)
+			assert.Equal(t, tc.expected.Code, e.Code)
+			assert.Equal(t, tc.expected.Messages, e.Messages)
+			assert.True(t, tc.expected.Timestamp.Equal(e.Timestamp))
+			assert.EqualError(t, e, fmt.Sprintf("status: %d, body: %s\n", tc.status, body.String()))
+		})
+	}
+}
+
+// TestError_Is tests that errors.Is() matches the Discovery errors with the sentinel errors and other Discovery errors.
+func TestError_Is(t *testing.T) {
+	notFound := NewError(http.StatusNotFound, gjson.Parse(`{"status":404,"code":1003,"messages":["Entity not found"]}`))
+	wrapped := fmt.Errorf("could not get the seed: %w", notFound)
+
+	tests := []struct {
+		name     string
+		err      error
+		target   error
+		expected bool
+	}{
+		{name: "Not found sentinel", err: notFound, target: ErrNotFound, expected: true},
+		{name: "Wrapped error", err: wrapped, target: ErrNotFound, expected: true},
+		{name: "Different sentinel", err: notFound, target: ErrConflict, expected: false},
+		{name: "Same status without code", err: notFound, target: Error{Status: http.StatusNotFound}, expected: true},
+		{name: "Same status and code", err: wrapped, target: Error{Status: http.StatusNotFound, Code: 1003}, expected: true},
+		{name: "Same status and different code", err: notFound, target: Error{Status: http.StatusNotFound, Code: 1001}, expected: false},
+		{name: "Different status", err: notFound, target: Error{Status: http.StatusConflict}, expected: false},
+		{name: "Unrelated error", err: notFound, target: errors.New("not found"), expected: false},
+		{name: "Unauthorized sentinel", err: Error{Status: http.StatusUnauthorized}, target: ErrUnauthorized, expected: true},
+		{name: "Forbidden sentinel", err: Error{Status: http.StatusForbidden}, target: ErrForbidden, expected: true},
+		{name: "Bad request sentinel", err: Error{Status: http.StatusBadRequest}, target: ErrBadRequest, expected: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, errors.Is(tc.err, tc.target))
+		})
+	}
+
+	var e Error
+	require.ErrorAs(t, wrapped, &e)
+	assert.Equal(t, 1003, e.Code)
+}
+
+// TestErrorPredicates tests the IsNotFound(), IsConflict(), IsUnauthorized() and IsBadRequest() functions.
+func TestErrorPredicates(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		conflict     bool
+		unauthorized bool
+		badRequest   bool
+	}{
+		{name: "Not found", err: Error{Status: http.StatusNotFound}, notFound: true},
+		{name: "Conflict", err: fmt.Errorf("wrapped: %w", Error{Status: http.StatusConflict}), conflict: true},
+		{name: "Unauthorized", err: Error{Status: http.StatusUnauthorized}, unauthorized: true},
+		{name: "Forbidden", err: Error{Status: http.StatusForbidden}, unauthorized: true},
+		{name: "Bad request", err: Error{Status: http.StatusBadRequest}, badRequest: true},
+		{name: "Unprocessable entity", err: Error{Status: http.StatusUnprocessableEntity}, badRequest: true},
+		{name: "Internal server error", err: Error{Status: http.StatusInternalServerError}},
+		{name: "Other error", err: errors.New("connection refused")},
+		{name: "No error", err: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.notFound, IsNotFound(tc.err))
+			assert.Equal(t, tc.conflict, IsConflict(tc.err))
+			assert.Equal(t, tc.unauthorized, IsUnauthorized(tc.err))
+			assert.Equal(t, tc.badRequest, IsBadRequest(tc.err))
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package discovery
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// The following functions build the filters of Discovery's DSL.
+// The filters are JSON objects, so they can be given to every method that receives a filter, like Search(), SearchSeq(), Scroll() and DeleteMany().
+// The fields and values are encoded as JSON, so their quotes and special characters are escaped.
+// For example, And(Equals("type", "mongo"), Not(Exists("labels"))) matches the MongoDB entities that do not have labels.
+
+// filterProperty is a property of a filter, like the value of an equals filter or a bound of a range filter.
+type filterProperty struct {
+	name  string
+	value any
+}
+
+// setFilterValue sets the value in the given path of the filter.
+// The gjson.Result values are set as they are, and the rest are encoded as JSON.
+// It panics if the value cannot be encoded, like a channel or a function, as that is a mistake of the caller.
+func setFilterValue(filter, path string, value any) string {
+	var err error
+	if result, ok := value.(gjson.Result); ok {
+		filter, err = sjson.SetRaw(filter, path, result.Raw)
+	} else {
+		filter, err = sjson.Set(filter, path, value)
+	}
+
+	if err != nil {
+		panic(fmt.Sprintf("discovery: could not set the %s of the filter: %v", path, err))
+	}
+	return filter
+}
+
+// fieldFilter builds a filter with the given operator that applies to a field, like {"equals":{"field":"type","value":"mongo"}}.
+func fieldFilter(operator, field string, properties ...filterProperty) gjson.Result {
+	filter := setFilterValue("{}", operator+".field", field)
+	for _, property := range properties {
+		filter = setFilterValue(filter, operator+"."+property.name, property.value)
+	}
+	return gjson.Parse(filter)
+}
+
+// Equals returns a filter that matches the entities whose field has the given value.
+func Equals(field string, value any) gjson.Result {
+	return fieldFilter("equals", field, filterProperty{"value", value})
+}
+
+// In returns a filter that matches the entities whose field has any of the given values.
+func In(field string, values ...any) gjson.Result {
+	if values == nil {
+		values = []any{}
+	}
+	return fieldFilter("in", field, filterProperty{"values", values})
+}
+
+// Exists returns a filter that matches the entities that have the given field.
+func Exists(field string) gjson.Result {
+	return fieldFilter("exists", field)
+}
+
+// Empty returns a filter that matches the entities whose field is empty, like an empty string or array.
+func Empty(field string) gjson.Result {
+	return fieldFilter("empty", field)
+}
+
+// Wildcard returns a filter that matches the entities whose field matches the given pattern.
+// In the pattern, * matches any sequence of characters and ? matches a single character. For example, Wildcard("name", "my-*") matches the names that start with "my-".
+func Wildcard(field, pattern string) gjson.Result {
+	return fieldFilter("wildcard", field, filterProperty{"value", pattern})
+}
+
+// RangeBound is a bound of a range filter. It is created with the Gt(), Gte(), Lt() and Lte() functions.
+type RangeBound struct {
+	operator string
+	value    any
+}
+
+// Gt returns the bound of a range filter that matches the values greater than the given one.
+func Gt(value any) RangeBound {
+	return RangeBound{operator: "gt", value: value}
+}
+
+// Gte returns the bound of a range filter that matches the values greater than or equal to the given one.
+func Gte(value any) RangeBound {
+	return RangeBound{operator: "gte", value: value}
+}
+
+// Lt returns the bound of a range filter that matches the values lower than the given one.
+func Lt(value any) RangeBound {
+	return RangeBound{operator: "lt", value: value}
+}
+
+// Lte returns the bound of a range filter that matches the values lower than or equal to the given one.
+func Lte(value any) RangeBound {
+	return RangeBound{operator: "lte", value: value}
+}
+
+// Range returns a filter that matches the entities whose field is within the given bounds.
+// For example, Range("batchSize", Gte(10), Lt(100)).
+func Range(field string, bounds ...RangeBound) gjson.Result {
+	properties := make([]filterProperty, 0, len(bounds))
+	for _, bound := range bounds {
+		properties = append(properties, filterProperty{bound.operator, bound.value})
+	}
+	return fieldFilter("range", field, properties...)
+}
+
+// isEmptyFilter checks if the filter does not exist or is an empty object, which matches every entity.
+func isEmptyFilter(filter gjson.Result) bool {
+	return !filter.Exists() || (filter.IsObject() && len(filter.Map()) == 0)
+}
+
+// combineFilters joins the filters with the given logical operator.
+// The empty filters are ignored. If only one filter is left, it is returned as it is, and if there are none, an empty filter is returned.
+func combineFilters(operator string, filters []gjson.Result) gjson.Result {
+	raws := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		if !isEmptyFilter(filter) {
+			raws = append(raws, filter.Raw)
+		}
+	}
+
+	switch len(raws) {
+	case 0:
+		return gjson.Parse("{}")
+	case 1:
+		return gjson.Parse(raws[0])
+	}
+
+	filter, _ := sjson.SetRaw("{}", operator, "["+strings.Join(raws, ",")+"]")
+	return gjson.Parse(filter)
+}
+
+// And returns a filter that matches the entities that match every given filter.
+// The empty filters are ignored, so a single filter is returned as it is and no filters return an empty filter, which matches every entity.
+func And(filters ...gjson.Result) gjson.Result {
+	return combineFilters("and", filters)
+}
+
+// Or returns a filter that matches the entities that match any of the given filters.
+// The empty filters are ignored, so a single filter is returned as it is and no filters return an empty filter, which matches every entity.
+func Or(filters ...gjson.Result) gjson.Result {
+	return combineFilters("or", filters)
+}
+
+// Not returns a filter that matches the entities that do not match the given filter.
+func Not(filter gjson.Result) gjson.Result {
+	raw := filter.Raw
+	if !filter.Exists() {
+		raw = "{}"
+	}
+	negated, _ := sjson.SetRaw("{}", "not", raw)
+	return gjson.Parse(negated)
+}
+
+// matchesFilter evaluates the filter on the entity, so that the clients whose endpoint cannot search the entities can filter them.
+// The fields of the filters are paths separated by dots. If a part of the path is an array, like the labels, the field matches if any of its elements does.
+// It returns an error if the filter has an operator that is not supported.
+func matchesFilter(filter, entity gjson.Result) (bool, error) {
+	if isEmptyFilter(filter) {
+		return true, nil
+	}
+
+	matches := true
+	var err error
+	filter.ForEach(func(operator, arguments gjson.Result) bool {
+		switch operator.String() {
+		case "and", "or":
+			matches = operator.String() == "and"
+			for _, child := range arguments.Array() {
+				var childMatches bool
+				childMatches, err = matchesFilter(child, entity)
+				if err != nil || childMatches != matches {
+					matches = childMatches
+					break
+				}
+			}
+		case "not":
+			matches, err = matchesFilter(arguments, entity)
+			matches = !matches
+		default:
+			matches, err = matchesField(operator.String(), arguments, fieldValues(entity, arguments.Get("field").String()))
+		}
+		return err == nil && matches
+	})
+
+	return matches && err == nil, err
+}
+
+// matchesField evaluates the filter with the given operator on the values of a field.
+func matchesField(operator string, arguments gjson.Result, values []gjson.Result) (bool, error) {
+	switch operator {
+	case "exists":
+		return len(values) > 0, nil
+	case "empty":
+		for _, value := range values {
+			if value.String() != "" && !(value.IsArray() && len(value.Array()) == 0) && !(value.IsObject() && len(value.Map()) == 0) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	for _, value := range values {
+		switch operator {
+		case "equals":
+			if equalValues(value, arguments.Get("value")) {
+				return true, nil
+			}
+		case "in":
+			for _, expected := range arguments.Get("values").Array() {
+				if equalValues(value, expected) {
+					return true, nil
+				}
+			}
+		case "wildcard":
+			if matchesWildcard(arguments.Get("value").String(), value.String()) {
+				return true, nil
+			}
+		case "range":
+			if inRange(value, arguments) {
+				return true, nil
+			}
+		default:
+			return false, fmt.Errorf("the filter operator %q is not supported", operator)
+		}
+	}
+	return false, nil
+}
+
+// fieldValues returns the values of the field of the entity.
+// The arrays in the path are flattened, so labels.key returns the key of every label. The null values are ignored.
+func fieldValues(entity gjson.Result, field string) []gjson.Result {
+	values := []gjson.Result{entity}
+	for _, part := range strings.Split(field, ".") {
+		next := []gjson.Result{}
+		for _, value := range values {
+			elements := []gjson.Result{value}
+			if value.IsArray() {
+				elements = value.Array()
+			}
+			for _, element := range elements {
+				if child := element.Get(gjson.Escape(part)); child.Exists() && child.Type != gjson.Null {
+					next = append(next, child)
+				}
+			}
+		}
+		values = next
+	}
+
+	flattened := []gjson.Result{}
+	for _, value := range values {
+		if value.IsArray() {
+			flattened = append(flattened, value.Array()...)
+		} else {
+			flattened = append(flattened, value)
+		}
+	}
+	return flattened
+}
+
+// equalValues checks if the values are equal. The numbers are compared by their value and the rest by their JSON representation.
+func equalValues(a, b gjson.Result) bool {
+	if a.Type == gjson.Number && b.Type == gjson.Number {
+		return a.Num == b.Num
+	}
+	if a.Type == gjson.String && b.Type == gjson.String {
+		return a.Str == b.Str
+	}
+	return a.Type == b.Type && a.Raw == b.Raw
+}
+
+// compareValues compares the values as numbers if both are numbers, or as strings if they are not, like the timestamps.
+func compareValues(a, b gjson.Result) int {
+	if a.Type == gjson.Number && b.Type == gjson.Number {
+		return cmp.Compare(a.Num, b.Num)
+	}
+	return strings.Compare(a.String(), b.String())
+}
+
+// inRange checks if the value is within the bounds of a range filter.
+func inRange(value, arguments gjson.Result) bool {
+	bounds := map[string]func(int) bool{
+		"gt":  func(c int) bool { return c > 0 },
+		"gte": func(c int) bool { return c >= 0 },
+		"lt":  func(c int) bool { return c < 0 },
+		"lte": func(c int) bool { return c <= 0 },
+	}
+	for name, check := range bounds {
+		if bound := arguments.Get(name); bound.Exists() && !check(compareValues(value, bound)) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesWildcard checks if the text matches the pattern, in which * matches any sequence of characters and ? matches a single character.
+func matchesWildcard(pattern, text string) bool {
+	var expression strings.Builder
+	expression.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expression.WriteString(".*")
+		case '?':
+			expression.WriteString(".")
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expression.WriteString("$")
+	return regexp.MustCompile(expression.String()).MatchString(text)
+}
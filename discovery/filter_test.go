@@ -0,0 +1,200 @@
+package discovery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// TestFieldFilters tests the filters that apply to a field.
+func TestFieldFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   gjson.Result
+		expected string
+	}{
+		{
+			name:     "Equals with a string",
+			filter:   Equals("name", "my-seed"),
+			expected: `{"equals":{"field":"name","value":"my-seed"}}`,
+		},
+		{
+			name:     "Equals escapes the quotes and backslashes",
+			filter:   Equals("labels.key", `say "hi" \ bye`),
+			expected: `{"equals":{"field":"labels.key","value":"say \"hi\" \\ bye"}}`,
+		},
+		{
+			name:     "Equals with a number",
+			filter:   Equals("config.batchSize", 10),
+			expected: `{"equals":{"field":"config.batchSize","value":10}}`,
+		},
+		{
+			name:     "Equals with a boolean",
+			filter:   Equals("active", true),
+			expected: `{"equals":{"field":"active","value":true}}`,
+		},
+		{
+			name:     "Equals with a JSON value",
+			filter:   Equals("config", gjson.Parse(`{"a":[1,2]}`)),
+			expected: `{"equals":{"field":"config","value":{"a":[1,2]}}}`,
+		},
+		{
+			name:     "In with several values",
+			filter:   In("type", "mongo", "openai"),
+			expected: `{"in":{"field":"type","values":["mongo","openai"]}}`,
+		},
+		{
+			name:     "In without values",
+			filter:   In("type"),
+			expected: `{"in":{"field":"type","values":[]}}`,
+		},
+		{
+			name:     "Exists",
+			filter:   Exists("labels"),
+			expected: `{"exists":{"field":"labels"}}`,
+		},
+		{
+			name:     "Empty",
+			filter:   Empty("description"),
+			expected: `{"empty":{"field":"description"}}`,
+		},
+		{
+			name:     "Wildcard",
+			filter:   Wildcard("name", "my-*"),
+			expected: `{"wildcard":{"field":"name","value":"my-*"}}`,
+		},
+		{
+			name:     "Range with two bounds",
+			filter:   Range("config.batchSize", Gte(10), Lt(100)),
+			expected: `{"range":{"field":"config.batchSize","gte":10,"lt":100}}`,
+		},
+		{
+			name:     "Range with the other bounds",
+			filter:   Range("creationTimestamp", Gt("2025-01-01T00:00:00Z"), Lte("2025-12-31T00:00:00Z")),
+			expected: `{"range":{"field":"creationTimestamp","gt":"2025-01-01T00:00:00Z","lte":"2025-12-31T00:00:00Z"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.True(t, gjson.Valid(tc.filter.Raw))
+			assert.JSONEq(t, tc.expected, tc.filter.Raw)
+		})
+	}
+}
+
+// TestFieldFilters_Panics tests that the filters panic when the value cannot be encoded as JSON.
+func TestFieldFilters_Panics(t *testing.T) {
+	assert.Panics(t, func() { Equals("name", make(chan int)) })
+}
+
+// TestLogicalFilters tests the And(), Or() and Not() functions.
+func TestLogicalFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   gjson.Result
+		expected string
+	}{
+		{
+			name:     "And with two filters",
+			filter:   And(Equals("labels.key", "A"), Equals("labels.value", "C")),
+			expected: `{"and":[{"equals":{"field":"labels.key","value":"A"}},{"equals":{"field":"labels.value","value":"C"}}]}`,
+		},
+		{
+			name:     "And with one filter",
+			filter:   And(Equals("labels.key", "A")),
+			expected: `{"equals":{"field":"labels.key","value":"A"}}`,
+		},
+		{
+			name:     "And without filters",
+			filter:   And(),
+			expected: `{}`,
+		},
+		{
+			name:     "And ignores the empty filters",
+			filter:   And(gjson.Result{}, And(), Exists("labels")),
+			expected: `{"exists":{"field":"labels"}}`,
+		},
+		{
+			name:     "Or with two filters",
+			filter:   Or(Equals("type", "mongo"), Equals("type", "openai")),
+			expected: `{"or":[{"equals":{"field":"type","value":"mongo"}},{"equals":{"field":"type","value":"openai"}}]}`,
+		},
+		{
+			name:     "Or without filters",
+			filter:   Or(),
+			expected: `{}`,
+		},
+		{
+			name:     "Not",
+			filter:   Not(Exists("labels")),
+			expected: `{"not":{"exists":{"field":"labels"}}}`,
+		},
+		{
+			name:     "Nested filters",
+			filter:   And(Equals("type", "mongo"), Or(Not(Exists("labels")), In("labels.key", "A", "B"))),
+			expected: `{"and":[{"equals":{"field":"type","value":"mongo"}},{"or":[{"not":{"exists":{"field":"labels"}}},{"in":{"field":"labels.key","values":["A","B"]}}]}]}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.True(t, gjson.Valid(tc.filter.Raw))
+			assert.JSONEq(t, tc.expected, tc.filter.Raw)
+		})
+	}
+}
+
+// Test_matchesFilter tests that the filters are evaluated on the entities like Discovery does.
+func Test_matchesFilter(t *testing.T) {
+	entity := gjson.Parse(`{
+		"name": "my-secret",
+		"active": true,
+		"labels": [{"key": "env", "value": "dev"}, {"key": "team"}],
+		"config": {"batchSize": 10, "description": ""},
+		"creationTimestamp": "2026-10-01T12:00:00Z"
+	}`)
+
+	tests := []struct {
+		name     string
+		filter   gjson.Result
+		expected bool
+		err      error
+	}{
+		{name: "Empty filter", filter: And(), expected: true},
+		{name: "Equals a string", filter: Equals("name", "my-secret"), expected: true},
+		{name: "Equals a different string", filter: Equals("name", "my"), expected: false},
+		{name: "Equals a number", filter: Equals("config.batchSize", 10.0), expected: true},
+		{name: "Equals a boolean", filter: Equals("active", true), expected: true},
+		{name: "Equals an element of an array", filter: Equals("labels.key", "team"), expected: true},
+		{name: "In", filter: In("labels.value", "prod", "dev"), expected: true},
+		{name: "Exists", filter: Exists("labels.value"), expected: true},
+		{name: "Does not exist", filter: Exists("config.servers"), expected: false},
+		{name: "Empty", filter: Empty("config.description"), expected: true},
+		{name: "Not empty", filter: Empty("name"), expected: false},
+		{name: "Wildcard", filter: Wildcard("name", "my-?ecret*"), expected: true},
+		{name: "Wildcard does not match", filter: Wildcard("name", "secret*"), expected: false},
+		{name: "Range of numbers", filter: Range("config.batchSize", Gt(5), Lte(10)), expected: true},
+		{name: "Range of timestamps", filter: Range("creationTimestamp", Gte("2026-10-02T00:00:00Z")), expected: false},
+		{name: "And", filter: And(Equals("name", "my-secret"), Equals("labels.key", "prod")), expected: false},
+		{name: "Or", filter: Or(Equals("name", "other"), Equals("labels.key", "env")), expected: true},
+		{name: "Not", filter: Not(Exists("labels")), expected: false},
+		{name: "Unsupported operator", filter: gjson.Parse(`{"match":{"field":"name","value":"secret"}}`), err: errors.New(`the filter operator "match" is not supported`)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := matchesFilter(tc.filter, entity)
+			if tc.err != nil {
+				assert.EqualError(t, err, tc.err.Error())
+				assert.False(t, matches)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, matches)
+			}
+		})
+	}
+}
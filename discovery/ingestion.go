@@ -1,6 +1,8 @@
 package discovery
 
 import (
+	"context"
+	"iter"
 	"net/http"
 	"strings"
 
@@ -54,12 +56,33 @@ func newSeedRecordsClient(sc seedsClient, seedId uuid.UUID) seedRecordsClient {
 // Get obtains a record based on the seed and record IDs.
 // Since record IDs are not UUIDs, a new function was needed.
 func (src seedRecordsClient) Get(id string) (gjson.Result, error) {
-	return execute(src.client, http.MethodGet, "/"+id)
+	return src.GetContext(src.client.requestContext(), id)
+}
+
+// GetContext is like Get, but it uses the given context for its requests instead of the one set with WithContext().
+func (src seedRecordsClient) GetContext(ctx context.Context, id string) (gjson.Result, error) {
+	return execute(ctx, src.client, http.MethodGet, "/"+id)
+}
+
+// GetAllSeq returns a sequence that iterates through every record in the seed.
+// The pages of records are requested as the sequence is consumed.
+func (src seedRecordsClient) GetAllSeq(options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return src.GetAllSeqContext(src.client.requestContext(), options...)
+}
+
+// GetAllSeqContext is like GetAllSeq, but it uses the given context for its requests instead of the one set with WithContext().
+func (src seedRecordsClient) GetAllSeqContext(ctx context.Context, options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return paginate(ctx, src.client, http.MethodGet, "", options...)
 }
 
 // GetAll obtains every record in the seed.
-func (src seedRecordsClient) GetAll() ([]gjson.Result, error) {
-	return executeWithPagination(src.client, http.MethodGet, "")
+func (src seedRecordsClient) GetAll(options ...RequestOption) ([]gjson.Result, error) {
+	return src.GetAllContext(src.client.requestContext(), options...)
+}
+
+// GetAllContext is like GetAll, but it uses the given context for its requests instead of the one set with WithContext().
+func (src seedRecordsClient) GetAllContext(ctx context.Context, options ...RequestOption) ([]gjson.Result, error) {
+	return executeWithPagination(ctx, src.client, http.MethodGet, "", options...)
 }
 
 // seedSchedulesClient is the struct that performs the CRUD and cloning of seed schedules.
@@ -71,8 +94,8 @@ type seedSchedulesClient struct {
 }
 
 // newSeedSchedulesClient is the constructor of an seedSchedulesClient.
-func newSeedSchedulesClient(url, apiKey string) seedSchedulesClient {
-	client := newClient(url+"/seed/schedule", apiKey)
+func newSeedSchedulesClient(url, apiKey string, options ...ClientOption) seedSchedulesClient {
+	client := newClient(url+"/seed/schedule", apiKey, options...)
 	return seedSchedulesClient{
 		crud: crud{
 			getter{
@@ -99,7 +122,12 @@ type seedExecutionsClient struct {
 
 // GetLast5Executions gets the last five executions sorted by creation timestamp in a descending order and returns the array as a gjson.Result
 func (src seedExecutionsClient) GetLast5Executions() (gjson.Result, error) {
-	response, err := execute(src.client, http.MethodGet, "", WithQueryParameters(map[string][]string{"size": {"5"}, "sort": {"creationTimestamp,desc"}}))
+	return src.GetLast5ExecutionsContext(src.client.requestContext())
+}
+
+// GetLast5ExecutionsContext is like GetLast5Executions, but it uses the given context for its requests instead of the one set with WithContext().
+func (src seedExecutionsClient) GetLast5ExecutionsContext(ctx context.Context) (gjson.Result, error) {
+	response, err := execute(ctx, src.client, http.MethodGet, "", WithPageSize(5), WithSort("creationTimestamp", SortDescending))
 	if err != nil {
 		return gjson.Result{}, err
 	}
@@ -123,37 +151,83 @@ func newSeedExecutionsClient(sc seedsClient, seedId uuid.UUID) seedExecutionsCli
 // Halt stops a seed execution based on the seedId and executionId.
 // It cannot halt an execution if it is already in a state that does not allow it.
 func (c seedExecutionsClient) Halt(executionId uuid.UUID) (gjson.Result, error) {
-	return execute(c.client, http.MethodPost, "/"+executionId.String()+"/halt")
+	return c.HaltContext(c.client.requestContext(), executionId)
+}
+
+// HaltContext is like Halt, but it uses the given context for its requests instead of the one set with WithContext().
+func (c seedExecutionsClient) HaltContext(ctx context.Context, executionId uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodPost, "/"+executionId.String()+"/halt")
+}
+
+// AuditSeq returns a sequence that iterates through the audited changes from a seed execution.
+// The pages of changes are requested as the sequence is consumed.
+func (c seedExecutionsClient) AuditSeq(executionId uuid.UUID) iter.Seq2[gjson.Result, error] {
+	return c.AuditSeqContext(c.client.requestContext(), executionId)
+}
+
+// AuditSeqContext is like AuditSeq, but it uses the given context for its requests instead of the one set with WithContext().
+func (c seedExecutionsClient) AuditSeqContext(ctx context.Context, executionId uuid.UUID) iter.Seq2[gjson.Result, error] {
+	return paginate(ctx, c.client, http.MethodGet, "/"+executionId.String()+"/audit")
 }
 
 // Audit gets the audited changes from a seed execution. It returns an array with the stages the execution has completed.
 func (c seedExecutionsClient) Audit(executionId uuid.UUID) ([]gjson.Result, error) {
-	return executeWithPagination(c.client, http.MethodGet, "/"+executionId.String()+"/audit")
+	return c.AuditContext(c.client.requestContext(), executionId)
+}
+
+// AuditContext is like Audit, but it uses the given context for its requests instead of the one set with WithContext().
+func (c seedExecutionsClient) AuditContext(ctx context.Context, executionId uuid.UUID) ([]gjson.Result, error) {
+	return executeWithPagination(ctx, c.client, http.MethodGet, "/"+executionId.String()+"/audit")
 }
 
 // Seed gets the seed configuration of the seed execution.
 func (c seedExecutionsClient) Seed(executionId uuid.UUID) (gjson.Result, error) {
-	return execute(c.client, http.MethodGet, "/"+executionId.String()+"/config/seed")
+	return c.SeedContext(c.client.requestContext(), executionId)
+}
+
+// SeedContext is like Seed, but it uses the given context for its requests instead of the one set with WithContext().
+func (c seedExecutionsClient) SeedContext(ctx context.Context, executionId uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodGet, "/"+executionId.String()+"/config/seed")
 }
 
 // Pipeline gets the pipeline's configuration.
 func (c seedExecutionsClient) Pipeline(executionId uuid.UUID, pipelineId uuid.UUID) (gjson.Result, error) {
-	return execute(c.client, http.MethodGet, "/"+executionId.String()+"/config/pipeline/"+pipelineId.String())
+	return c.PipelineContext(c.client.requestContext(), executionId, pipelineId)
+}
+
+// PipelineContext is like Pipeline, but it uses the given context for its requests instead of the one set with WithContext().
+func (c seedExecutionsClient) PipelineContext(ctx context.Context, executionId uuid.UUID, pipelineId uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodGet, "/"+executionId.String()+"/config/pipeline/"+pipelineId.String())
 }
 
 // Processor gets the configuration of a processor used in the pipeline of the seed.
 func (c seedExecutionsClient) Processor(executionId uuid.UUID, processorId uuid.UUID) (gjson.Result, error) {
-	return execute(c.client, http.MethodGet, "/"+executionId.String()+"/config/processor/"+processorId.String())
+	return c.ProcessorContext(c.client.requestContext(), executionId, processorId)
+}
+
+// ProcessorContext is like Processor, but it uses the given context for its requests instead of the one set with WithContext().
+func (c seedExecutionsClient) ProcessorContext(ctx context.Context, executionId uuid.UUID, processorId uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodGet, "/"+executionId.String()+"/config/processor/"+processorId.String())
 }
 
 // Server gets the configuration of a server used by a processor of the seed.
 func (c seedExecutionsClient) Server(executionId uuid.UUID, serverId uuid.UUID) (gjson.Result, error) {
-	return execute(c.client, http.MethodGet, "/"+executionId.String()+"/config/server/"+serverId.String())
+	return c.ServerContext(c.client.requestContext(), executionId, serverId)
+}
+
+// ServerContext is like Server, but it uses the given context for its requests instead of the one set with WithContext().
+func (c seedExecutionsClient) ServerContext(ctx context.Context, executionId uuid.UUID, serverId uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodGet, "/"+executionId.String()+"/config/server/"+serverId.String())
 }
 
 // Credential gets the configuration of a credential used by a server of the seed.
 func (c seedExecutionsClient) Credential(executionId uuid.UUID, credentialId uuid.UUID) (gjson.Result, error) {
-	return execute(c.client, http.MethodGet, "/"+executionId.String()+"/config/credential/"+credentialId.String())
+	return c.CredentialContext(c.client.requestContext(), executionId, credentialId)
+}
+
+// CredentialContext is like Credential, but it uses the given context for its requests instead of the one set with WithContext().
+func (c seedExecutionsClient) CredentialContext(ctx context.Context, executionId uuid.UUID, credentialId uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodGet, "/"+executionId.String()+"/config/credential/"+credentialId.String())
 }
 
 // Records creates a seedExecutionsRecordClient.
@@ -174,8 +248,8 @@ type ingestionProcessorsClient struct {
 }
 
 // newIngestionProcessorsClient is the constructor of a ingestionProcessorsClient.
-func newIngestionProcessorsClient(url, apiKey string) ingestionProcessorsClient {
-	client := newClient(url+"/processor", apiKey)
+func newIngestionProcessorsClient(url, apiKey string, options ...ClientOption) ingestionProcessorsClient {
+	client := newClient(url+"/processor", apiKey, options...)
 	return ingestionProcessorsClient{
 		crud: crud{
 			getter{
@@ -199,8 +273,8 @@ type ingestionPipelinesClient struct {
 }
 
 // newIngestionPipelinesClient is the constructor of an ingestionPipelinesClient.
-func newIngestionPipelinesClient(url, apiKey string) ingestionPipelinesClient {
-	client := newClient(url+"/pipeline", apiKey)
+func newIngestionPipelinesClient(url, apiKey string, options ...ClientOption) ingestionPipelinesClient {
+	client := newClient(url+"/pipeline", apiKey, options...)
 	return ingestionPipelinesClient{
 		crud: crud{
 			getter{
@@ -224,8 +298,8 @@ type seedsClient struct {
 }
 
 // newSeedsClient is the constructor of seedsClient.
-func newSeedsClient(url, apiKey string) seedsClient {
-	client := newClient(url+"/seed", apiKey)
+func newSeedsClient(url, apiKey string, options ...ClientOption) seedsClient {
+	client := newClient(url+"/seed", apiKey, options...)
 	return seedsClient{
 		crud: crud{
 			getter{
@@ -254,12 +328,17 @@ const (
 
 // Start starts the execution of seed.
 func (sc seedsClient) Start(id uuid.UUID, scan ScanType, executionProperties gjson.Result) (gjson.Result, error) {
+	return sc.StartContext(sc.crud.client.requestContext(), id, scan, executionProperties)
+}
+
+// StartContext is like Start, but it uses the given context for its requests instead of the one set with WithContext().
+func (sc seedsClient) StartContext(ctx context.Context, id uuid.UUID, scan ScanType, executionProperties gjson.Result) (gjson.Result, error) {
 	if !executionProperties.Exists() {
-		return execute(sc.crud.client, http.MethodPost, "/"+id.String(), WithQueryParameters(map[string][]string{
+		return execute(ctx, sc.crud.client, http.MethodPost, "/"+id.String(), WithQueryParameters(map[string][]string{
 			"scanType": {string(scan)},
 		}))
 	} else {
-		return execute(sc.crud.client, http.MethodPost, "/"+id.String(), WithQueryParameters(map[string][]string{
+		return execute(ctx, sc.crud.client, http.MethodPost, "/"+id.String(), WithQueryParameters(map[string][]string{
 			"scanType": {string(scan)},
 		}), WithJSONBody(executionProperties.Raw))
 	}
@@ -267,7 +346,12 @@ func (sc seedsClient) Start(id uuid.UUID, scan ScanType, executionProperties gjs
 
 // Halt stops all the executions of a seed.
 func (sc seedsClient) Halt(id uuid.UUID) ([]gjson.Result, error) {
-	haltings, err := execute(sc.crud.client, http.MethodPost, "/"+id.String()+"/halt")
+	return sc.HaltContext(sc.crud.client.requestContext(), id)
+}
+
+// HaltContext is like Halt, but it uses the given context for its requests instead of the one set with WithContext().
+func (sc seedsClient) HaltContext(ctx context.Context, id uuid.UUID) ([]gjson.Result, error) {
+	haltings, err := execute(ctx, sc.crud.client, http.MethodPost, "/"+id.String()+"/halt")
 	if err != nil {
 		return []gjson.Result(nil), err
 	}
@@ -278,7 +362,12 @@ func (sc seedsClient) Halt(id uuid.UUID) ([]gjson.Result, error) {
 // Reset resets a seed.
 // If the seed has no active executions, then the seed's metadata is reset and its records deleted.
 func (sc seedsClient) Reset(id uuid.UUID) (gjson.Result, error) {
-	return execute(sc.crud.client, http.MethodPost, "/"+id.String()+"/reset")
+	return sc.ResetContext(sc.crud.client.requestContext(), id)
+}
+
+// ResetContext is like Reset, but it uses the given context for its requests instead of the one set with WithContext().
+func (sc seedsClient) ResetContext(ctx context.Context, id uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, sc.crud.client, http.MethodPost, "/"+id.String()+"/reset")
 }
 
 // Records creates a new seedRecordsClient.
@@ -294,44 +383,51 @@ func (sc seedsClient) Executions(seedId uuid.UUID) seedExecutionsClient {
 // ingestion is the struct that is used to interact with the Ingestion Component.
 type ingestion struct {
 	Url, ApiKey string
+	options     []ClientOption
 }
 
 // Processors is used to create an ingestionProcessorsClient.
 func (i ingestion) Processors() ingestionProcessorsClient {
-	return newIngestionProcessorsClient(i.Url, i.ApiKey)
+	return newIngestionProcessorsClient(i.Url, i.ApiKey, i.options...)
 }
 
 // Pipelines is used to create an ingestionPipelinesClient.
 func (i ingestion) Pipelines() ingestionPipelinesClient {
-	return newIngestionPipelinesClient(i.Url, i.ApiKey)
+	return newIngestionPipelinesClient(i.Url, i.ApiKey, i.options...)
 }
 
 // Seeds is used to create a seedsClient.
 func (i ingestion) Seeds() seedsClient {
-	return newSeedsClient(i.Url, i.ApiKey)
+	return newSeedsClient(i.Url, i.ApiKey, i.options...)
 }
 
 // SeedSchedules creates a new newSeedSchedulesClient.
 func (i ingestion) SeedSchedules() seedSchedulesClient {
-	return newSeedSchedulesClient(i.Url, i.ApiKey)
+	return newSeedSchedulesClient(i.Url, i.ApiKey, i.options...)
 }
 
 // BackupRestore creates a backUpRestore struct.
 func (i ingestion) BackupRestore() backupRestore {
 	return backupRestore{
-		client: newClient(i.Url, i.ApiKey),
+		client: newClient(i.Url, i.ApiKey, i.options...),
 	}
 }
 
 // StatusChecker creates a statusChecker with the Ingestion's URL and API Key.
 func (i ingestion) StatusChecker() statusChecker {
 	return statusChecker{
-		client: newClient(i.Url[:len(i.Url)-3], i.ApiKey),
+		client: newClient(i.Url[:len(i.Url)-3], i.ApiKey, i.options...),
 	}
 }
 
-// NewIngestion is the constructor of the ingestion struct.
+// NewIngestion creates the client of Discovery Ingestion, which implements the Ingestion interface.
 // It adds a /v2 path to the URL in order to properly connect to Discovery.
-func NewIngestion(url, apiKey string) ingestion {
-	return ingestion{Url: strings.TrimRight(url, "/") + "/v2", ApiKey: apiKey}
+// The client options are applied to every client created by it.
+func NewIngestion(url, apiKey string, options ...ClientOption) Ingestion {
+	return ingestionSDK{newIngestion(url, apiKey, options...)}
+}
+
+// newIngestion is the constructor for the ingestion struct.
+func newIngestion(url, apiKey string, options ...ClientOption) ingestion {
+	return ingestion{Url: strings.TrimRight(url, "/") + "/v2", ApiKey: apiKey, options: options}
 }
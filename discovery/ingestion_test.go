@@ -5,7 +5,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pureinsights/discovery-cli/internal/testutils"
@@ -1797,7 +1799,7 @@ func Test_seedsClient_Executions(t *testing.T) {
 
 // Test_ingestion_Processors tests the ingestion.Processors() function.
 func Test_ingestion_Processors(t *testing.T) {
-	i := NewIngestion("http://localhost:12030", "Api Key")
+	i := newIngestion("http://localhost:12030", "Api Key")
 	ipc := i.Processors()
 
 	assert.Equal(t, i.ApiKey, ipc.crud.client.ApiKey)
@@ -1810,7 +1812,7 @@ func Test_ingestion_Processors(t *testing.T) {
 
 // Test_ingestion_SeedSchedules tests the ingestion.SeedSchedules() function.
 func Test_ingestion_SeedSchedules(t *testing.T) {
-	i := NewIngestion("http://localhost:12030", "Api Key")
+	i := newIngestion("http://localhost:12030", "Api Key")
 	seedSchedulesClient := i.SeedSchedules()
 
 	assert.Equal(t, i.ApiKey, seedSchedulesClient.crud.client.ApiKey)
@@ -1825,7 +1827,7 @@ func Test_ingestion_SeedSchedules(t *testing.T) {
 
 // Test_ingestion_Pipelines tests the ingestion.Pipelines() function.
 func Test_ingestion_Pipelines(t *testing.T) {
-	i := NewIngestion("http://localhost:12030", "Api Key")
+	i := newIngestion("http://localhost:12030", "Api Key")
 	ipc := i.Pipelines()
 
 	assert.Equal(t, i.ApiKey, ipc.crud.client.ApiKey)
@@ -1838,7 +1840,7 @@ func Test_ingestion_Pipelines(t *testing.T) {
 
 // Test_ingestion_Seeds test the ingestion.Seeds() function.
 func Test_ingestion_Seeds(t *testing.T) {
-	i := NewIngestion("http://localhost:12030", "Api Key")
+	i := newIngestion("http://localhost:12030", "Api Key")
 	ipc := i.Seeds()
 
 	assert.Equal(t, i.ApiKey, ipc.crud.client.ApiKey)
@@ -1851,7 +1853,7 @@ func Test_ingestion_Seeds(t *testing.T) {
 
 // Test_ingestion_BackupRestore tests the ingestion.BackupRestore() function.
 func Test_ingestion_BackupRestore(t *testing.T) {
-	i := NewIngestion("http://localhost:12030", "Api Key")
+	i := newIngestion("http://localhost:12030", "Api Key")
 	bc := i.BackupRestore()
 
 	assert.Equal(t, i.ApiKey, bc.ApiKey)
@@ -1860,7 +1862,7 @@ func Test_ingestion_BackupRestore(t *testing.T) {
 
 // Test_ingestion_StatusChecker tests the ingestion.StatusChecker() function.
 func Test_ingestion_StatusChecker(t *testing.T) {
-	i := NewIngestion("http://localhost:12030", "Api Key")
+	i := newIngestion("http://localhost:12030", "Api Key")
 	bc := i.StatusChecker()
 
 	assert.Equal(t, i.ApiKey, bc.ApiKey)
@@ -1869,8 +1871,55 @@ func Test_ingestion_StatusChecker(t *testing.T) {
 
 // Test_NewIngestion tests the ingestion constructor.
 func Test_NewIngestion(t *testing.T) {
-	i := NewIngestion("http://localhost:12030///", "Api Key")
+	i := newIngestion("http://localhost:12030///", "Api Key")
 
 	assert.Equal(t, "http://localhost:12030/v2", i.Url)
 	assert.Equal(t, "Api Key", i.ApiKey)
 }
+
+// Test_NewIngestion_ClientOptions tests that the client options are applied to the clients and sub-clients created by the ingestion struct.
+func Test_NewIngestion_ClientOptions(t *testing.T) {
+	i := newIngestion("http://localhost:12030", "Api Key", WithTimeout(5*time.Second))
+	seedId := uuid.MustParse("1d81d3d5-58a2-44a5-9acf-3fc8358afe09")
+
+	assert.Equal(t, 5*time.Second, i.Seeds().crud.client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, i.Seeds().Executions(seedId).client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, i.Seeds().Records(seedId).client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, i.StatusChecker().client.client.GetClient().Timeout)
+}
+
+// Test_seedRecordsClient_GetAllSeq tests that seedRecordsClient.GetAllSeq() yields the records of every page.
+func Test_seedRecordsClient_GetAllSeq(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(pagedHandler(t, [][]string{{`{"id":"r1"}`}, {`{"id":"r2"}`}, {`{"id":"r3"}`}}, calls))
+	t.Cleanup(srv.Close)
+
+	seedId := uuid.MustParse("2acd0a61-852c-4f38-af2b-9c84e152873e")
+	records := newSeedRecordsClient(newSeedsClient(srv.URL, ""), seedId)
+	var ids []string
+	for record, err := range records.GetAllSeq() {
+		require.NoError(t, err)
+		ids = append(ids, record.Get("id").String())
+	}
+
+	assert.Equal(t, []string{"r1", "r2", "r3"}, ids)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+// Test_seedExecutionsClient_AuditSeq tests that seedExecutionsClient.AuditSeq() yields the audited changes of every page.
+func Test_seedExecutionsClient_AuditSeq(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(pagedHandler(t, [][]string{{`{"stage":"BEFORE_HOOKS"}`, `{"stage":"INGEST"}`}, {`{"stage":"AFTER_HOOKS"}`}}, calls))
+	t.Cleanup(srv.Close)
+
+	seedId := uuid.MustParse("2acd0a61-852c-4f38-af2b-9c84e152873e")
+	executions := newSeedExecutionsClient(newSeedsClient(srv.URL, ""), seedId)
+	var stages []string
+	for change, err := range executions.AuditSeq(uuid.MustParse("a056c7fb-0ca1-45f6-97ea-ec849a0701fd")) {
+		require.NoError(t, err)
+		stages = append(stages, change.Get("stage").String())
+	}
+
+	assert.Equal(t, []string{"BEFORE_HOOKS", "INGEST", "AFTER_HOOKS"}, stages)
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,398 @@
+package discovery
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entity contains the fields that most of the Discovery entities have in common.
+// It is embedded in the structs of the entities.
+type Entity struct {
+	Id                   uuid.UUID `json:"id,omitzero"`
+	Name                 string    `json:"name,omitempty"`
+	Description          string    `json:"description,omitempty"`
+	Labels               []Label   `json:"labels,omitzero"`
+	Active               *bool     `json:"active,omitempty"`
+	CreationTimestamp    time.Time `json:"creationTimestamp,omitzero"`
+	LastUpdatedTimestamp time.Time `json:"lastUpdatedTimestamp,omitzero"`
+}
+
+// Label is a key-value pair of Discovery Core that classifies the entities.
+type Label struct {
+	Id                   uuid.UUID `json:"id,omitzero"`
+	Key                  string    `json:"key"`
+	Value                string    `json:"value,omitempty"`
+	CreationTimestamp    time.Time `json:"creationTimestamp,omitzero"`
+	LastUpdatedTimestamp time.Time `json:"lastUpdatedTimestamp,omitzero"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Secret is a secret of Discovery Core.
+type Secret struct {
+	Entity
+	Content json.RawMessage `json:"content,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Credential is a credential of Discovery Core.
+// The secret is the name of the secret used by the credential.
+type Credential struct {
+	Entity
+	Type   string          `json:"type,omitempty"`
+	Secret string          `json:"secret,omitempty"`
+	Config json.RawMessage `json:"config,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Server is a server of Discovery Core.
+type Server struct {
+	Entity
+	Type       string          `json:"type,omitempty"`
+	Credential uuid.UUID       `json:"credential,omitzero"`
+	Config     json.RawMessage `json:"config,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Processor is a processor of Discovery Ingestion or Discovery QueryFlow.
+type Processor struct {
+	Entity
+	Type   string          `json:"type,omitempty"`
+	Server json.RawMessage `json:"server,omitempty"`
+	Config json.RawMessage `json:"config,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Pipeline is a pipeline of Discovery Ingestion or Discovery QueryFlow.
+type Pipeline struct {
+	Entity
+	InitialState string          `json:"initialState,omitempty"`
+	States       json.RawMessage `json:"states,omitempty"`
+	RecordPolicy json.RawMessage `json:"recordPolicy,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Seed is a seed of Discovery Ingestion.
+type Seed struct {
+	Entity
+	Type         string          `json:"type,omitempty"`
+	Pipeline     uuid.UUID       `json:"pipeline,omitzero"`
+	Config       json.RawMessage `json:"config,omitempty"`
+	Properties   json.RawMessage `json:"properties,omitempty"`
+	RecordPolicy json.RawMessage `json:"recordPolicy,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// SeedSchedule is a seed schedule of Discovery Ingestion.
+// The expression is the cron expression that determines when the seed is executed.
+type SeedSchedule struct {
+	Entity
+	Expression string          `json:"expression,omitempty"`
+	Seed       uuid.UUID       `json:"seed,omitzero"`
+	ScanType   ScanType        `json:"scanType,omitempty"`
+	Properties json.RawMessage `json:"properties,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// SeedExecution is an execution of a seed of Discovery Ingestion.
+type SeedExecution struct {
+	Id                   uuid.UUID       `json:"id,omitzero"`
+	Status               string          `json:"status,omitempty"`
+	TriggerType          string          `json:"triggerType,omitempty"`
+	ScanType             ScanType        `json:"scanType,omitempty"`
+	Properties           json.RawMessage `json:"properties,omitempty"`
+	CreationTimestamp    time.Time       `json:"creationTimestamp,omitzero"`
+	LastUpdatedTimestamp time.Time       `json:"lastUpdatedTimestamp,omitzero"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Endpoint is an endpoint of Discovery QueryFlow.
+type Endpoint struct {
+	Entity
+	Type         string          `json:"type,omitempty"`
+	URI          string          `json:"uri,omitempty"`
+	HTTPMethod   string          `json:"httpMethod,omitempty"`
+	InitialState string          `json:"initialState,omitempty"`
+	States       json.RawMessage `json:"states,omitempty"`
+	Timeout      string          `json:"timeout,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// MCPServer is an MCP server of Discovery QueryFlow.
+type MCPServer struct {
+	Entity
+	Type string `json:"type,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Tool is a tool of an MCP server of Discovery QueryFlow.
+type Tool struct {
+	Entity
+	Type   string          `json:"type,omitempty"`
+	Config json.RawMessage `json:"config,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Bucket is a bucket of Discovery Staging.
+// The document count has the number of documents of the bucket for each action, like STORE.
+type Bucket struct {
+	Entity
+	DocumentCount map[string]int64 `json:"documentCount,omitzero"`
+	Indices       []BucketIndex    `json:"indices,omitzero"`
+	Config        json.RawMessage  `json:"config,omitempty"`
+	// Extra contains the fields of the JSON object that are not fields of the struct.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BucketIndex is an index of a bucket of Discovery Staging.
+// Each field maps the name of a field of the documents to its sort order, ASC or DESC.
+type BucketIndex struct {
+	Name   string              `json:"name"`
+	Fields []map[string]string `json:"fields"`
+	Unique bool                `json:"unique"`
+}
+
+// jsonFields returns the lowercase names of the JSON fields of a struct type, including the ones of its embedded structs.
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := map[string]bool{}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for embedded := range jsonFields(field.Type) {
+				fields[embedded] = true
+			}
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = true
+	}
+	return fields
+}
+
+// unmarshalEntity decodes the JSON object into the struct the entity points to.
+// The fields of the object that are not fields of the struct are stored in the extra map.
+// The entity must point to a type without an UnmarshalJSON method, like an alias of the struct.
+func unmarshalEntity(data []byte, entity any, extra *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(data, entity); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	known := jsonFields(reflect.TypeOf(entity).Elem())
+	for name := range fields {
+		if known[strings.ToLower(name)] {
+			delete(fields, name)
+		}
+	}
+
+	if len(fields) == 0 {
+		fields = nil
+	}
+	*extra = fields
+	return nil
+}
+
+// marshalEntity encodes the entity and adds the fields of the extra map to the JSON object.
+// The entity must be a type without a MarshalJSON method, like an alias of the struct.
+func marshalEntity(entity any, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(entity)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	for name, value := range extra {
+		if _, exists := fields[name]; !exists {
+			fields[name] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON decodes the label and keeps its unknown fields in Extra.
+func (l *Label) UnmarshalJSON(data []byte) error {
+	type alias Label
+	return unmarshalEntity(data, (*alias)(l), &l.Extra)
+}
+
+// MarshalJSON encodes the label with the unknown fields in Extra.
+func (l Label) MarshalJSON() ([]byte, error) {
+	type alias Label
+	return marshalEntity(alias(l), l.Extra)
+}
+
+// UnmarshalJSON decodes the secret and keeps its unknown fields in Extra.
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	type alias Secret
+	return unmarshalEntity(data, (*alias)(s), &s.Extra)
+}
+
+// MarshalJSON encodes the secret with the unknown fields in Extra.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	type alias Secret
+	return marshalEntity(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes the credential and keeps its unknown fields in Extra.
+func (c *Credential) UnmarshalJSON(data []byte) error {
+	type alias Credential
+	return unmarshalEntity(data, (*alias)(c), &c.Extra)
+}
+
+// MarshalJSON encodes the credential with the unknown fields in Extra.
+func (c Credential) MarshalJSON() ([]byte, error) {
+	type alias Credential
+	return marshalEntity(alias(c), c.Extra)
+}
+
+// UnmarshalJSON decodes the server and keeps its unknown fields in Extra.
+func (s *Server) UnmarshalJSON(data []byte) error {
+	type alias Server
+	return unmarshalEntity(data, (*alias)(s), &s.Extra)
+}
+
+// MarshalJSON encodes the server with the unknown fields in Extra.
+func (s Server) MarshalJSON() ([]byte, error) {
+	type alias Server
+	return marshalEntity(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes the processor and keeps its unknown fields in Extra.
+func (p *Processor) UnmarshalJSON(data []byte) error {
+	type alias Processor
+	return unmarshalEntity(data, (*alias)(p), &p.Extra)
+}
+
+// MarshalJSON encodes the processor with the unknown fields in Extra.
+func (p Processor) MarshalJSON() ([]byte, error) {
+	type alias Processor
+	return marshalEntity(alias(p), p.Extra)
+}
+
+// UnmarshalJSON decodes the pipeline and keeps its unknown fields in Extra.
+func (p *Pipeline) UnmarshalJSON(data []byte) error {
+	type alias Pipeline
+	return unmarshalEntity(data, (*alias)(p), &p.Extra)
+}
+
+// MarshalJSON encodes the pipeline with the unknown fields in Extra.
+func (p Pipeline) MarshalJSON() ([]byte, error) {
+	type alias Pipeline
+	return marshalEntity(alias(p), p.Extra)
+}
+
+// UnmarshalJSON decodes the seed and keeps its unknown fields in Extra.
+func (s *Seed) UnmarshalJSON(data []byte) error {
+	type alias Seed
+	return unmarshalEntity(data, (*alias)(s), &s.Extra)
+}
+
+// MarshalJSON encodes the seed with the unknown fields in Extra.
+func (s Seed) MarshalJSON() ([]byte, error) {
+	type alias Seed
+	return marshalEntity(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes the seed schedule and keeps its unknown fields in Extra.
+func (s *SeedSchedule) UnmarshalJSON(data []byte) error {
+	type alias SeedSchedule
+	return unmarshalEntity(data, (*alias)(s), &s.Extra)
+}
+
+// MarshalJSON encodes the seed schedule with the unknown fields in Extra.
+func (s SeedSchedule) MarshalJSON() ([]byte, error) {
+	type alias SeedSchedule
+	return marshalEntity(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes the seed execution and keeps its unknown fields in Extra.
+func (s *SeedExecution) UnmarshalJSON(data []byte) error {
+	type alias SeedExecution
+	return unmarshalEntity(data, (*alias)(s), &s.Extra)
+}
+
+// MarshalJSON encodes the seed execution with the unknown fields in Extra.
+func (s SeedExecution) MarshalJSON() ([]byte, error) {
+	type alias SeedExecution
+	return marshalEntity(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes the endpoint and keeps its unknown fields in Extra.
+func (e *Endpoint) UnmarshalJSON(data []byte) error {
+	type alias Endpoint
+	return unmarshalEntity(data, (*alias)(e), &e.Extra)
+}
+
+// MarshalJSON encodes the endpoint with the unknown fields in Extra.
+func (e Endpoint) MarshalJSON() ([]byte, error) {
+	type alias Endpoint
+	return marshalEntity(alias(e), e.Extra)
+}
+
+// UnmarshalJSON decodes the MCP server and keeps its unknown fields in Extra.
+func (m *MCPServer) UnmarshalJSON(data []byte) error {
+	type alias MCPServer
+	return unmarshalEntity(data, (*alias)(m), &m.Extra)
+}
+
+// MarshalJSON encodes the MCP server with the unknown fields in Extra.
+func (m MCPServer) MarshalJSON() ([]byte, error) {
+	type alias MCPServer
+	return marshalEntity(alias(m), m.Extra)
+}
+
+// UnmarshalJSON decodes the tool and keeps its unknown fields in Extra.
+func (t *Tool) UnmarshalJSON(data []byte) error {
+	type alias Tool
+	return unmarshalEntity(data, (*alias)(t), &t.Extra)
+}
+
+// MarshalJSON encodes the tool with the unknown fields in Extra.
+func (t Tool) MarshalJSON() ([]byte, error) {
+	type alias Tool
+	return marshalEntity(alias(t), t.Extra)
+}
+
+// UnmarshalJSON decodes the bucket and keeps its unknown fields in Extra.
+func (b *Bucket) UnmarshalJSON(data []byte) error {
+	type alias Bucket
+	return unmarshalEntity(data, (*alias)(b), &b.Extra)
+}
+
+// MarshalJSON encodes the bucket with the unknown fields in Extra.
+func (b Bucket) MarshalJSON() ([]byte, error) {
+	type alias Bucket
+	return marshalEntity(alias(b), b.Extra)
+}
@@ -0,0 +1,200 @@
+package discovery
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTrip decodes the JSON into a value of the given type and encodes it again.
+func roundTrip[T any](t *testing.T, data string) (T, string) {
+	t.Helper()
+	var value T
+	require.NoError(t, json.Unmarshal([]byte(data), &value))
+	encoded, err := json.Marshal(value)
+	require.NoError(t, err)
+	return value, string(encoded)
+}
+
+// TestModels_RoundTrip tests that the entities are encoded with the same fields they were decoded from, including the unknown ones.
+func TestModels_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		roundTrip func(t *testing.T, data string) string
+	}{
+		{
+			name: "Label",
+			json: `{"id":"4957145b-6192-4862-a5da-e97853974e9f","key":"A","value":"B","creationTimestamp":"2025-10-17T22:37:53Z"}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Label](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Label without a value",
+			json: `{"key":"env"}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Label](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Credential with a label without a value",
+			json: `{"type":"mongo","name":"my-credential","labels":[{"key":"env"},{"key":"A","value":"A"}],"active":true}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Credential](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Secret",
+			json: `{"name":"mongo-secret","labels":[],"active":true,"content":{"username":"user","password":"pass"}}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Secret](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Credential",
+			json: `{"type":"mongo","name":"my-credential","labels":[{"key":"A","value":"A"}],"active":true,"id":"4957145b-6192-4862-a5da-e97853974e9f","secret":"mongo-secret","config":{"authDatabase":"admin"},"futureField":1.50}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Credential](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Server",
+			json: `{"type":"mongo","name":"MongoDB server","active":false,"credential":"3b32e410-2f33-412d-9fb8-17970131921c","config":{"servers":["mongodb://localhost:27017"]},"pool":{"size":5}}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Server](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Processor",
+			json: `{"type":"mongo","name":"MongoDB text processor","labels":[],"active":true,"id":"3393f6d9-94c1-4b70-ba02-5f582727d998","server":{"id":"21029da3-041c-43b5-a67e-870251f2f6a6"},"config":{"action":"hydrate"}}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Processor](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Pipeline",
+			json: `{"name":"my-pipeline","initialState":"ingestState","states":{"ingestState":{"type":"processor"}},"recordPolicy":{"errorPolicy":"FAIL"}}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Pipeline](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Seed",
+			json: `{"type":"staging","name":"my-seed","pipeline":"0d3f476d-9003-4fc8-b9a9-8ba6ebf9445b","config":{"bucket":"blogs"},"properties":{"a":1},"batchSize":10}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Seed](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "SeedSchedule",
+			json: `{"name":"my-seed-schedule","expression":"0 0 * * *","properties":{"some-property":"a"},"seed":"ac7c5765-bef6-42cc-b519-c75df51ebf3b","scanType":"INCREMENTAL"}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[SeedSchedule](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "SeedExecution",
+			json: `{"id":"f4242ca1-0572-4244-8fcb-1305332351b9","creationTimestamp":"2026-04-14T16:06:44Z","lastUpdatedTimestamp":"2026-04-14T16:24:03Z","triggerType":"MANUAL","status":"DONE","scanType":"FULL","stages":["BEFORE_HOOKS"]}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[SeedExecution](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Endpoint",
+			json: `{"type":"default","uri":"/search","httpMethod":"GET","name":"search","initialState":"searchState","states":{},"timeout":"PT5S","active":true}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Endpoint](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "MCPServer",
+			json: `{"type":"default","name":"my-mcp-server","active":true,"version":"1.0"}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[MCPServer](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Tool",
+			json: `{"name":"search-tool","description":"Searches the blogs","inputSchema":{"type":"object"}}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Tool](t, data)
+				return encoded
+			},
+		},
+		{
+			name: "Bucket",
+			json: `{"name":"testBucket","id":"3d51beef-8b90-40aa-84b5-033241dc6239","documentCount":{"STORE":3},"indices":[{"name":"authorIndex","fields":[{"author":"DESC"}],"unique":false}]}`,
+			roundTrip: func(t *testing.T, data string) string {
+				_, encoded := roundTrip[Bucket](t, data)
+				return encoded
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.JSONEq(t, tc.json, tc.roundTrip(t, tc.json))
+		})
+	}
+}
+
+// TestModels_Fields tests that the known fields are decoded into the struct and the unknown ones are kept in Extra.
+func TestModels_Fields(t *testing.T) {
+	server, _ := roundTrip[Server](t, `{
+		"type": "mongo",
+		"name": "MongoDB server",
+		"labels": [{"key": "A", "value": "B"}],
+		"active": false,
+		"id": "21029da3-041c-43b5-a67e-870251f2f6a6",
+		"credential": "3b32e410-2f33-412d-9fb8-17970131921c",
+		"creationTimestamp": "2025-08-14T18:02:38Z",
+		"pool": {"size": 5}
+	}`)
+
+	assert.Equal(t, "mongo", server.Type)
+	assert.Equal(t, "MongoDB server", server.Name)
+	assert.Equal(t, []Label{{Key: "A", Value: "B"}}, server.Labels)
+	require.NotNil(t, server.Active)
+	assert.False(t, *server.Active)
+	assert.Equal(t, uuid.MustParse("21029da3-041c-43b5-a67e-870251f2f6a6"), server.Id)
+	assert.Equal(t, uuid.MustParse("3b32e410-2f33-412d-9fb8-17970131921c"), server.Credential)
+	assert.Equal(t, time.Date(2025, 8, 14, 18, 2, 38, 0, time.UTC), server.CreationTimestamp)
+	assert.Equal(t, map[string]json.RawMessage{"pool": json.RawMessage(`{"size": 5}`)}, server.Extra)
+}
+
+// TestModels_NewEntity tests that the zero fields of a new entity are not encoded.
+func TestModels_NewEntity(t *testing.T) {
+	encoded, err := json.Marshal(SeedSchedule{
+		Entity:     Entity{Name: "my-seed-schedule"},
+		Expression: "0 0 * * *",
+		Seed:       uuid.MustParse("ac7c5765-bef6-42cc-b519-c75df51ebf3b"),
+		ScanType:   ScanFull,
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"my-seed-schedule","expression":"0 0 * * *","seed":"ac7c5765-bef6-42cc-b519-c75df51ebf3b","scanType":"FULL"}`, string(encoded))
+}
+
+// TestModels_InvalidJSON tests that an error is returned when the JSON does not match the struct.
+func TestModels_InvalidJSON(t *testing.T) {
+	var server Server
+	assert.Error(t, json.Unmarshal([]byte(`{"id":"not-a-uuid"}`), &server))
+	assert.Error(t, json.Unmarshal([]byte(`[]`), &server))
+}
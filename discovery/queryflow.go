@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"context"
 	"strings"
 
 	"github.com/google/uuid"
@@ -15,8 +16,8 @@ type queryFlowProcessorsClient struct {
 }
 
 // newQueryFlowProcessorsClient is the constructor of a queryFlowProcessorsClient.
-func newQueryFlowProcessorsClient(url, apiKey string) queryFlowProcessorsClient {
-	client := newClient(url+"/processor", apiKey)
+func newQueryFlowProcessorsClient(url, apiKey string, options ...ClientOption) queryFlowProcessorsClient {
+	client := newClient(url+"/processor", apiKey, options...)
 	return queryFlowProcessorsClient{
 		crud: crud{
 			getter{
@@ -40,8 +41,8 @@ type queryFlowPipelinesClient struct {
 }
 
 // NewQueryFlowPipelinesClient is the constructor of a queryFlowPipelinesClient.
-func newQueryFlowPipelinesClient(url, apiKey string) queryFlowPipelinesClient {
-	client := newClient(url+"/pipeline", apiKey)
+func newQueryFlowPipelinesClient(url, apiKey string, options ...ClientOption) queryFlowPipelinesClient {
+	client := newClient(url+"/pipeline", apiKey, options...)
 	return queryFlowPipelinesClient{
 		crud: crud{
 			getter{
@@ -66,8 +67,8 @@ type endpointsClient struct {
 }
 
 // newEndpointsClient is the constructor of a new endpointsClient.
-func newEndpointsClient(url, apiKey string) endpointsClient {
-	client := newClient(url+"/entrypoint/endpoint", apiKey)
+func newEndpointsClient(url, apiKey string, options ...ClientOption) endpointsClient {
+	client := newClient(url+"/entrypoint/endpoint", apiKey, options...)
 	return endpointsClient{
 		crud: crud{
 			getter{
@@ -95,8 +96,8 @@ type mcpServersClient struct {
 }
 
 // newMCPServersClient is the constructor of a new mcpServersClient.
-func newMCPServersClient(url, apiKey string) mcpServersClient {
-	client := newClient(url+"/entrypoint/mcp-server", apiKey)
+func newMCPServersClient(url, apiKey string, options ...ClientOption) mcpServersClient {
+	client := newClient(url+"/entrypoint/mcp-server", apiKey, options...)
 	return mcpServersClient{
 		crud: crud{
 			getter{
@@ -148,60 +149,79 @@ func (sc mcpServersClient) Tools(serverId uuid.UUID) toolsClient {
 // queryFlow is the struct for the client that can carry out every QueryFlow operation.
 type queryFlow struct {
 	Url, ApiKey string
+	options     []ClientOption
 }
 
 // Processors creates a queryFlowProcessorsClient with QueryFlow's URL and API Key.
 func (q queryFlow) Processors() queryFlowProcessorsClient {
-	return newQueryFlowProcessorsClient(q.Url, q.ApiKey)
+	return newQueryFlowProcessorsClient(q.Url, q.ApiKey, q.options...)
 }
 
 // Pipelines is used to create a queryFlowPipelinesClient.
 func (q queryFlow) Pipelines() queryFlowPipelinesClient {
-	return newQueryFlowPipelinesClient(q.Url, q.ApiKey)
+	return newQueryFlowPipelinesClient(q.Url, q.ApiKey, q.options...)
 }
 
 // Endpoints creates an endpointsClient with QueryFlow's URL and API Key.
 func (q queryFlow) Endpoints() endpointsClient {
-	return newEndpointsClient(q.Url, q.ApiKey)
+	return newEndpointsClient(q.Url, q.ApiKey, q.options...)
 }
 
 // MCPServers creates a new mcpServersClient with QueryFlow's URL and API Key.
 func (q queryFlow) MCPServers() mcpServersClient {
-	return newMCPServersClient(q.Url, q.ApiKey)
+	return newMCPServersClient(q.Url, q.ApiKey, q.options...)
 }
 
 // BackupRestore creates a backupRestore with QueryFlow's URL and API Key.
 func (q queryFlow) BackupRestore() backupRestore {
 	return backupRestore{
-		client: newClient(q.Url, q.ApiKey),
+		client: newClient(q.Url, q.ApiKey, q.options...),
 	}
 }
 
 // Invoke is a function that calls the API version of an endpoint.
 // It returns the endpoint's response as a gjson.Result or an error if any occurred.
 func (q queryFlow) Invoke(method, uri string, options ...RequestOption) (gjson.Result, error) {
+	client := newClient(q.Url, q.ApiKey, q.options...)
+	return execute(client.requestContext(), client, method, "/api/"+strings.TrimPrefix(uri, "/"), options...)
+}
+
+// InvokeContext is like Invoke, but it uses the given context for its requests instead of the one set with WithContext().
+func (q queryFlow) InvokeContext(ctx context.Context, method, uri string, options ...RequestOption) (gjson.Result, error) {
 	newUri := "/api/" + strings.TrimPrefix(uri, "/")
-	client := newClient(q.Url, q.ApiKey)
-	return execute(client, method, newUri, options...)
+	client := newClient(q.Url, q.ApiKey, q.options...)
+	return execute(ctx, client, method, newUri, options...)
 }
 
 // Debug is a function that calls the Debug version of an endpoint.
 // It returns the endpoint's response as a gjson.Result or an error if one occurred.
 func (q queryFlow) Debug(method, uri string, options ...RequestOption) (gjson.Result, error) {
+	client := newClient(q.Url, q.ApiKey, q.options...)
+	return execute(client.requestContext(), client, method, "/debug/"+strings.TrimPrefix(uri, "/"), options...)
+}
+
+// DebugContext is like Debug, but it uses the given context for its requests instead of the one set with WithContext().
+func (q queryFlow) DebugContext(ctx context.Context, method, uri string, options ...RequestOption) (gjson.Result, error) {
 	newUri := "/debug/" + strings.TrimPrefix(uri, "/")
-	client := newClient(q.Url, q.ApiKey)
-	return execute(client, method, newUri, options...)
+	client := newClient(q.Url, q.ApiKey, q.options...)
+	return execute(ctx, client, method, newUri, options...)
 }
 
 // StatusChecker creates a statusChecker with QueryFlow's URL and API Key.
 func (q queryFlow) StatusChecker() statusChecker {
 	return statusChecker{
-		client: newClient(q.Url[:len(q.Url)-3], q.ApiKey),
+		client: newClient(q.Url[:len(q.Url)-3], q.ApiKey, q.options...),
 	}
 }
 
-// NewQueryFlow is the constructor for the QueryFlow struct.
+// NewQueryFlow creates the client of Discovery QueryFlow, which implements the QueryFlow interface.
 // It adds a /v2 path to the URL in order to properly connect to Discovery.
-func NewQueryFlow(url, apiKey string) queryFlow {
-	return queryFlow{Url: strings.TrimRight(url, "/") + "/v2", ApiKey: apiKey}
+// The client options are applied to every client created by it.
+func NewQueryFlow(url, apiKey string, options ...ClientOption) QueryFlow {
+	return queryFlowSDK{newQueryFlow(url, apiKey, options...)}
+}
+
+// newQueryFlow is the constructor for the queryFlow struct.
+func newQueryFlow(url, apiKey string, options ...ClientOption) queryFlow {
+	return queryFlow{Url: strings.TrimRight(url, "/") + "/v2", ApiKey: apiKey, options: options}
 }
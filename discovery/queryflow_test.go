@@ -1,12 +1,14 @@
 package discovery
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pureinsights/discovery-cli/internal/testutils"
@@ -111,7 +113,7 @@ func Test_mcpServersClient_Tools(t *testing.T) {
 
 // Test_queryFlow_Processors tests the queryFlow.Processors() function.
 func Test_queryFlow_Processors(t *testing.T) {
-	q := NewQueryFlow("http://localhost:12040", "Api Key")
+	q := newQueryFlow("http://localhost:12040", "Api Key")
 	qpc := q.Processors()
 
 	assert.Equal(t, q.ApiKey, qpc.crud.client.ApiKey)
@@ -124,7 +126,7 @@ func Test_queryFlow_Processors(t *testing.T) {
 
 // Test_queryFlow_Pipelines tests the queryFlow.Pipelines() function.
 func Test_queryFlow_Pipelines(t *testing.T) {
-	q := NewQueryFlow("http://localhost:12040", "Api Key")
+	q := newQueryFlow("http://localhost:12040", "Api Key")
 	qpc := q.Pipelines()
 
 	assert.Equal(t, q.ApiKey, qpc.crud.client.ApiKey)
@@ -137,7 +139,7 @@ func Test_queryFlow_Pipelines(t *testing.T) {
 
 // Test_queryFlow_Endpoints tests the queryFlow.Endpoints() function.
 func Test_queryFlow_Endpoints(t *testing.T) {
-	q := NewQueryFlow("http://localhost:12040", "Api Key")
+	q := newQueryFlow("http://localhost:12040", "Api Key")
 	qec := q.Endpoints()
 
 	assert.Equal(t, q.ApiKey, qec.crud.client.ApiKey)
@@ -152,7 +154,7 @@ func Test_queryFlow_Endpoints(t *testing.T) {
 
 // Test_queryFlow_MCPServers tests the queryFlow.MCPServers() function.
 func Test_queryFlow_MCPServers(t *testing.T) {
-	q := NewQueryFlow("http://localhost:12040", "Api Key")
+	q := newQueryFlow("http://localhost:12040", "Api Key")
 	qec := q.MCPServers()
 
 	assert.Equal(t, q.ApiKey, qec.crud.client.ApiKey)
@@ -167,7 +169,7 @@ func Test_queryFlow_MCPServers(t *testing.T) {
 
 // Test_queryFlow_BackupRestore tests the queryFlow.BackupRestore() function.
 func Test_queryFlow_BackupRestore(t *testing.T) {
-	q := NewQueryFlow("http://localhost:12040", "Api Key")
+	q := newQueryFlow("http://localhost:12040", "Api Key")
 	bc := q.BackupRestore()
 
 	assert.Equal(t, q.ApiKey, bc.ApiKey)
@@ -359,7 +361,7 @@ func Test_queryFlow_Invoke(t *testing.T) {
 				}))
 			defer srv.Close()
 
-			q := NewQueryFlow(srv.URL, "")
+			q := newQueryFlow(srv.URL, "")
 
 			requestOptions := []RequestOption{}
 			if tc.body != "" {
@@ -546,7 +548,7 @@ func Test_queryFlow_Debug(t *testing.T) {
 				}))
 			defer srv.Close()
 
-			q := NewQueryFlow(srv.URL, "")
+			q := newQueryFlow(srv.URL, "")
 			requestOptions := []RequestOption{}
 			if tc.body != "" {
 				requestOptions = append(requestOptions, WithJSONBody(tc.body))
@@ -571,7 +573,7 @@ func Test_queryFlow_Debug(t *testing.T) {
 
 // Test_queryFlow_StatusChecker tests the queryFlow.StatusChecker() function.
 func Test_queryFlow_StatusChecker(t *testing.T) {
-	q := NewQueryFlow("http://localhost:12040", "Api Key")
+	q := newQueryFlow("http://localhost:12040", "Api Key")
 	bc := q.StatusChecker()
 
 	assert.Equal(t, q.ApiKey, bc.ApiKey)
@@ -580,8 +582,31 @@ func Test_queryFlow_StatusChecker(t *testing.T) {
 
 // Test_NewQueryFlow tests the QueryFlow constructor.
 func Test_NewQueryFlow(t *testing.T) {
-	i := NewQueryFlow("http://localhost:12040/////", "Api Key")
+	i := newQueryFlow("http://localhost:12040/////", "Api Key")
 
 	assert.Equal(t, "http://localhost:12040/v2", i.Url)
 	assert.Equal(t, "Api Key", i.ApiKey)
 }
+
+// Test_NewQueryFlow_ClientOptions tests that the client options are applied to the clients created by the queryFlow struct.
+func Test_NewQueryFlow_ClientOptions(t *testing.T) {
+	q := newQueryFlow("http://localhost:12040", "Api Key", WithTimeout(5*time.Second))
+	serverId := uuid.MustParse("1d81d3d5-58a2-44a5-9acf-3fc8358afe09")
+
+	assert.Equal(t, 5*time.Second, q.Endpoints().crud.client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, q.MCPServers().Tools(serverId).crud.client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, q.BackupRestore().client.client.GetClient().Timeout)
+}
+
+// Test_queryFlow_Invoke_ContextCanceled tests that the Invoke() function uses the context of the queryFlow struct.
+func Test_queryFlow_Invoke_ContextCanceled(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{"ok":true}`, nil))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	q := newQueryFlow(srv.URL, "Api Key", WithContext(ctx))
+	_, err := q.Invoke(http.MethodGet, "/search")
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,114 @@
+package discovery
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate of the requests sent to Discovery with a token bucket.
+// The bucket holds up to burst tokens and is refilled at the given number of tokens per second.
+// Every attempt of a request takes a token, so the requests wait when the bucket is empty.
+// A RateLimiter is safe to use from several goroutines, so it can be shared by several clients.
+type RateLimiter struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that allows the given number of requests per second,
+// with bursts of up to the given number of requests. The bucket starts full.
+// A burst lower than 1 is changed to 1. If the number of requests per second is not positive, it returns nil, which does not limit the requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if requestsPerSecond <= 0 || math.IsInf(requestsPerSecond, 0) || math.IsNaN(requestsPerSecond) {
+		return nil
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		now:    time.Now,
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a token that was reserved but not used.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a request can be sent or the context is done.
+// A nil RateLimiter does not block.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// WithRateLimit limits the requests executed by the client and its sub-clients to the given number of requests per second,
+// with bursts of up to the given number of requests.
+// The limiter is created once, so every client created with the same option shares it.
+// If the number of requests per second is not positive, the requests are not limited.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return WithRateLimiter(NewRateLimiter(requestsPerSecond, burst))
+}
+
+// WithRateLimiter sets the RateLimiter that limits the requests executed by the client and its sub-clients.
+// The same limiter can be given to the clients of several Discovery products to limit their requests together.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *client) {
+		c.limiter = limiter
+	}
+}
@@ -0,0 +1,109 @@
+package discovery
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewRateLimiter tests the NewRateLimiter() function.
+func TestNewRateLimiter(t *testing.T) {
+	assert.Nil(t, NewRateLimiter(0, 5))
+	assert.Nil(t, NewRateLimiter(-1, 5))
+	assert.Nil(t, NewRateLimiter(math.Inf(1), 5))
+
+	limiter := NewRateLimiter(10, 0)
+	require.NotNil(t, limiter)
+	assert.Equal(t, float64(1), limiter.burst)
+	assert.Equal(t, float64(1), limiter.tokens)
+}
+
+// TestRateLimiter_reserve tests that the bucket allows bursts and is refilled at the limiter's rate.
+func TestRateLimiter_reserve(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(2, 3)
+	limiter.now = func() time.Time { return now }
+
+	for range 3 {
+		assert.Zero(t, limiter.reserve())
+	}
+	assert.Equal(t, 500*time.Millisecond, limiter.reserve())
+	assert.Equal(t, time.Second, limiter.reserve())
+
+	now = now.Add(10 * time.Second)
+	for range 3 {
+		assert.Zero(t, limiter.reserve())
+	}
+	assert.Equal(t, 500*time.Millisecond, limiter.reserve())
+}
+
+// TestRateLimiter_Wait tests that Wait blocks until a token is available and returns the context's error when it is canceled.
+func TestRateLimiter_Wait(t *testing.T) {
+	var limiter *RateLimiter
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	limiter = NewRateLimiter(20, 1)
+	start := time.Now()
+	for range 3 {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	limiter = NewRateLimiter(0.001, 1)
+	require.NoError(t, limiter.Wait(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+	assert.InDelta(t, 0, limiter.tokens, 0.01)
+}
+
+// TestWithRateLimit tests that the sub-clients of a product client share its rate limiter.
+func TestWithRateLimit(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{}`, func(t *testing.T, r *http.Request) {
+		calls.Add(1)
+	}))
+	t.Cleanup(srv.Close)
+
+	core := newCore(srv.URL, "apiKey", WithRateLimit(20, 2))
+	start := time.Now()
+	_, err := core.Servers().Get(uuid.Nil)
+	require.NoError(t, err)
+	_, err = core.Credentials().Get(uuid.Nil)
+	require.NoError(t, err)
+	_, err = core.Labels().Get(uuid.Nil)
+	require.NoError(t, err)
+	_, err = core.Servers().Get(uuid.Nil)
+	require.NoError(t, err)
+
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+// TestWithRateLimiter_ContextCanceled tests that the request is not sent when its context is canceled while it waits for the rate limiter.
+func TestWithRateLimiter_ContextCanceled(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	t.Cleanup(srv.Close)
+
+	limiter := NewRateLimiter(0.001, 1)
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	c := newClient(srv.URL, "", WithContext(ctx), WithRateLimiter(limiter))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Zero(t, calls.Load())
+}
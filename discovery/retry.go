@@ -0,0 +1,224 @@
+package discovery
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/tidwall/gjson"
+)
+
+// RetryPolicy configures how the requests that fail with a transient error are retried.
+// A request is retried when the server responds with a 429 or 5xx status code or when the connection fails, like when it is reset.
+// By default, only the requests with idempotent HTTP methods are retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried. Zero disables the retries.
+	MaxRetries int
+	// WaitTime is the base wait time before the first retry. It doubles with each retry.
+	WaitTime time.Duration
+	// MaxWaitTime is the maximum wait time between retries, even if the server asks for a longer wait with the Retry-After header.
+	MaxWaitTime time.Duration
+	// NonIdempotent enables the retries of the requests with non-idempotent HTTP methods, like POST and PATCH.
+	NonIdempotent bool
+	// OnRetry is called before waiting to retry a request. It receives the number of the retry, the wait time and the error that caused it.
+	OnRetry func(method, url string, retry int, wait time.Duration, cause error)
+}
+
+// Default values of the retry policy.
+const (
+	DefaultMaxRetries  = 3
+	DefaultWaitTime    = 500 * time.Millisecond
+	DefaultMaxWaitTime = 30 * time.Second
+)
+
+// DefaultRetryPolicy returns a retry policy with the default number of retries and wait times.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:  DefaultMaxRetries,
+		WaitTime:    DefaultWaitTime,
+		MaxWaitTime: DefaultMaxWaitTime,
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry the requests executed by the client that fail with a transient error.
+// The clients use DefaultRetryPolicy() when this option is not given, and an empty RetryPolicy disables the retries.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *client) {
+		c.retry = policy
+	}
+}
+
+// idempotentMethods are the HTTP methods that can be retried by default.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+// shouldRetry checks if the request should be retried, based on the number of retries already done, its method, response and error.
+func (p RetryPolicy) shouldRetry(method string, retries int, response *resty.Response, err error) bool {
+	if retries >= p.MaxRetries {
+		return false
+	}
+
+	if !p.NonIdempotent && !idempotentMethods[method] {
+		return false
+	}
+
+	if err != nil {
+		return isTransientError(err)
+	}
+
+	if response == nil {
+		return false
+	}
+
+	status := response.StatusCode()
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// isTransientError checks if the error was caused by a failed connection, like a reset or refused connection, an unexpected EOF or a network timeout.
+// Canceled requests, exceeded deadlines, invalid certificates and hosts that are not found are not transient.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCertErr x509.CertificateInvalidError
+	if errors.As(err, &certErr) || errors.As(err, &unknownAuthorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &invalidCertErr) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// backoff calculates how long to wait before the given retry.
+// If the response has a Retry-After header, its value is used. Otherwise, the wait time grows exponentially with a random jitter.
+// The wait time never exceeds the policy's maximum wait time.
+func (p RetryPolicy) backoff(retry int, response *resty.Response) time.Duration {
+	wait := time.Duration(0)
+	if response != nil {
+		wait = retryAfter(response.Header().Get("Retry-After"))
+	}
+
+	if wait <= 0 {
+		wait = p.WaitTime
+		for i := 1; i < retry && (p.MaxWaitTime <= 0 || wait < p.MaxWaitTime); i++ {
+			wait *= 2
+		}
+		if half := int64(wait / 2); half > 0 {
+			wait = time.Duration(half + rand.Int64N(half+1))
+		}
+	}
+
+	if p.MaxWaitTime > 0 && wait > p.MaxWaitTime {
+		wait = p.MaxWaitTime
+	}
+
+	return wait
+}
+
+// retryAfter parses the value of a Retry-After header, which can be a number of seconds or an HTTP date.
+// It returns zero if the header is empty or invalid.
+func retryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+// send executes the request and retries it according to the client's retry policy.
+// Before every attempt, the client's rate limiter, if it has one, waits until the request can be sent
+// and the client's authenticator, if it has one, adds the credentials to the request.
+// Every attempt and retry is written by the client's tracer, if it has one.
+// The wait between retries is interrupted if the request's context is canceled.
+// The bodies of the responses that are retried are closed, even if they were not read by Resty.
+func (c client) send(request *resty.Request, method, url string) (*resty.Response, error) {
+	for retry := 0; ; retry++ {
+		if err := c.limiter.Wait(request.Context()); err != nil {
+			return nil, err
+		}
+
+		if c.auth != nil {
+			if err := c.auth.Authenticate(request.Context(), request.Header); err != nil {
+				return nil, err
+			}
+		}
+
+		start := time.Now()
+		response, err := request.Execute(method, url)
+		if c.tracer != nil {
+			c.tracer.exchange(request, method, url, response, err, time.Since(start))
+		}
+
+		if !c.retry.shouldRetry(method, retry, response, err) {
+			return response, err
+		}
+
+		wait := c.retry.backoff(retry+1, response)
+		closeRawBody(response)
+		if c.retry.OnRetry != nil || c.tracer != nil {
+			cause := err
+			if cause == nil {
+				cause = NewError(response.StatusCode(), gjson.ParseBytes(response.Body()))
+			}
+			if c.tracer != nil {
+				c.tracer.retry(method, url, retry+1, wait, cause)
+			}
+			if c.retry.OnRetry != nil {
+				c.retry.OnRetry(method, url, retry+1, wait, cause)
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-request.Context().Done():
+			timer.Stop()
+			return response, request.Context().Err()
+		}
+	}
+}
+
+// closeRawBody closes the body of a response that was not read by Resty, like the one of an export, so that its connection is released.
+func closeRawBody(response *resty.Response) {
+	if response != nil && response.RawResponse != nil && response.RawResponse.Body != nil {
+		_ = response.RawResponse.Body.Close()
+	}
+}
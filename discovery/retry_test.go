@@ -0,0 +1,435 @@
+package discovery
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRetryPolicy returns a retry policy with short wait times to use in the tests.
+func testRetryPolicy(maxRetries int) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:  maxRetries,
+		WaitTime:    time.Millisecond,
+		MaxWaitTime: 10 * time.Millisecond,
+	}
+}
+
+// TestDefaultRetryPolicy tests the DefaultRetryPolicy() function.
+func TestDefaultRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	assert.Equal(t, DefaultMaxRetries, policy.MaxRetries)
+	assert.Equal(t, DefaultWaitTime, policy.WaitTime)
+	assert.Equal(t, DefaultMaxWaitTime, policy.MaxWaitTime)
+	assert.False(t, policy.NonIdempotent)
+}
+
+// Test_client_execute_Retries tests that execute() retries the requests that fail with a transient error.
+func Test_client_execute_Retries(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		policy        RetryPolicy
+		failures      int
+		failureStatus int
+		expectedCalls int32
+		err           error
+	}{
+		// Working cases
+		{
+			name:          "GET succeeds after two 503 responses",
+			method:        http.MethodGet,
+			policy:        testRetryPolicy(3),
+			failures:      2,
+			failureStatus: http.StatusServiceUnavailable,
+			expectedCalls: 3,
+			err:           nil,
+		},
+		{
+			name:          "PUT succeeds after a 429 response",
+			method:        http.MethodPut,
+			policy:        testRetryPolicy(3),
+			failures:      1,
+			failureStatus: http.StatusTooManyRequests,
+			expectedCalls: 2,
+			err:           nil,
+		},
+		{
+			name:          "DELETE succeeds after a 500 response",
+			method:        http.MethodDelete,
+			policy:        testRetryPolicy(1),
+			failures:      1,
+			failureStatus: http.StatusInternalServerError,
+			expectedCalls: 2,
+			err:           nil,
+		},
+		{
+			name:   "POST succeeds when non-idempotent retries are enabled",
+			method: http.MethodPost,
+			policy: RetryPolicy{
+				MaxRetries:    2,
+				WaitTime:      time.Millisecond,
+				MaxWaitTime:   10 * time.Millisecond,
+				NonIdempotent: true,
+			},
+			failures:      2,
+			failureStatus: http.StatusBadGateway,
+			expectedCalls: 3,
+			err:           nil,
+		},
+
+		// Error cases
+		{
+			name:          "GET fails after exhausting the retries",
+			method:        http.MethodGet,
+			policy:        testRetryPolicy(2),
+			failures:      5,
+			failureStatus: http.StatusServiceUnavailable,
+			expectedCalls: 3,
+			err:           Error{Status: http.StatusServiceUnavailable},
+		},
+		{
+			name:          "POST is not retried by default",
+			method:        http.MethodPost,
+			policy:        testRetryPolicy(3),
+			failures:      1,
+			failureStatus: http.StatusServiceUnavailable,
+			expectedCalls: 1,
+			err:           Error{Status: http.StatusServiceUnavailable},
+		},
+		{
+			name:          "A 404 response is not retried",
+			method:        http.MethodGet,
+			policy:        testRetryPolicy(3),
+			failures:      1,
+			failureStatus: http.StatusNotFound,
+			expectedCalls: 1,
+			err:           Error{Status: http.StatusNotFound},
+		},
+		{
+			name:          "The requests are not retried without a retry policy",
+			method:        http.MethodGet,
+			policy:        RetryPolicy{},
+			failures:      1,
+			failureStatus: http.StatusServiceUnavailable,
+			expectedCalls: 1,
+			err:           Error{Status: http.StatusServiceUnavailable},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := &atomic.Int32{}
+			srv := httptest.NewServer(testutils.HttpFailingHandler(t, tc.failures,
+				testutils.MockResponse{
+					StatusCode:  tc.failureStatus,
+					ContentType: "application/json",
+					Body:        `{"status":503,"messages":["Service unavailable"]}`,
+				},
+				testutils.MockResponse{
+					StatusCode:  http.StatusOK,
+					ContentType: "application/json",
+					Body:        `{"ok":true}`,
+					Assertions: func(t *testing.T, r *http.Request) {
+						assert.Equal(t, tc.method, r.Method)
+						assert.Equal(t, "/seed", r.URL.Path)
+					},
+				},
+				calls,
+			))
+			t.Cleanup(srv.Close)
+
+			c := newClient(srv.URL, "apiKey", WithRetryPolicy(tc.policy))
+			res, err := c.execute(c.requestContext(), tc.method, "/seed")
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.Equal(t, tc.err.(Error).Status, errStruct.Status)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, `{"ok":true}`, string(res))
+			}
+			assert.Equal(t, tc.expectedCalls, calls.Load())
+		})
+	}
+}
+
+// Test_client_execute_OnRetry tests that the OnRetry hook is called before each retry.
+func Test_client_execute_OnRetry(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(testutils.HttpFailingHandler(t, 2,
+		testutils.MockResponse{StatusCode: http.StatusServiceUnavailable, ContentType: "application/json", Body: `{"status":503}`},
+		testutils.MockResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: `{"ok":true}`},
+		calls,
+	))
+	t.Cleanup(srv.Close)
+
+	var retries []int
+	policy := testRetryPolicy(3)
+	policy.OnRetry = func(method, url string, retry int, wait time.Duration, cause error) {
+		assert.Equal(t, http.MethodGet, method)
+		assert.Equal(t, srv.URL+"/seed", url)
+		assert.LessOrEqual(t, wait, policy.MaxWaitTime)
+		var errStruct Error
+		require.ErrorAs(t, cause, &errStruct)
+		assert.Equal(t, http.StatusServiceUnavailable, errStruct.Status)
+		retries = append(retries, retry)
+	}
+
+	c := newClient(srv.URL, "apiKey", WithRetryPolicy(policy))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, retries)
+}
+
+// Test_client_execute_RetryConnectionError tests that the requests are retried when the connection fails.
+func Test_client_execute_RetryConnectionError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	var retries int
+	policy := testRetryPolicy(2)
+	policy.OnRetry = func(method, url string, retry int, wait time.Duration, cause error) {
+		retries = retry
+	}
+
+	c := newClient(url, "apiKey", WithRetryPolicy(policy))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	require.Error(t, err)
+	assert.Equal(t, 2, retries)
+}
+
+// Test_client_execute_NoRetryCertificateError tests that the requests are not retried when the certificate of the server is not trusted.
+func Test_client_execute_NoRetryCertificateError(t *testing.T) {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(srv.Close)
+
+	var retries int
+	policy := testRetryPolicy(2)
+	policy.OnRetry = func(method, url string, retry int, wait time.Duration, cause error) {
+		retries = retry
+	}
+
+	c := newClient(srv.URL, "apiKey", WithRetryPolicy(policy))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	require.Error(t, err)
+	assert.Equal(t, 0, retries)
+}
+
+// Test_client_execute_RetryContextCanceled tests that the wait between retries stops when the context is canceled.
+func Test_client_execute_RetryContextCanceled(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(testutils.HttpFailingHandler(t, 5,
+		testutils.MockResponse{StatusCode: http.StatusServiceUnavailable, ContentType: "application/json", Body: `{"status":503}`},
+		testutils.MockResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: `{"ok":true}`},
+		calls,
+	))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := RetryPolicy{MaxRetries: 3, WaitTime: time.Minute, MaxWaitTime: time.Minute}
+	policy.OnRetry = func(method, url string, retry int, wait time.Duration, cause error) {
+		cancel()
+	}
+
+	c := newClient(srv.URL, "apiKey", WithContext(ctx), WithRetryPolicy(policy))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// Test_backupRestore_Export_Retries tests that the export request is retried.
+func Test_backupRestore_Export_Retries(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(testutils.HttpFailingHandler(t, 1,
+		testutils.MockResponse{StatusCode: http.StatusBadGateway, ContentType: "application/json", Body: `{"status":502}`},
+		testutils.MockResponse{StatusCode: http.StatusOK, ContentType: "application/octet-stream", Body: "zip"},
+		calls,
+	))
+	t.Cleanup(srv.Close)
+
+	backup := backupRestore{client: newClient(srv.URL, "apiKey", WithRetryPolicy(testRetryPolicy(1)))}
+	export := &bytes.Buffer{}
+	_, err := backup.Export(export)
+	require.NoError(t, err)
+	assert.Equal(t, "zip", export.String())
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// TestRetryPolicy_backoff tests the backoff() function.
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, WaitTime: 100 * time.Millisecond, MaxWaitTime: time.Second}
+
+	tests := []struct {
+		name       string
+		retry      int
+		retryAfter string
+		min        time.Duration
+		max        time.Duration
+	}{
+		{
+			name:  "First retry",
+			retry: 1,
+			min:   50 * time.Millisecond,
+			max:   100 * time.Millisecond,
+		},
+		{
+			name:  "Third retry",
+			retry: 3,
+			min:   200 * time.Millisecond,
+			max:   400 * time.Millisecond,
+		},
+		{
+			name:  "The wait time is capped",
+			retry: 10,
+			min:   500 * time.Millisecond,
+			max:   time.Second,
+		},
+		{
+			name:       "The Retry-After header in seconds is honored",
+			retry:      1,
+			retryAfter: "1",
+			min:        time.Second,
+			max:        time.Second,
+		},
+		{
+			name:       "The Retry-After header is capped",
+			retry:      1,
+			retryAfter: "120",
+			min:        time.Second,
+			max:        time.Second,
+		},
+		{
+			name:       "An invalid Retry-After header is ignored",
+			retry:      1,
+			retryAfter: "soon",
+			min:        50 * time.Millisecond,
+			max:        100 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response := &resty.Response{RawResponse: &http.Response{Header: http.Header{}}}
+			if tc.retryAfter != "" {
+				response.RawResponse.Header.Set("Retry-After", tc.retryAfter)
+			}
+
+			wait := policy.backoff(tc.retry, response)
+			assert.GreaterOrEqual(t, wait, tc.min)
+			assert.LessOrEqual(t, wait, tc.max)
+		})
+	}
+}
+
+// Test_retryAfter tests the retryAfter() function.
+func Test_retryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), retryAfter(""))
+	assert.Equal(t, 3*time.Second, retryAfter("3"))
+	assert.Equal(t, time.Duration(0), retryAfter("later"))
+
+	wait := retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.Greater(t, wait, 50*time.Second)
+	assert.LessOrEqual(t, wait, time.Minute)
+}
+
+// Test_isTransientError tests the isTransientError() function.
+func Test_isTransientError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "Connection reset", err: syscall.ECONNRESET, expected: true},
+		{name: "Connection refused", err: &url.Error{Op: "Get", URL: "http://localhost", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, expected: true},
+		{name: "EOF", err: &url.Error{Op: "Get", URL: "http://localhost", Err: io.EOF}, expected: true},
+		{name: "Unexpected EOF", err: io.ErrUnexpectedEOF, expected: true},
+		{name: "Network timeout", err: &url.Error{Op: "Get", URL: "http://localhost", Err: &net.OpError{Op: "read", Err: os.ErrDeadlineExceeded}}, expected: true},
+		{name: "Context canceled", err: context.Canceled, expected: false},
+		{name: "Context deadline exceeded", err: context.DeadlineExceeded, expected: false},
+		{name: "Unknown certificate authority", err: &url.Error{Op: "Get", URL: "https://localhost", Err: x509.UnknownAuthorityError{}}, expected: false},
+		{name: "Certificate verification", err: &url.Error{Op: "Get", URL: "https://localhost", Err: &tls.CertificateVerificationError{Err: x509.HostnameError{Host: "localhost"}}}, expected: false},
+		{name: "Host not found", err: &url.Error{Op: "Get", URL: "http://unknown", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "unknown", IsNotFound: true}}}, expected: false},
+		{name: "Other URL error", err: &url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("unsupported protocol scheme")}, expected: false},
+		{name: "Other error", err: errors.New("invalid request"), expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isTransientError(tc.err))
+		})
+	}
+}
+
+// Test_newClient_DefaultRetryPolicy tests that the clients created without a retry policy retry the transient errors with the default policy.
+func Test_newClient_DefaultRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(t *testing.T, calls *atomic.Int32) http.HandlerFunc
+	}{
+		// Working cases
+		{
+			name: "GET succeeds after a 503 response",
+			handler: func(t *testing.T, calls *atomic.Int32) http.HandlerFunc {
+				return testutils.HttpFailingHandler(t, 1,
+					testutils.MockResponse{StatusCode: http.StatusServiceUnavailable, ContentType: "application/json", Body: `{"status":503}`},
+					testutils.MockResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: `{"ok":true}`},
+					calls,
+				)
+			},
+		},
+		{
+			name: "GET succeeds after the connection is reset",
+			handler: func(t *testing.T, calls *atomic.Int32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if calls.Add(1) == 1 {
+						conn, _, err := w.(http.Hijacker).Hijack()
+						require.NoError(t, err)
+						require.NoError(t, conn.(*net.TCPConn).SetLinger(0))
+						conn.Close()
+						return
+					}
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`{"ok":true}`))
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := &atomic.Int32{}
+			srv := httptest.NewServer(tc.handler(t, calls))
+			t.Cleanup(srv.Close)
+
+			c := newClient(srv.URL, "apiKey")
+			assert.Equal(t, DefaultRetryPolicy(), c.retry)
+
+			response, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+			require.NoError(t, err)
+			assert.Equal(t, `{"ok":true}`, string(response))
+			assert.Equal(t, int32(2), calls.Load())
+		})
+	}
+}
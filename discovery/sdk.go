@@ -0,0 +1,647 @@
+package discovery
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+)
+
+// Getter obtains the entities of an endpoint.
+type Getter interface {
+	Get(id uuid.UUID) (gjson.Result, error)
+	GetContext(ctx context.Context, id uuid.UUID) (gjson.Result, error)
+	GetAll(options ...RequestOption) ([]gjson.Result, error)
+	GetAllContext(ctx context.Context, options ...RequestOption) ([]gjson.Result, error)
+	GetAllSeq(options ...RequestOption) iter.Seq2[gjson.Result, error]
+	GetAllSeqContext(ctx context.Context, options ...RequestOption) iter.Seq2[gjson.Result, error]
+}
+
+// CRUD creates, reads, updates and deletes the entities of an endpoint.
+type CRUD interface {
+	Getter
+	Create(config gjson.Result) (gjson.Result, error)
+	CreateContext(ctx context.Context, config gjson.Result) (gjson.Result, error)
+	Update(id uuid.UUID, config gjson.Result) (gjson.Result, error)
+	UpdateContext(ctx context.Context, id uuid.UUID, config gjson.Result) (gjson.Result, error)
+	Delete(id uuid.UUID) (gjson.Result, error)
+	DeleteContext(ctx context.Context, id uuid.UUID) (gjson.Result, error)
+}
+
+// Cloner clones the entities of an endpoint.
+type Cloner interface {
+	Clone(id uuid.UUID, params map[string][]string) (gjson.Result, error)
+	CloneContext(ctx context.Context, id uuid.UUID, params map[string][]string) (gjson.Result, error)
+}
+
+// Searcher searches the entities of an endpoint with filters or by their name.
+type Searcher interface {
+	Search(filter gjson.Result, options ...RequestOption) ([]gjson.Result, error)
+	SearchContext(ctx context.Context, filter gjson.Result, options ...RequestOption) ([]gjson.Result, error)
+	SearchSeq(filter gjson.Result, options ...RequestOption) iter.Seq2[gjson.Result, error]
+	SearchSeqContext(ctx context.Context, filter gjson.Result, options ...RequestOption) iter.Seq2[gjson.Result, error]
+	SearchByName(name string) (gjson.Result, error)
+	SearchByNameContext(ctx context.Context, name string) (gjson.Result, error)
+}
+
+// Enabler enables and disables the entities of an endpoint.
+type Enabler interface {
+	Enable(id uuid.UUID) (gjson.Result, error)
+	EnableContext(ctx context.Context, id uuid.UUID) (gjson.Result, error)
+	Disable(id uuid.UUID) (gjson.Result, error)
+	DisableContext(ctx context.Context, id uuid.UUID) (gjson.Result, error)
+}
+
+// Summarizer obtains the summary of an endpoint.
+type Summarizer interface {
+	Summarize() (gjson.Result, error)
+	SummarizeContext(ctx context.Context) (gjson.Result, error)
+}
+
+// BackupRestorer exports and imports the entities of a Discovery product.
+type BackupRestorer interface {
+	Export(w io.Writer) (string, error)
+	ExportContext(ctx context.Context, w io.Writer) (string, error)
+	Import(onConflict OnConflict, file string) (gjson.Result, error)
+	ImportContext(ctx context.Context, onConflict OnConflict, file string) (gjson.Result, error)
+}
+
+// StatusChecker checks the health of a Discovery product.
+type StatusChecker interface {
+	StatusCheck() (gjson.Result, error)
+	StatusCheckContext(ctx context.Context) (gjson.Result, error)
+}
+
+// LabelsClient manages the labels of Discovery Core.
+// The labels are searched on the client, as their endpoint cannot search them.
+type LabelsClient interface {
+	CRUD
+	Searcher
+}
+
+// SecretsClient manages the secrets of Discovery Core.
+// The secrets are searched on the client, as their endpoint cannot search them.
+type SecretsClient interface {
+	CRUD
+	Searcher
+}
+
+// CredentialsClient manages the credentials of Discovery Core.
+type CredentialsClient interface {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// ServersClient manages the servers of Discovery Core.
+type ServersClient interface {
+	CRUD
+	Cloner
+	Searcher
+	Ping(id uuid.UUID) (gjson.Result, error)
+	PingContext(ctx context.Context, id uuid.UUID) (gjson.Result, error)
+}
+
+// FilesClient manages the files of Discovery Core.
+type FilesClient interface {
+	Upload(key, file string) (gjson.Result, error)
+	UploadContext(ctx context.Context, key, file string) (gjson.Result, error)
+	Retrieve(key string) ([]byte, error)
+	RetrieveContext(ctx context.Context, key string) ([]byte, error)
+	List() ([]gjson.Result, error)
+	ListContext(ctx context.Context) ([]gjson.Result, error)
+	Delete(key string) (gjson.Result, error)
+	DeleteContext(ctx context.Context, key string) (gjson.Result, error)
+}
+
+// MaintenanceClient carries out the maintenance operations of Discovery Core.
+type MaintenanceClient interface {
+	Log(componentName string, level LogLevel, loggerName string) (gjson.Result, error)
+	LogContext(ctx context.Context, componentName string, level LogLevel, loggerName string) (gjson.Result, error)
+}
+
+// Core is the client of Discovery Core.
+type Core interface {
+	Servers() ServersClient
+	Credentials() CredentialsClient
+	Secrets() SecretsClient
+	Labels() LabelsClient
+	Files() FilesClient
+	Maintenance() MaintenanceClient
+	BackupRestore() BackupRestorer
+	StatusChecker() StatusChecker
+}
+
+// IngestionProcessorsClient manages the processors of Discovery Ingestion.
+type IngestionProcessorsClient interface {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// IngestionPipelinesClient manages the pipelines of Discovery Ingestion.
+type IngestionPipelinesClient interface {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// SeedRecordsClient obtains the records of a seed.
+type SeedRecordsClient interface {
+	Summarizer
+	Get(id string) (gjson.Result, error)
+	GetContext(ctx context.Context, id string) (gjson.Result, error)
+	GetAll(options ...RequestOption) ([]gjson.Result, error)
+	GetAllContext(ctx context.Context, options ...RequestOption) ([]gjson.Result, error)
+	GetAllSeq(options ...RequestOption) iter.Seq2[gjson.Result, error]
+	GetAllSeqContext(ctx context.Context, options ...RequestOption) iter.Seq2[gjson.Result, error]
+}
+
+// SeedExecutionRecordsClient obtains the summary of the records of a seed execution.
+type SeedExecutionRecordsClient interface {
+	Summarizer
+}
+
+// SeedExecutionJobsClient obtains the summary of the jobs of a seed execution.
+type SeedExecutionJobsClient interface {
+	Summarizer
+}
+
+// SeedExecutionsClient manages the executions of a seed.
+type SeedExecutionsClient interface {
+	Getter
+	GetLast5Executions() (gjson.Result, error)
+	GetLast5ExecutionsContext(ctx context.Context) (gjson.Result, error)
+	Halt(executionId uuid.UUID) (gjson.Result, error)
+	HaltContext(ctx context.Context, executionId uuid.UUID) (gjson.Result, error)
+	Audit(executionId uuid.UUID) ([]gjson.Result, error)
+	AuditContext(ctx context.Context, executionId uuid.UUID) ([]gjson.Result, error)
+	AuditSeq(executionId uuid.UUID) iter.Seq2[gjson.Result, error]
+	AuditSeqContext(ctx context.Context, executionId uuid.UUID) iter.Seq2[gjson.Result, error]
+	Seed(executionId uuid.UUID) (gjson.Result, error)
+	SeedContext(ctx context.Context, executionId uuid.UUID) (gjson.Result, error)
+	Pipeline(executionId uuid.UUID, pipelineId uuid.UUID) (gjson.Result, error)
+	PipelineContext(ctx context.Context, executionId uuid.UUID, pipelineId uuid.UUID) (gjson.Result, error)
+	Processor(executionId uuid.UUID, processorId uuid.UUID) (gjson.Result, error)
+	ProcessorContext(ctx context.Context, executionId uuid.UUID, processorId uuid.UUID) (gjson.Result, error)
+	Server(executionId uuid.UUID, serverId uuid.UUID) (gjson.Result, error)
+	ServerContext(ctx context.Context, executionId uuid.UUID, serverId uuid.UUID) (gjson.Result, error)
+	Credential(executionId uuid.UUID, credentialId uuid.UUID) (gjson.Result, error)
+	CredentialContext(ctx context.Context, executionId uuid.UUID, credentialId uuid.UUID) (gjson.Result, error)
+	Records(executionId uuid.UUID) SeedExecutionRecordsClient
+	Jobs(executionId uuid.UUID) SeedExecutionJobsClient
+}
+
+// SeedsClient manages the seeds of Discovery Ingestion.
+type SeedsClient interface {
+	CRUD
+	Cloner
+	Searcher
+	Start(id uuid.UUID, scan ScanType, executionProperties gjson.Result) (gjson.Result, error)
+	StartContext(ctx context.Context, id uuid.UUID, scan ScanType, executionProperties gjson.Result) (gjson.Result, error)
+	Halt(id uuid.UUID) ([]gjson.Result, error)
+	HaltContext(ctx context.Context, id uuid.UUID) ([]gjson.Result, error)
+	Reset(id uuid.UUID) (gjson.Result, error)
+	ResetContext(ctx context.Context, id uuid.UUID) (gjson.Result, error)
+	Records(seedId uuid.UUID) SeedRecordsClient
+	Executions(seedId uuid.UUID) SeedExecutionsClient
+}
+
+// SeedSchedulesClient manages the seed schedules of Discovery Ingestion.
+type SeedSchedulesClient interface {
+	CRUD
+	Cloner
+	Searcher
+	Enabler
+}
+
+// Ingestion is the client of Discovery Ingestion.
+type Ingestion interface {
+	Processors() IngestionProcessorsClient
+	Pipelines() IngestionPipelinesClient
+	Seeds() SeedsClient
+	SeedSchedules() SeedSchedulesClient
+	BackupRestore() BackupRestorer
+	StatusChecker() StatusChecker
+}
+
+// QueryFlowProcessorsClient manages the processors of Discovery QueryFlow.
+type QueryFlowProcessorsClient interface {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// QueryFlowPipelinesClient manages the pipelines of Discovery QueryFlow.
+type QueryFlowPipelinesClient interface {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// EndpointsClient manages the endpoints of Discovery QueryFlow.
+type EndpointsClient interface {
+	CRUD
+	Cloner
+	Enabler
+	Searcher
+}
+
+// ToolsClient manages the tools of an MCP server.
+type ToolsClient interface {
+	CRUD
+	Cloner
+	Searcher
+}
+
+// MCPServersClient manages the MCP servers of Discovery QueryFlow.
+type MCPServersClient interface {
+	CRUD
+	Cloner
+	Enabler
+	Searcher
+	Tools(serverId uuid.UUID) ToolsClient
+}
+
+// QueryFlow is the client of Discovery QueryFlow.
+type QueryFlow interface {
+	Processors() QueryFlowProcessorsClient
+	Pipelines() QueryFlowPipelinesClient
+	Endpoints() EndpointsClient
+	MCPServers() MCPServersClient
+	BackupRestore() BackupRestorer
+	Invoke(method, uri string, options ...RequestOption) (gjson.Result, error)
+	InvokeContext(ctx context.Context, method, uri string, options ...RequestOption) (gjson.Result, error)
+	Debug(method, uri string, options ...RequestOption) (gjson.Result, error)
+	DebugContext(ctx context.Context, method, uri string, options ...RequestOption) (gjson.Result, error)
+	StatusChecker() StatusChecker
+}
+
+// BucketsClient manages the buckets of Discovery Staging.
+type BucketsClient interface {
+	CRUD
+	Searcher
+	Purge(bucket uuid.UUID) (gjson.Result, error)
+	PurgeContext(ctx context.Context, bucket uuid.UUID) (gjson.Result, error)
+	CreateIndex(id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error)
+	CreateIndexContext(ctx context.Context, id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error)
+	DeleteIndex(id uuid.UUID, index string) (gjson.Result, error)
+	DeleteIndexContext(ctx context.Context, id uuid.UUID, index string) (gjson.Result, error)
+}
+
+// ContentClient manages the content of a bucket of Discovery Staging.
+type ContentClient interface {
+	Store(contentId, parentId string, content gjson.Result) (gjson.Result, error)
+	StoreContext(ctx context.Context, contentId, parentId string, content gjson.Result) (gjson.Result, error)
+	Get(contentId string, options ...ContentGetOption) (gjson.Result, error)
+	GetContext(ctx context.Context, contentId string, options ...ContentGetOption) (gjson.Result, error)
+	Scroll(filters, projections gjson.Result, size *int) ([]gjson.Result, error)
+	ScrollContext(ctx context.Context, filters, projections gjson.Result, size *int) ([]gjson.Result, error)
+	ScrollSeq(filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error]
+	ScrollSeqContext(ctx context.Context, filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error]
+	Delete(contentId string) (gjson.Result, error)
+	DeleteContext(ctx context.Context, contentId string) (gjson.Result, error)
+	DeleteMany(parentId string, filter gjson.Result) (gjson.Result, error)
+	DeleteManyContext(ctx context.Context, parentId string, filter gjson.Result) (gjson.Result, error)
+}
+
+// Staging is the client of Discovery Staging.
+type Staging interface {
+	Buckets() BucketsClient
+	Content(bucket string) ContentClient
+	StatusChecker() StatusChecker
+}
+
+// The following assertions check that the clients implement the interfaces of the SDK.
+var (
+	_ LabelsClient               = labelsClient{}
+	_ SecretsClient              = secretsClient{}
+	_ CredentialsClient          = credentialsClient{}
+	_ ServersClient              = serversClient{}
+	_ FilesClient                = filesClient{}
+	_ MaintenanceClient          = maintenanceClient{}
+	_ BackupRestorer             = backupRestore{}
+	_ StatusChecker              = statusChecker{}
+	_ IngestionProcessorsClient  = ingestionProcessorsClient{}
+	_ IngestionPipelinesClient   = ingestionPipelinesClient{}
+	_ SeedRecordsClient          = seedRecordsClient{}
+	_ SeedExecutionRecordsClient = seedExecutionRecordsClient{}
+	_ SeedExecutionJobsClient    = seedExecutionJobsClient{}
+	_ SeedSchedulesClient        = seedSchedulesClient{}
+	_ QueryFlowProcessorsClient  = queryFlowProcessorsClient{}
+	_ QueryFlowPipelinesClient   = queryFlowPipelinesClient{}
+	_ EndpointsClient            = endpointsClient{}
+	_ ToolsClient                = toolsClient{}
+	_ BucketsClient              = bucketsClient{}
+	_ ContentClient              = contentClient{}
+	_ Core                       = coreSDK{}
+	_ Ingestion                  = ingestionSDK{}
+	_ QueryFlow                  = queryFlowSDK{}
+	_ Staging                    = stagingSDK{}
+)
+
+// coreSDK adapts the core struct to the Core interface.
+type coreSDK struct {
+	core
+}
+
+// Servers returns the ServersClient of Discovery Core.
+func (c coreSDK) Servers() ServersClient {
+	return c.core.Servers()
+}
+
+// Credentials returns the CredentialsClient of Discovery Core.
+func (c coreSDK) Credentials() CredentialsClient {
+	return c.core.Credentials()
+}
+
+// Secrets returns the SecretsClient of Discovery Core.
+func (c coreSDK) Secrets() SecretsClient {
+	return c.core.Secrets()
+}
+
+// Labels returns the LabelsClient of Discovery Core.
+func (c coreSDK) Labels() LabelsClient {
+	return c.core.Labels()
+}
+
+// Files returns the FilesClient of Discovery Core.
+func (c coreSDK) Files() FilesClient {
+	return c.core.Files()
+}
+
+// Maintenance returns the MaintenanceClient of Discovery Core.
+func (c coreSDK) Maintenance() MaintenanceClient {
+	return c.core.Maintenance()
+}
+
+// BackupRestore returns the BackupRestorer of Discovery Core.
+func (c coreSDK) BackupRestore() BackupRestorer {
+	return c.core.BackupRestore()
+}
+
+// StatusChecker returns the StatusChecker of Discovery Core.
+func (c coreSDK) StatusChecker() StatusChecker {
+	return c.core.StatusChecker()
+}
+
+// seedExecutionsSDK adapts the seedExecutionsClient struct to the SeedExecutionsClient interface.
+type seedExecutionsSDK struct {
+	seedExecutionsClient
+}
+
+// Records returns the SeedExecutionRecordsClient of the seed execution.
+func (c seedExecutionsSDK) Records(executionId uuid.UUID) SeedExecutionRecordsClient {
+	return c.seedExecutionsClient.Records(executionId)
+}
+
+// Jobs returns the SeedExecutionJobsClient of the seed execution.
+func (c seedExecutionsSDK) Jobs(executionId uuid.UUID) SeedExecutionJobsClient {
+	return c.seedExecutionsClient.Jobs(executionId)
+}
+
+// seedsSDK adapts the seedsClient struct to the SeedsClient interface.
+type seedsSDK struct {
+	seedsClient
+}
+
+// Records returns the SeedRecordsClient of the seed.
+func (c seedsSDK) Records(seedId uuid.UUID) SeedRecordsClient {
+	return c.seedsClient.Records(seedId)
+}
+
+// Executions returns the SeedExecutionsClient of the seed.
+func (c seedsSDK) Executions(seedId uuid.UUID) SeedExecutionsClient {
+	return seedExecutionsSDK{c.seedsClient.Executions(seedId)}
+}
+
+// ingestionSDK adapts the ingestion struct to the Ingestion interface.
+type ingestionSDK struct {
+	ingestion
+}
+
+// Processors returns the IngestionProcessorsClient of Discovery Ingestion.
+func (i ingestionSDK) Processors() IngestionProcessorsClient {
+	return i.ingestion.Processors()
+}
+
+// Pipelines returns the IngestionPipelinesClient of Discovery Ingestion.
+func (i ingestionSDK) Pipelines() IngestionPipelinesClient {
+	return i.ingestion.Pipelines()
+}
+
+// Seeds returns the SeedsClient of Discovery Ingestion.
+func (i ingestionSDK) Seeds() SeedsClient {
+	return seedsSDK{i.ingestion.Seeds()}
+}
+
+// SeedSchedules returns the SeedSchedulesClient of Discovery Ingestion.
+func (i ingestionSDK) SeedSchedules() SeedSchedulesClient {
+	return i.ingestion.SeedSchedules()
+}
+
+// BackupRestore returns the BackupRestorer of Discovery Ingestion.
+func (i ingestionSDK) BackupRestore() BackupRestorer {
+	return i.ingestion.BackupRestore()
+}
+
+// StatusChecker returns the StatusChecker of Discovery Ingestion.
+func (i ingestionSDK) StatusChecker() StatusChecker {
+	return i.ingestion.StatusChecker()
+}
+
+// mcpServersSDK adapts the mcpServersClient struct to the MCPServersClient interface.
+type mcpServersSDK struct {
+	mcpServersClient
+}
+
+// Tools returns the ToolsClient of the MCP server.
+func (c mcpServersSDK) Tools(serverId uuid.UUID) ToolsClient {
+	return c.mcpServersClient.Tools(serverId)
+}
+
+// queryFlowSDK adapts the queryFlow struct to the QueryFlow interface.
+type queryFlowSDK struct {
+	queryFlow
+}
+
+// Processors returns the QueryFlowProcessorsClient of Discovery QueryFlow.
+func (q queryFlowSDK) Processors() QueryFlowProcessorsClient {
+	return q.queryFlow.Processors()
+}
+
+// Pipelines returns the QueryFlowPipelinesClient of Discovery QueryFlow.
+func (q queryFlowSDK) Pipelines() QueryFlowPipelinesClient {
+	return q.queryFlow.Pipelines()
+}
+
+// Endpoints returns the EndpointsClient of Discovery QueryFlow.
+func (q queryFlowSDK) Endpoints() EndpointsClient {
+	return q.queryFlow.Endpoints()
+}
+
+// MCPServers returns the MCPServersClient of Discovery QueryFlow.
+func (q queryFlowSDK) MCPServers() MCPServersClient {
+	return mcpServersSDK{q.queryFlow.MCPServers()}
+}
+
+// BackupRestore returns the BackupRestorer of Discovery QueryFlow.
+func (q queryFlowSDK) BackupRestore() BackupRestorer {
+	return q.queryFlow.BackupRestore()
+}
+
+// StatusChecker returns the StatusChecker of Discovery QueryFlow.
+func (q queryFlowSDK) StatusChecker() StatusChecker {
+	return q.queryFlow.StatusChecker()
+}
+
+// stagingSDK adapts the staging struct to the Staging interface.
+type stagingSDK struct {
+	staging
+}
+
+// Buckets returns the BucketsClient of Discovery Staging.
+func (s stagingSDK) Buckets() BucketsClient {
+	return s.staging.Buckets()
+}
+
+// Content returns the ContentClient of the given bucket.
+func (s stagingSDK) Content(bucket string) ContentClient {
+	return s.staging.Content(bucket)
+}
+
+// StatusChecker returns the StatusChecker of Discovery Staging.
+func (s stagingSDK) StatusChecker() StatusChecker {
+	return s.staging.StatusChecker()
+}
+
+// Default URLs of the Discovery products.
+const (
+	DefaultCoreURL      = "http://localhost:12010"
+	DefaultStagingURL   = "http://localhost:12020"
+	DefaultIngestionURL = "http://localhost:12030"
+	DefaultQueryFlowURL = "http://localhost:12040"
+)
+
+// product contains the URL and API key of a Discovery product.
+type product struct {
+	url, apiKey string
+}
+
+// clientSettings contains the configuration used to create a Client.
+type clientSettings struct {
+	core, ingestion, queryFlow, staging product
+	options                             []ClientOption
+}
+
+// Option is a type definition used for the functional options pattern.
+// It configures the Client created with the NewClient() function, like setting the URLs and API keys of the Discovery products.
+type Option func(*clientSettings)
+
+// WithCore sets the URL and API key of Discovery Core.
+func WithCore(url, apiKey string) Option {
+	return func(s *clientSettings) {
+		s.core = product{url: url, apiKey: apiKey}
+	}
+}
+
+// WithIngestion sets the URL and API key of Discovery Ingestion.
+func WithIngestion(url, apiKey string) Option {
+	return func(s *clientSettings) {
+		s.ingestion = product{url: url, apiKey: apiKey}
+	}
+}
+
+// WithQueryFlow sets the URL and API key of Discovery QueryFlow.
+func WithQueryFlow(url, apiKey string) Option {
+	return func(s *clientSettings) {
+		s.queryFlow = product{url: url, apiKey: apiKey}
+	}
+}
+
+// WithStaging sets the URL and API key of Discovery Staging.
+func WithStaging(url, apiKey string) Option {
+	return func(s *clientSettings) {
+		s.staging = product{url: url, apiKey: apiKey}
+	}
+}
+
+// WithAPIKey sets the same API key for every Discovery product.
+func WithAPIKey(apiKey string) Option {
+	return func(s *clientSettings) {
+		s.core.apiKey = apiKey
+		s.ingestion.apiKey = apiKey
+		s.queryFlow.apiKey = apiKey
+		s.staging.apiKey = apiKey
+	}
+}
+
+// WithClientOptions adds client options, like WithHTTPClient() or WithRetryPolicy(), that are applied to the clients of every Discovery product.
+func WithClientOptions(options ...ClientOption) Option {
+	return func(s *clientSettings) {
+		s.options = append(s.options, options...)
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+// The given client is copied, so it is not modified by other client options like WithTimeout().
+// If it has no transport, the shared default transport is used.
+// The WithTimeout() and WithTransport() options replace the timeout and transport of the HTTP client, no matter if they are set before or after it.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *client) {
+		hc := *httpClient
+		c.httpClient = &hc
+	}
+}
+
+// Client is the entry point of the Discovery SDK. It has the clients of every Discovery product.
+type Client struct {
+	core      Core
+	ingestion Ingestion
+	queryFlow QueryFlow
+	staging   Staging
+}
+
+// NewClient creates a Client configured with the given options.
+// The products whose URL is not set use their default URL on localhost.
+func NewClient(options ...Option) Client {
+	settings := clientSettings{
+		core:      product{url: DefaultCoreURL},
+		ingestion: product{url: DefaultIngestionURL},
+		queryFlow: product{url: DefaultQueryFlowURL},
+		staging:   product{url: DefaultStagingURL},
+	}
+	for _, opt := range options {
+		opt(&settings)
+	}
+
+	return Client{
+		core:      NewCore(settings.core.url, settings.core.apiKey, settings.options...),
+		ingestion: NewIngestion(settings.ingestion.url, settings.ingestion.apiKey, settings.options...),
+		queryFlow: NewQueryFlow(settings.queryFlow.url, settings.queryFlow.apiKey, settings.options...),
+		staging:   NewStaging(settings.staging.url, settings.staging.apiKey, settings.options...),
+	}
+}
+
+// Core returns the client of Discovery Core.
+func (c Client) Core() Core {
+	return c.core
+}
+
+// Ingestion returns the client of Discovery Ingestion.
+func (c Client) Ingestion() Ingestion {
+	return c.ingestion
+}
+
+// QueryFlow returns the client of Discovery QueryFlow.
+func (c Client) QueryFlow() QueryFlow {
+	return c.queryFlow
+}
+
+// Staging returns the client of Discovery Staging.
+func (c Client) Staging() Staging {
+	return c.staging
+}
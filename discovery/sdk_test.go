@@ -0,0 +1,142 @@
+package discovery
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewClient_DefaultURLs tests that the Client uses the default URLs of the Discovery products.
+func TestNewClient_DefaultURLs(t *testing.T) {
+	c := NewClient(WithAPIKey("apiKey"))
+
+	core := c.Core().(coreSDK).core
+	assert.Equal(t, DefaultCoreURL+"/v2", core.Url)
+	assert.Equal(t, "apiKey", core.ApiKey)
+
+	ingestion := c.Ingestion().(ingestionSDK).ingestion
+	assert.Equal(t, DefaultIngestionURL+"/v2", ingestion.Url)
+	assert.Equal(t, "apiKey", ingestion.ApiKey)
+
+	queryFlow := c.QueryFlow().(queryFlowSDK).queryFlow
+	assert.Equal(t, DefaultQueryFlowURL+"/v2", queryFlow.Url)
+	assert.Equal(t, "apiKey", queryFlow.ApiKey)
+
+	staging := c.Staging().(stagingSDK).staging
+	assert.Equal(t, DefaultStagingURL+"/v2", staging.Url)
+	assert.Equal(t, "apiKey", staging.ApiKey)
+}
+
+// TestNewClient_Options tests that the Client is configured with the URLs, API keys and client options.
+func TestNewClient_Options(t *testing.T) {
+	c := NewClient(
+		WithCore("http://core:8080", "coreKey"),
+		WithIngestion("http://ingestion:8080", "ingestionKey"),
+		WithQueryFlow("http://queryflow:8080", "queryFlowKey"),
+		WithStaging("http://staging:8080", "stagingKey"),
+		WithClientOptions(WithTimeout(5*time.Second)),
+	)
+
+	labels := c.Core().Labels().(labelsClient)
+	assert.Equal(t, "http://core:8080/v2/label", labels.client.client.BaseURL)
+	assert.Equal(t, "coreKey", labels.ApiKey)
+	assert.Equal(t, 5*time.Second, labels.client.client.GetClient().Timeout)
+
+	seedId := uuid.MustParse("1d81d3d5-58a2-44a5-9acf-3fc8358afe09")
+	executionId := uuid.MustParse("a056c7fb-0ca1-45f6-97ea-ec849a0701fd")
+	records := c.Ingestion().Seeds().Executions(seedId).Records(executionId).(seedExecutionRecordsClient)
+	assert.Equal(t, "http://ingestion:8080/v2/seed/"+seedId.String()+"/execution/"+executionId.String()+"/record", records.client.client.BaseURL)
+	assert.Equal(t, "ingestionKey", records.ApiKey)
+
+	serverId := uuid.MustParse("4b558077-cb0f-4e1c-ab6b-ed96870529e4")
+	tools := c.QueryFlow().MCPServers().Tools(serverId).(toolsClient)
+	assert.Equal(t, "http://queryflow:8080/v2/entrypoint/mcp-server/"+serverId.String()+"/tool", tools.crud.client.client.BaseURL)
+	assert.Equal(t, "queryFlowKey", tools.crud.ApiKey)
+
+	content := c.Staging().Content("my-bucket").(contentClient)
+	assert.Equal(t, "http://staging:8080/v2/content/my-bucket", content.client.client.BaseURL)
+	assert.Equal(t, "stagingKey", content.ApiKey)
+}
+
+// TestNewClient_SendsRequests tests that the clients obtained from the Client send their requests to Discovery.
+func TestNewClient_SendsRequests(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{"status":"UP"}`, func(t *testing.T, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/health", r.URL.Path)
+		assert.Equal(t, "apiKey", r.Header.Get("X-API-Key"))
+	}))
+	t.Cleanup(srv.Close)
+
+	var ingestion Ingestion = NewClient(WithIngestion(srv.URL, "apiKey")).Ingestion()
+	status, err := ingestion.StatusChecker().StatusCheck()
+	require.NoError(t, err)
+	assert.Equal(t, "UP", status.Get("status").String())
+}
+
+// TestWithHTTPClient tests that the WithHTTPClient() option uses a copy of the given HTTP client.
+func TestWithHTTPClient(t *testing.T) {
+	transport := NewTransport()
+	httpClient := &http.Client{Transport: transport, Timeout: time.Minute}
+
+	c := newClient("http://localhost:12010/v2", "apiKey", WithHTTPClient(httpClient), WithTimeout(5*time.Second))
+	sub := newSubClient(c, "/seed")
+
+	assert.Equal(t, "http://localhost:12010/v2", c.client.BaseURL)
+	assert.Same(t, transport, c.client.GetClient().Transport)
+	assert.Equal(t, 5*time.Second, c.client.GetClient().Timeout)
+	assert.Same(t, transport, sub.client.GetClient().Transport)
+	assert.Equal(t, time.Minute, httpClient.Timeout)
+
+	withoutTransport := newClient("http://localhost:12010/v2", "apiKey", WithHTTPClient(&http.Client{}))
+	assert.Same(t, defaultTransport, withoutTransport.client.GetClient().Transport)
+}
+
+// TestWithHTTPClient_OptionOrder tests that the options set before WithHTTPClient() are not dropped by it.
+func TestWithHTTPClient_OptionOrder(t *testing.T) {
+	transport := NewTransport()
+	other := NewTransport()
+	httpClient := &http.Client{Transport: transport, Timeout: time.Minute}
+
+	c := newClient("http://localhost:12010/v2", "apiKey", WithTimeout(5*time.Second), WithTransport(other), WithHTTPClient(httpClient))
+	sub := newSubClient(c, "/seed")
+
+	assert.Equal(t, "http://localhost:12010/v2", c.client.BaseURL)
+	assert.Same(t, other, c.client.GetClient().Transport)
+	assert.Equal(t, 5*time.Second, c.client.GetClient().Timeout)
+	assert.Same(t, other, sub.client.GetClient().Transport)
+	assert.Equal(t, 5*time.Second, sub.client.GetClient().Timeout)
+	assert.Same(t, transport, httpClient.Transport)
+	assert.Equal(t, time.Minute, httpClient.Timeout)
+
+	withoutOptions := newClient("http://localhost:12010/v2", "apiKey", WithHTTPClient(httpClient))
+	assert.Same(t, transport, withoutOptions.client.GetClient().Transport)
+	assert.Equal(t, time.Minute, withoutOptions.client.GetClient().Timeout)
+}
+
+// TestNewProductClients tests that the constructors of the clients of the Discovery products return the interfaces of the SDK, which send the requests to the /v2 path.
+func TestNewProductClients(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{"status":"UP"}`, func(t *testing.T, r *http.Request) {
+		assert.Equal(t, "/health", r.URL.Path)
+		assert.Equal(t, "apiKey", r.Header.Get("X-API-Key"))
+	}))
+	t.Cleanup(srv.Close)
+
+	var core Core = NewCore(srv.URL, "apiKey")
+	var ingestion Ingestion = NewIngestion(srv.URL, "apiKey")
+	var queryFlow QueryFlow = NewQueryFlow(srv.URL, "apiKey")
+	var staging Staging = NewStaging(srv.URL, "apiKey")
+	for _, checker := range []StatusChecker{core.StatusChecker(), ingestion.StatusChecker(), queryFlow.StatusChecker(), staging.StatusChecker()} {
+		status, err := checker.StatusCheck()
+		require.NoError(t, err)
+		assert.Equal(t, "UP", status.Get("status").String())
+	}
+
+	labels := core.Labels().(labelsClient)
+	assert.Equal(t, srv.URL+"/v2/label", labels.client.client.BaseURL)
+}
@@ -1,8 +1,11 @@
 package discovery
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"strings"
 
 	"github.com/tidwall/gjson"
 )
@@ -23,39 +26,181 @@ const (
 }`
 )
 
+// SearchSeq returns a sequence that iterates through every page of the results and yields only the JSON objects.
+// The pages are requested as the sequence is consumed.
+// The request options can change the pages, like WithSort() and WithPageSize().
+func (s searcher) SearchSeq(filter gjson.Result, options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return s.SearchSeqContext(s.client.requestContext(), filter, options...)
+}
+
+// SearchSeqContext is like SearchSeq, but it uses the given context for its requests instead of the one set with WithContext().
+func (s searcher) SearchSeqContext(ctx context.Context, filter gjson.Result, options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		for entity, err := range paginate(ctx, s.client, http.MethodPost, "/search", append([]RequestOption{WithJSONBody(filter.Raw)}, options...)...) {
+			if !yield(entity.Get("source"), err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
 // Search iterates through every page of the results and returns an array with only the JSON objects.
 // Each object has a score that grades how well it matches the given filters.
-func (s searcher) Search(filter gjson.Result) ([]gjson.Result, error) {
-	results, err := executeWithPagination(s.client, http.MethodPost, "/search", WithJSONBody(filter.Raw))
-	if err != nil {
-		return []gjson.Result(nil), err
+func (s searcher) Search(filter gjson.Result, options ...RequestOption) ([]gjson.Result, error) {
+	return s.SearchContext(s.client.requestContext(), filter, options...)
+}
+
+// SearchContext is like Search, but it uses the given context for its requests instead of the one set with WithContext().
+func (s searcher) SearchContext(ctx context.Context, filter gjson.Result, options ...RequestOption) ([]gjson.Result, error) {
+	return Collect(s.SearchSeqContext(ctx, filter, options...))
+}
+
+// searchExactName returns the results of the search whose name is exactly the given one.
+// If the type is not empty, the results must also have that type.
+// The search can match other names, like the ones that contain the given name, so they are discarded.
+// It stops after the second match, because it is enough to know that the name is ambiguous, so the rest of the pages are not requested.
+func (s searcher) searchExactName(ctx context.Context, name, entityType string) ([]gjson.Result, error) {
+	filter := Equals("name", name)
+	if entityType != "" {
+		filter = And(Equals("type", entityType), filter)
 	}
-	for index, entity := range results {
-		results[index] = entity.Get("source")
+
+	matches := []gjson.Result{}
+	for result, err := range s.SearchSeqContext(ctx, filter) {
+		if err != nil {
+			return nil, err
+		}
+		if result.Get("name").String() == name && (entityType == "" || result.Get("type").String() == entityType) {
+			matches = append(matches, result)
+			if len(matches) == 2 {
+				break
+			}
+		}
 	}
-	return results, nil
+	return matches, nil
 }
 
-// SearchByName creates the filter to search an entity by the given name and calls the searcher.Search() function.
-// It returns the first result if any or an error if it was not found or the search failed.
+// SearchByName searches the entity with exactly the given name and gets it by its id.
+// If no entity has the name and it has the format {type}/{name}, it searches the entity with that type and name, so that entities with the same name can be told apart.
+// It returns a Not Found error if no entity matches, and an AmbiguousNameError with the candidates if several entities match.
 func (s searcher) SearchByName(name string) (gjson.Result, error) {
-	byNameFilter := gjson.Parse(fmt.Sprintf(`{
-		"equals": {
-			"field": "name",
-			"value": "%s"
+	return s.SearchByNameContext(s.client.requestContext(), name)
+}
+
+// SearchByNameContext is like SearchByName, but it uses the given context for its requests instead of the one set with WithContext().
+func (s searcher) SearchByNameContext(ctx context.Context, name string) (gjson.Result, error) {
+	matches, err := s.searchExactName(ctx, name, "")
+	if err != nil {
+		return gjson.Result{}, err
+	}
+
+	if entityType, entityName, found := strings.Cut(name, "/"); len(matches) == 0 && found && entityType != "" && entityName != "" {
+		matches, err = s.searchExactName(ctx, entityName, entityType)
+		if err != nil {
+			return gjson.Result{}, err
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return gjson.Result{}, NewError(http.StatusNotFound, gjson.Parse(fmt.Sprintf(NotFoundError, name)))
+	case 1:
+		return execute(ctx, s.client, http.MethodGet, "/"+matches[0].Get("id").String())
+	default:
+		return gjson.Result{}, AmbiguousNameError{Name: name, Candidates: matches, Format: "{type}/{name}"}
+	}
+}
+
+// localSearcher searches the entities of the endpoints that do not have a search endpoint, like the labels and secrets of Discovery Core.
+// It gets every entity with the getter and evaluates the filters on the client, so it supports the same filters as Discovery's DSL.
+type localSearcher struct {
+	getter getter
+	// nameField is the field that has the name of the entities, like "key" for the labels.
+	nameField string
+	// qualifierField is the field that tells apart the entities with the same name in the format {name}:{qualifier}, like "value" for the labels.
+	// If it is empty, the names cannot be qualified.
+	qualifierField string
+}
+
+// SearchSeq returns a sequence that iterates through every entity that matches the filter.
+// The pages of entities are requested as the sequence is consumed.
+// The request options can change the pages, like WithSort() and WithPageSize().
+func (s localSearcher) SearchSeq(filter gjson.Result, options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return s.SearchSeqContext(s.getter.client.requestContext(), filter, options...)
+}
+
+// SearchSeqContext is like SearchSeq, but it uses the given context for its requests instead of the one set with WithContext().
+func (s localSearcher) SearchSeqContext(ctx context.Context, filter gjson.Result, options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		for entity, err := range s.getter.GetAllSeqContext(ctx, options...) {
+			if err != nil {
+				yield(gjson.Result{}, err)
+				return
+			}
+
+			matches, err := matchesFilter(filter, entity)
+			if err != nil {
+				yield(gjson.Result{}, err)
+				return
+			}
+			if matches && !yield(entity, nil) {
+				return
+			}
 		}
-	}`, name))
+	}
+}
 
-	results, err := s.Search(byNameFilter)
+// Search returns an array with every entity that matches the filter.
+func (s localSearcher) Search(filter gjson.Result, options ...RequestOption) ([]gjson.Result, error) {
+	return s.SearchContext(s.getter.client.requestContext(), filter, options...)
+}
+
+// SearchContext is like Search, but it uses the given context for its requests instead of the one set with WithContext().
+func (s localSearcher) SearchContext(ctx context.Context, filter gjson.Result, options ...RequestOption) ([]gjson.Result, error) {
+	return Collect(s.SearchSeqContext(ctx, filter, options...))
+}
+
+// SearchByName gets the entity with exactly the given name.
+// If no entity has the name and the client has a qualifier field, a name in the format {name}:{qualifier} searches the entity with that name and qualifier, like my-label:my-value for the labels.
+// It returns a Not Found error if no entity matches, and an AmbiguousNameError with the candidates if several entities match.
+func (s localSearcher) SearchByName(name string) (gjson.Result, error) {
+	return s.SearchByNameContext(s.getter.client.requestContext(), name)
+}
+
+// SearchByNameContext is like SearchByName, but it uses the given context for its requests instead of the one set with WithContext().
+func (s localSearcher) SearchByNameContext(ctx context.Context, name string) (gjson.Result, error) {
+	filter := Equals(s.nameField, name)
+	if entityName, qualifier, found := strings.Cut(name, ":"); s.qualifierField != "" && found {
+		filter = Or(filter, And(Equals(s.nameField, entityName), Equals(s.qualifierField, qualifier)))
+	}
+
+	matches, err := s.SearchContext(ctx, filter)
 	if err != nil {
 		return gjson.Result{}, err
 	}
 
-	if len(results) == 0 || results[0].Get("name").String() != name {
-		return gjson.Result{}, Error{
-			Status: http.StatusNotFound, Body: gjson.Parse(fmt.Sprintf(NotFoundError, name)),
+	if len(matches) > 1 {
+		exact := []gjson.Result{}
+		for _, match := range matches {
+			if match.Get(gjson.Escape(s.nameField)).String() == name {
+				exact = append(exact, match)
+			}
+		}
+		if len(exact) > 0 {
+			matches = exact
 		}
 	}
 
-	return execute(s.client, http.MethodGet, "/"+results[0].Get("id").String())
+	switch len(matches) {
+	case 0:
+		return gjson.Result{}, NewError(http.StatusNotFound, gjson.Parse(fmt.Sprintf(NotFoundError, name)))
+	case 1:
+		return execute(ctx, s.getter.client, http.MethodGet, "/"+matches[0].Get("id").String())
+	default:
+		format := ""
+		if s.qualifierField != "" {
+			format = "{" + s.nameField + "}:{" + s.qualifierField + "}"
+		}
+		return gjson.Result{}, AmbiguousNameError{Name: name, Candidates: matches, Format: format}
+	}
 }
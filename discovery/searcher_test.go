@@ -1,10 +1,13 @@
 package discovery
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/pureinsights/discovery-cli/internal/testutils"
@@ -154,12 +157,7 @@ func Test_searcher_Search_HTTPResponseCases(t *testing.T) {
 
 // Test_searcher_SearchByName tests searcher.SearchByName().
 func Test_searcher_SearchByName(t *testing.T) {
-	filterString := `{
-		"equals": {
-			"field": "name",
-			"value": "%s"
-		}
-	}`
+	filterString := `{"equals":{"field":"name","value":"%s"}}`
 	tests := []struct {
 		name       string
 		method     string
@@ -217,7 +215,7 @@ func Test_searcher_SearchByName(t *testing.T) {
 				{
 				"source": {
 					"type": "mongo",
-					"name": "my-credential",
+					"name": "my-credential-2",
 					"labels": [
 					{
 						"key": "A",
@@ -469,3 +467,220 @@ func Test_searcher_SearchByName(t *testing.T) {
 		})
 	}
 }
+
+// Test_searcher_SearchByName_Ambiguity tests that searcher.SearchByName() detects the names of several entities and resolves the {type}/{name} format.
+func Test_searcher_SearchByName_Ambiguity(t *testing.T) {
+	entities := `[
+		{"source":{"id":"3b32e410-2f33-412d-9fb8-17970131921c","name":"my-credential","type":"mongo","labels":[]}},
+		{"source":{"id":"4957145b-6192-4862-a5da-e97853974e9f","name":"my-credential","type":"openai","labels":[{"key":"A","value":"B"}]}}
+	]`
+
+	tests := []struct {
+		name       string
+		nameFilter string
+		expectedId string
+		candidates []string
+		err        error
+	}{
+		{
+			name:       "The type and name resolve the entity",
+			nameFilter: "openai/my-credential",
+			expectedId: "4957145b-6192-4862-a5da-e97853974e9f",
+		},
+		{
+			name:       "Several entities have the name",
+			nameFilter: "my-credential",
+			candidates: []string{"3b32e410-2f33-412d-9fb8-17970131921c", "4957145b-6192-4862-a5da-e97853974e9f"},
+			err:        AmbiguousNameError{Name: "my-credential"},
+		},
+		{
+			name:       "No entity has the type and name",
+			nameFilter: "elasticsearch/my-credential",
+			err:        NewError(http.StatusNotFound, gjson.Parse(fmt.Sprintf(NotFoundError, "elasticsearch/my-credential"))),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					w.Write([]byte(fmt.Sprintf(`{"id":%q}`, strings.TrimPrefix(r.URL.Path, "/"))))
+					return
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				filter := gjson.ParseBytes(body)
+				entityType := filter.Get("and.0.equals.value").String()
+				content := []string{}
+				for _, entity := range gjson.Parse(entities).Array() {
+					if entityType == "" || entity.Get("source.type").String() == entityType {
+						content = append(content, entity.Raw)
+					}
+				}
+				w.Write([]byte(`{"content":[` + strings.Join(content, ",") + `],"totalPages":1}`))
+			}))
+			defer srv.Close()
+
+			s := searcher{client: newClient(srv.URL, "")}
+			result, err := s.SearchByName(tc.nameFilter)
+			if tc.err == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedId, result.Get("id").String())
+				return
+			}
+
+			assert.Equal(t, gjson.Result{}, result)
+			var ambiguousErr AmbiguousNameError
+			if errors.As(err, &ambiguousErr) {
+				ids := []string{}
+				for _, candidate := range ambiguousErr.Candidates {
+					ids = append(ids, candidate.Get("id").String())
+				}
+				assert.Equal(t, tc.candidates, ids)
+				assert.Equal(t, "{type}/{name}", ambiguousErr.Format)
+				assert.EqualError(t, err, `the name "my-credential" matches several entities`)
+			} else {
+				assert.EqualError(t, err, tc.err.Error())
+				assert.True(t, IsNotFound(err))
+			}
+		})
+	}
+}
+
+// Test_searcher_SearchByName_StopsAfterSecondMatch tests that searcher.SearchByName() does not request the rest of the pages once two entities have the name.
+func Test_searcher_SearchByName_StopsAfterSecondMatch(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(pagedHandler(t, [][]string{
+		{`{"source":{"id":"5467ab23-7827-4fae-aa78-dfd4800549ee","name":"my-credential","type":"mongo"},"score":1}`, `{"source":{"id":"7d0cb8c9-6555-4592-9b6c-1f4ed7fca9f4","name":"my-credential-2","type":"mongo"},"score":0.9}`},
+		{`{"source":{"id":"21029da3-041c-43b5-a67e-870251f2f6a6","name":"my-credential","type":"openai"},"score":0.8}`},
+		{`{"source":{"id":"226e8a0f-5b1e-4e17-8e43-e3e4a2a2d1f1","name":"my-credential","type":"elasticsearch"},"score":0.7}`},
+		{`{"source":{"id":"986ce864-af76-4fcb-8b4f-f4e4c6ab0951","name":"my-credential","type":"vertex"},"score":0.6}`},
+	}, calls))
+	t.Cleanup(srv.Close)
+
+	s := searcher{client: newClient(srv.URL, "")}
+	result, err := s.SearchByName("my-credential")
+	assert.Equal(t, gjson.Result{}, result)
+	var ambiguousErr AmbiguousNameError
+	require.ErrorAs(t, err, &ambiguousErr)
+	ids := []string{}
+	for _, candidate := range ambiguousErr.Candidates {
+		ids = append(ids, candidate.Get("id").String())
+	}
+	assert.Equal(t, []string{"5467ab23-7827-4fae-aa78-dfd4800549ee", "21029da3-041c-43b5-a67e-870251f2f6a6"}, ids)
+	assert.Less(t, calls.Load(), int32(4))
+}
+
+// Test_searcher_SearchSeq tests that searcher.SearchSeq() yields the source of every result.
+func Test_searcher_SearchSeq(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(pagedHandler(t, [][]string{{`{"source":{"name":"a"},"score":1}`}, {`{"source":{"name":"b"},"score":0.5}`}}, calls))
+	t.Cleanup(srv.Close)
+
+	s := searcher{client: newClient(srv.URL, "")}
+	var names []string
+	for entity, err := range s.SearchSeq(gjson.Parse(`{"equals":{"field":"type","value":"mongo"}}`)) {
+		require.NoError(t, err)
+		assert.False(t, entity.Get("score").Exists())
+		names = append(names, entity.Get("name").String())
+	}
+
+	assert.Equal(t, []string{"a", "b"}, names)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// Test_searcher_SearchSeq_Error tests that searcher.SearchSeq() yields the error when the search fails.
+func Test_searcher_SearchSeq_Error(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusUnauthorized, "application/json", `{"error":"unauthorized"}`, nil))
+	t.Cleanup(srv.Close)
+
+	s := searcher{client: newClient(srv.URL, "")}
+	count := 0
+	for _, err := range s.SearchSeq(gjson.Parse(`{}`)) {
+		count++
+		assert.EqualError(t, err, Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}.Error())
+	}
+	assert.Equal(t, 1, count)
+}
+
+// Test_searcher_SearchSeq_Options tests that the request options are sent with the filter in the search request.
+func Test_searcher_SearchSeq_Options(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{"content":[{"source":{"name":"a"}}],"totalSize":1,"totalPages":1,"numberOfElements":1,"pageNumber":0}`, func(t *testing.T, r *http.Request) {
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, "creationTimestamp,desc", r.URL.Query().Get("sort"))
+		assert.Equal(t, "10", r.URL.Query().Get("size"))
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"exists":{"field":"labels"}}`, string(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	s := searcher{client: newClient(srv.URL, "")}
+	results, err := s.Search(Exists("labels"), WithSort("creationTimestamp", SortDescending), WithPageSize(10))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "a", results[0].Get("name").String())
+}
+
+// Test_localSearcher tests that localSearcher searches the entities obtained with the getter.
+func Test_localSearcher(t *testing.T) {
+	labels := `[
+		{"id":"3b32e410-2f33-412d-9fb8-17970131921c","key":"env","value":"dev"},
+		{"id":"4957145b-6192-4862-a5da-e97853974e9f","key":"env","value":"prod"},
+		{"id":"8f14c11c-bb66-49d3-aa2a-dedff4608c17","key":"team","value":"search"}
+	]`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/" {
+			w.Write([]byte(`{"content":` + labels + `,"numberOfElements":3,"totalSize":3,"totalPages":1}`))
+			return
+		}
+		for _, label := range gjson.Parse(labels).Array() {
+			if "/"+label.Get("id").String() == r.URL.Path {
+				w.Write([]byte(label.Raw))
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	s := localSearcher{getter: getter{client: newClient(srv.URL, "")}, nameField: "key", qualifierField: "value"}
+
+	t.Run("Search evaluates the filter", func(t *testing.T) {
+		results, err := s.Search(Or(Equals("value", "prod"), Wildcard("key", "te*")))
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "4957145b-6192-4862-a5da-e97853974e9f", results[0].Get("id").String())
+		assert.Equal(t, "8f14c11c-bb66-49d3-aa2a-dedff4608c17", results[1].Get("id").String())
+	})
+
+	t.Run("SearchByName gets the entity with the name", func(t *testing.T) {
+		result, err := s.SearchByName("team")
+		require.NoError(t, err)
+		assert.Equal(t, "8f14c11c-bb66-49d3-aa2a-dedff4608c17", result.Get("id").String())
+	})
+
+	t.Run("SearchByName gets the entity with the name and qualifier", func(t *testing.T) {
+		result, err := s.SearchByName("env:prod")
+		require.NoError(t, err)
+		assert.Equal(t, "4957145b-6192-4862-a5da-e97853974e9f", result.Get("id").String())
+	})
+
+	t.Run("SearchByName detects several entities with the name", func(t *testing.T) {
+		result, err := s.SearchByName("env")
+		assert.Equal(t, gjson.Result{}, result)
+		var ambiguousErr AmbiguousNameError
+		require.ErrorAs(t, err, &ambiguousErr)
+		assert.Len(t, ambiguousErr.Candidates, 2)
+		assert.Equal(t, "{key}:{value}", ambiguousErr.Format)
+	})
+
+	t.Run("SearchByName does not find the name", func(t *testing.T) {
+		result, err := s.SearchByName("env:staging")
+		assert.Equal(t, gjson.Result{}, result)
+		assert.True(t, IsNotFound(err))
+	})
+}
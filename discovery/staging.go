@@ -1,6 +1,8 @@
 package discovery
 
 import (
+	"context"
+	"iter"
 	"net/http"
 	"strconv"
 	"strings"
@@ -10,26 +12,26 @@ import (
 	"github.com/tidwall/sjson"
 )
 
-// stagingGetContentOption is a type definition used for the functional options pattern.
+// ContentGetOption is a type definition used for the functional options pattern.
 // It adds query parameters to the contentClient.Get().
-type stagingGetContentOption func(*map[string][]string)
+type ContentGetOption func(*map[string][]string)
 
 // WithContentAction adds the given action as query parameter to the Get function.
-func WithContentAction(action string) stagingGetContentOption {
+func WithContentAction(action string) ContentGetOption {
 	return func(m *map[string][]string) {
 		(*m)["action"] = append((*m)["action"], action)
 	}
 }
 
 // WithIncludeProjections adds the query parameters to set the given fields as the ones the results will include.
-func WithIncludeProjections(include []string) stagingGetContentOption {
+func WithIncludeProjections(include []string) ContentGetOption {
 	return func(m *map[string][]string) {
 		(*m)["include"] = append((*m)["include"], include...)
 	}
 }
 
 // WithExcludeProjections adds the query parameters to set the given fields as the ones the results will exclude.
-func WithExcludeProjections(exclude []string) stagingGetContentOption {
+func WithExcludeProjections(exclude []string) ContentGetOption {
 	return func(m *map[string][]string) {
 		(*m)["exclude"] = append((*m)["exclude"], exclude...)
 	}
@@ -41,18 +43,23 @@ type contentClient struct {
 }
 
 // newContentClient is the constructor of the contentClient struct.
-func newContentClient(url, apiKey, bucketName string) contentClient {
+func newContentClient(url, apiKey, bucketName string, options ...ClientOption) contentClient {
 	return contentClient{
-		client: newClient(url+"/content/"+bucketName, apiKey),
+		client: newClient(url+"/content/"+bucketName, apiKey, options...),
 	}
 }
 
 // Store adds the given JSON content with the contentId parameter. The parentId parameter can be used to set hierarchical relationships between documents.
 func (c contentClient) Store(contentId, parentId string, content gjson.Result) (gjson.Result, error) {
+	return c.StoreContext(c.client.requestContext(), contentId, parentId, content)
+}
+
+// StoreContext is like Store, but it uses the given context for its requests instead of the one set with WithContext().
+func (c contentClient) StoreContext(ctx context.Context, contentId, parentId string, content gjson.Result) (gjson.Result, error) {
 	if parentId == "" {
-		return execute(c.client, http.MethodPost, "/"+contentId, WithJSONBody(content.Raw))
+		return execute(ctx, c.client, http.MethodPost, "/"+contentId, WithJSONBody(content.Raw))
 	} else {
-		return execute(c.client, http.MethodPost, "/"+contentId, WithQueryParameters(map[string][]string{
+		return execute(ctx, c.client, http.MethodPost, "/"+contentId, WithQueryParameters(map[string][]string{
 			"parentId": {parentId},
 		}), WithJSONBody(content.Raw))
 	}
@@ -60,52 +67,76 @@ func (c contentClient) Store(contentId, parentId string, content gjson.Result) (
 
 // Get obtains the information of the record in the bucket with the given contentId.
 // It can receive functional options to add the action, include, and exclude query parameters.
-func (c contentClient) Get(contentId string, options ...stagingGetContentOption) (gjson.Result, error) {
+func (c contentClient) Get(contentId string, options ...ContentGetOption) (gjson.Result, error) {
+	return c.GetContext(c.client.requestContext(), contentId, options...)
+}
+
+// GetContext is like Get, but it uses the given context for its requests instead of the one set with WithContext().
+func (c contentClient) GetContext(ctx context.Context, contentId string, options ...ContentGetOption) (gjson.Result, error) {
 	queryParams := make(map[string][]string)
 	for _, opt := range options {
 		opt(&queryParams)
 	}
-	return execute(c.client, http.MethodGet, "/"+contentId, WithQueryParameters(queryParams))
+	return execute(ctx, c.client, http.MethodGet, "/"+contentId, WithQueryParameters(queryParams))
 }
 
-// scrollWithPagination calls the scroll endpoint with the token parameter to get all of the results based on the filters and projections.
+// scroll returns a sequence that calls the scroll endpoint with the token parameter to iterate through all of the results based on the filters and projections.
 // The size query parameter and filters and projections JSON body should be within the request options received if they were set by the user.
-// The scroll endpoint is continuously called until the received response is empty.
-func scrollWithPagination(client client, method, path string, options ...RequestOption) ([]gjson.Result, error) {
-	response, err := execute(client, method, path, options...)
-	if err != nil {
-		return []gjson.Result(nil), err
-	}
+// The scroll endpoint is called again only when the elements of the previous response have been consumed, until the received response is empty.
+// If a request fails, the sequence yields the error and stops.
+func scroll(ctx context.Context, client client, method, path string, options ...RequestOption) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		response, err := execute(ctx, client, method, path, options...)
+		if err != nil {
+			yield(gjson.Result{}, err)
+			return
+		}
 
-	if !(response.Get("content").Exists()) {
-		return []gjson.Result{}, nil
-	}
+		if !(response.Get("content").Exists()) {
+			return
+		}
 
-	token := response.Get("token").String()
-	empty := response.Get("empty").Bool()
-	elements := response.Get("content").Array()
-	var requestOptions []RequestOption
-	for !empty {
-		requestOptions = append(options, WithQueryParameters(map[string][]string{"token": {token}}))
-		response, err = execute(client, method, path, requestOptions...)
-		if err != nil {
-			return []gjson.Result(nil), err
+		token := response.Get("token").String()
+		empty := response.Get("empty").Bool()
+		for _, element := range response.Get("content").Array() {
+			if !yield(element, nil) {
+				return
+			}
 		}
 
-		pageElements := response.Get("content").Array()
-		if len(pageElements) > 0 {
-			elements = append(elements, pageElements...)
+		for !empty {
+			requestOptions := append(options[:len(options):len(options)], WithQueryParameters(map[string][]string{"token": {token}}))
+			response, err = execute(ctx, client, method, path, requestOptions...)
+			if err != nil {
+				yield(gjson.Result{}, err)
+				return
+			}
+
+			pageElements := response.Get("content").Array()
+			if len(pageElements) == 0 {
+				return
+			}
+
+			for _, element := range pageElements {
+				if !yield(element, nil) {
+					return
+				}
+			}
 			token = response.Get("token").String()
 			empty = response.Get("empty").Bool()
-		} else {
-			break
 		}
 	}
-	return elements, nil
 }
 
-// Scroll iterates through all the records from a bucket based on the given filters and projections.
-func (c contentClient) Scroll(filters, projections gjson.Result, size *int) ([]gjson.Result, error) {
+// scrollWithPagination calls the scroll endpoint with the token parameter to get all of the results based on the filters and projections.
+// The size query parameter and filters and projections JSON body should be within the request options received if they were set by the user.
+// The scroll endpoint is continuously called until the received response is empty.
+func scrollWithPagination(ctx context.Context, client client, method, path string, options ...RequestOption) ([]gjson.Result, error) {
+	return Collect(scroll(ctx, client, method, path, options...))
+}
+
+// scrollOptions creates the request options of the scroll endpoint with the given filters, projections and page size.
+func scrollOptions(filters, projections gjson.Result, size *int) ([]RequestOption, error) {
 	body := "{}"
 	var err error
 	if filters.Exists() {
@@ -131,16 +162,59 @@ func (c contentClient) Scroll(filters, projections gjson.Result, size *int) ([]g
 		options = append(options, WithJSONBody(body))
 	}
 
-	return scrollWithPagination(c.client, http.MethodPost, "/scroll", options...)
+	return options, nil
+}
+
+// ScrollSeq returns a sequence that iterates through all the records from a bucket based on the given filters and projections.
+// The records are requested page by page as the sequence is consumed.
+func (c contentClient) ScrollSeq(filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error] {
+	return c.ScrollSeqContext(c.client.requestContext(), filters, projections, size)
+}
+
+// ScrollSeqContext is like ScrollSeq, but it uses the given context for its requests instead of the one set with WithContext().
+func (c contentClient) ScrollSeqContext(ctx context.Context, filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error] {
+	options, err := scrollOptions(filters, projections, size)
+	if err != nil {
+		return func(yield func(gjson.Result, error) bool) {
+			yield(gjson.Result{}, err)
+		}
+	}
+
+	return scroll(ctx, c.client, http.MethodPost, "/scroll", options...)
+}
+
+// Scroll iterates through all the records from a bucket based on the given filters and projections.
+func (c contentClient) Scroll(filters, projections gjson.Result, size *int) ([]gjson.Result, error) {
+	return c.ScrollContext(c.client.requestContext(), filters, projections, size)
+}
+
+// ScrollContext is like Scroll, but it uses the given context for its requests instead of the one set with WithContext().
+func (c contentClient) ScrollContext(ctx context.Context, filters, projections gjson.Result, size *int) ([]gjson.Result, error) {
+	options, err := scrollOptions(filters, projections, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return scrollWithPagination(ctx, c.client, http.MethodPost, "/scroll", options...)
 }
 
 // Delete deletes the document with the given contentId in the bucket.
 func (c contentClient) Delete(contentId string) (gjson.Result, error) {
-	return execute(c.client, http.MethodDelete, "/"+contentId)
+	return c.DeleteContext(c.client.requestContext(), contentId)
+}
+
+// DeleteContext is like Delete, but it uses the given context for its requests instead of the one set with WithContext().
+func (c contentClient) DeleteContext(ctx context.Context, contentId string) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodDelete, "/"+contentId)
 }
 
 // DeleteMany deletes the documents that match the given parentId or filters.
 func (c contentClient) DeleteMany(parentId string, filter gjson.Result) (gjson.Result, error) {
+	return c.DeleteManyContext(c.client.requestContext(), parentId, filter)
+}
+
+// DeleteManyContext is like DeleteMany, but it uses the given context for its requests instead of the one set with WithContext().
+func (c contentClient) DeleteManyContext(ctx context.Context, parentId string, filter gjson.Result) (gjson.Result, error) {
 	options := []RequestOption{}
 	if parentId != "" {
 		options = append(options, WithQueryParameters(map[string][]string{
@@ -152,7 +226,7 @@ func (c contentClient) DeleteMany(parentId string, filter gjson.Result) (gjson.R
 		options = append(options, WithJSONBody(filter.Raw))
 	}
 
-	return execute(c.client, http.MethodDelete, "", options...)
+	return execute(ctx, c.client, http.MethodDelete, "", options...)
 }
 
 // bucketsClient is the struct that manages buckets in the Staging Repository.
@@ -162,8 +236,8 @@ type bucketsClient struct {
 }
 
 // newBuckets is the constructor of the bucketsClient struct.
-func newBucketsClient(url, apiKey string) bucketsClient {
-	client := newClient(url+"/bucket", apiKey)
+func newBucketsClient(url, apiKey string, options ...ClientOption) bucketsClient {
+	client := newClient(url+"/bucket", apiKey, options...)
 	return bucketsClient{
 		crud: crud{
 			getter{
@@ -178,49 +252,71 @@ func newBucketsClient(url, apiKey string) bucketsClient {
 
 // Purge deletes all of the records in the given bucket.
 func (b bucketsClient) Purge(bucket uuid.UUID) (gjson.Result, error) {
-	return execute(b.client, http.MethodDelete, "/"+bucket.String()+"/purge")
+	return b.PurgeContext(b.client.requestContext(), bucket)
+}
+
+// PurgeContext is like Purge, but it uses the given context for its requests instead of the one set with WithContext().
+func (b bucketsClient) PurgeContext(ctx context.Context, bucket uuid.UUID) (gjson.Result, error) {
+	return execute(ctx, b.client, http.MethodDelete, "/"+bucket.String()+"/purge")
 }
 
 // CreateIndex adds an index with the given name and configuration to a bucket.
 func (b bucketsClient) CreateIndex(id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error) {
+	return b.CreateIndexContext(b.client.requestContext(), id, index, config)
+}
+
+// CreateIndexContext is like CreateIndex, but it uses the given context for its requests instead of the one set with WithContext().
+func (b bucketsClient) CreateIndexContext(ctx context.Context, id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error) {
 	var parts []string
 	for _, r := range config {
 		parts = append(parts, r.Raw)
 	}
 	jsonArray := "[" + strings.Join(parts, ",") + "]"
 
-	return execute(b.client, http.MethodPut, "/"+id.String()+"/index/"+index, WithJSONBody(jsonArray))
+	return execute(ctx, b.client, http.MethodPut, "/"+id.String()+"/index/"+index, WithJSONBody(jsonArray))
 }
 
 // DeleteIndex removes the index of a bucket.
 func (b bucketsClient) DeleteIndex(id uuid.UUID, index string) (gjson.Result, error) {
-	return execute(b.client, http.MethodDelete, "/"+id.String()+"/index/"+index)
+	return b.DeleteIndexContext(b.client.requestContext(), id, index)
+}
+
+// DeleteIndexContext is like DeleteIndex, but it uses the given context for its requests instead of the one set with WithContext().
+func (b bucketsClient) DeleteIndexContext(ctx context.Context, id uuid.UUID, index string) (gjson.Result, error) {
+	return execute(ctx, b.client, http.MethodDelete, "/"+id.String()+"/index/"+index)
 }
 
 // staging is the struct for the client that can carry out every Staging operation.
 type staging struct {
 	Url, ApiKey string
+	options     []ClientOption
 }
 
 // Buckets creates a new bucketsClient.
 func (s staging) Buckets() bucketsClient {
-	return newBucketsClient(s.Url, s.ApiKey)
+	return newBucketsClient(s.Url, s.ApiKey, s.options...)
 }
 
 // Content creates a new contentClient.
 func (s staging) Content(bucket string) contentClient {
-	return newContentClient(s.Url, s.ApiKey, bucket)
+	return newContentClient(s.Url, s.ApiKey, bucket, s.options...)
 }
 
 // StatusChecker creates a statusChecker with Staging's URL and API Key.
 func (s staging) StatusChecker() statusChecker {
 	return statusChecker{
-		client: newClient(s.Url[:len(s.Url)-3], s.ApiKey),
+		client: newClient(s.Url[:len(s.Url)-3], s.ApiKey, s.options...),
 	}
 }
 
-// NewStaging is the constructor for the staging struct.
+// NewStaging creates the client of Discovery Staging, which implements the Staging interface.
 // It adds a /v2 path to the URL in order to properly connect to Discovery.
-func NewStaging(url, apiKey string) staging {
-	return staging{Url: strings.TrimRight(url, "/") + "/v2", ApiKey: apiKey}
+// The client options are applied to every client created by it.
+func NewStaging(url, apiKey string, options ...ClientOption) Staging {
+	return stagingSDK{newStaging(url, apiKey, options...)}
+}
+
+// newStaging is the constructor for the staging struct.
+func newStaging(url, apiKey string, options ...ClientOption) staging {
+	return staging{Url: strings.TrimRight(url, "/") + "/v2", ApiKey: apiKey, options: options}
 }
@@ -4,7 +4,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pureinsights/discovery-cli/internal/testutils"
@@ -319,7 +321,7 @@ func Test_bucketsClient_GetAll(t *testing.T) {
 
 			defer srv.Close()
 
-			bucketsClient := newBucketsClient(srv.URL, "")
+			bucketsClient := newBucketsClient(srv.URL, "", WithRetryPolicy(RetryPolicy{}))
 
 			response, err := bucketsClient.GetAll()
 			assert.Equal(t, tc.expectedLen, len(response))
@@ -942,7 +944,7 @@ func Test_contentClient_Get(t *testing.T) {
 		expectedResponse gjson.Result
 		bucketName       string
 		contentId        string
-		getOptions       []stagingGetContentOption
+		getOptions       []ContentGetOption
 		err              error
 	}{
 		// Working case
@@ -984,7 +986,7 @@ func Test_contentClient_Get(t *testing.T) {
 			bucketName: "testBucket",
 			contentId:  "c28db957887e1aae75e7ab1dd0fd34e9",
 			err:        nil,
-			getOptions: []stagingGetContentOption{WithContentAction("STORE"), WithIncludeProjections([]string{"author", "header"}), WithExcludeProjections([]string{"author", "link"})},
+			getOptions: []ContentGetOption{WithContentAction("STORE"), WithIncludeProjections([]string{"author", "header"}), WithExcludeProjections([]string{"author", "link"})},
 		},
 
 		// Error case
@@ -1012,7 +1014,7 @@ func Test_contentClient_Get(t *testing.T) {
 			],
 			"timestamp": "2025-09-09T14:31:13.275303600Z"
 			}`)},
-			getOptions: []stagingGetContentOption(nil),
+			getOptions: []ContentGetOption(nil),
 		},
 		{
 			name:       "Get returns 404 Not found",
@@ -1038,7 +1040,7 @@ func Test_contentClient_Get(t *testing.T) {
 			],
 			"timestamp": "2025-09-09T15:47:26.883457300Z"
 			}`)},
-			getOptions: []stagingGetContentOption(nil),
+			getOptions: []ContentGetOption(nil),
 		},
 	}
 
@@ -1356,7 +1358,7 @@ func Test_scrollWithPagination_HTTPResponseCases(t *testing.T) {
 			defer srv.Close()
 
 			c := newClient(srv.URL, "")
-			results, err := scrollWithPagination(c, tc.method, "")
+			results, err := scrollWithPagination(c.requestContext(), c, tc.method, "")
 			if tc.err == nil {
 				require.NoError(t, err)
 				assert.Len(t, results, tc.expectedLen)
@@ -1436,7 +1438,7 @@ func Test_scrollWithPagination_ErrorInSecondPage(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	c := newClient(srv.URL, "")
-	response, err := scrollWithPagination(c, http.MethodPost, "/content/my-bucket/scroll")
+	response, err := scrollWithPagination(c.requestContext(), c, http.MethodPost, "/content/my-bucket/scroll")
 	assert.Equal(t, []gjson.Result(nil), response)
 	var errStruct Error
 	require.ErrorAs(t, err, &errStruct)
@@ -1450,7 +1452,7 @@ func Test_scrollWithPagination_RestyReturnsError(t *testing.T) {
 	srv.Close()
 
 	c := newClient(base, "")
-	response, err := scrollWithPagination(c, http.MethodPost, "/down")
+	response, err := scrollWithPagination(c.requestContext(), c, http.MethodPost, "/down")
 	require.Error(t, err)
 	assert.Equal(t, response, []gjson.Result(nil))
 	assert.Contains(t, err.Error(), base+"/down")
@@ -1573,7 +1575,7 @@ func Test_scrollWithPagination_ContentInSecondPage(t *testing.T) {
 	t.Cleanup(srv.Close)
 
 	c := newClient(srv.URL, "")
-	response, err := scrollWithPagination(c, http.MethodPost, "/content/my-bucket/scroll")
+	response, err := scrollWithPagination(c.requestContext(), c, http.MethodPost, "/content/my-bucket/scroll")
 	require.NoError(t, err)
 	assert.Len(t, response, 6)
 }
@@ -1782,7 +1784,7 @@ func TestWithExcludeProjections(t *testing.T) {
 func Test_staging_Buckets(t *testing.T) {
 	url := "http://localhost:12020"
 	apiKey := "Api Key"
-	staging := NewStaging(url, apiKey)
+	staging := newStaging(url, apiKey)
 	c := staging.Buckets()
 
 	assert.Equal(t, apiKey, c.client.ApiKey)
@@ -1794,7 +1796,7 @@ func Test_staging_Content(t *testing.T) {
 	url := "http://localhost:12020"
 	apiKey := "Api Key"
 	bucketName := "testBucket"
-	staging := NewStaging(url, apiKey)
+	staging := newStaging(url, apiKey)
 	c := staging.Content(bucketName)
 
 	assert.Equal(t, apiKey, c.client.ApiKey)
@@ -1803,7 +1805,7 @@ func Test_staging_Content(t *testing.T) {
 
 // Test_staging_StatusChecker tests the staging.StatusChecker() function.
 func Test_staging_StatusChecker(t *testing.T) {
-	s := NewStaging("http://localhost:12020", "Api Key")
+	s := newStaging("http://localhost:12020", "Api Key")
 	bc := s.StatusChecker()
 
 	assert.Equal(t, s.ApiKey, bc.ApiKey)
@@ -1812,8 +1814,54 @@ func Test_staging_StatusChecker(t *testing.T) {
 
 // TestNewStaging tests the staging client constructor.
 func TestNewStaging(t *testing.T) {
-	s := NewStaging("http://localhost:12020////", "Api Key")
+	s := newStaging("http://localhost:12020////", "Api Key")
 
 	assert.Equal(t, "Api Key", s.ApiKey, "ApiKey should be stored")
 	assert.Equal(t, "http://localhost:12020/v2", s.Url, "BaseURL should match server URL")
 }
+
+// TestNewStaging_ClientOptions tests that the client options are applied to the clients created by the staging struct.
+func TestNewStaging_ClientOptions(t *testing.T) {
+	s := newStaging("http://localhost:12020", "Api Key", WithTimeout(5*time.Second))
+
+	assert.Equal(t, 5*time.Second, s.Buckets().crud.client.client.GetClient().Timeout)
+	assert.Equal(t, 5*time.Second, s.Content("my-bucket").client.client.GetClient().Timeout)
+}
+
+// Test_contentClient_ScrollSeq tests that contentClient.ScrollSeq() yields the records of every scroll response and stops when the consumer does.
+func Test_contentClient_ScrollSeq(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/content/my-bucket/scroll", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Query().Get("token") {
+		case "":
+			_, _ = w.Write([]byte(`{"content":[{"id":"1"},{"id":"2"}],"token":"t1","empty":false}`))
+		case "t1":
+			_, _ = w.Write([]byte(`{"content":[{"id":"3"}],"token":"t2","empty":false}`))
+		default:
+			_, _ = w.Write([]byte(`{"content":[],"empty":true}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newContentClient(srv.URL, "", "my-bucket")
+	var ids []string
+	for record, err := range c.ScrollSeq(gjson.Result{}, gjson.Result{}, nil) {
+		require.NoError(t, err)
+		ids = append(ids, record.Get("id").String())
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, int32(3), calls.Load())
+
+	calls.Store(0)
+	for record, err := range c.ScrollSeq(gjson.Result{}, gjson.Result{}, nil) {
+		require.NoError(t, err)
+		assert.Equal(t, "1", record.Get("id").String())
+		break
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tidwall/gjson"
@@ -13,5 +14,10 @@ type statusChecker struct {
 
 // StatusCheck calls the health endpoint of a Discovery product. If it is online, the response should be a JSON with a "status" field whose value is "UP".
 func (c statusChecker) StatusCheck() (gjson.Result, error) {
-	return execute(c.client, http.MethodGet, "/health")
+	return c.StatusCheckContext(c.client.requestContext())
+}
+
+// StatusCheckContext is like StatusCheck, but it uses the given context for its requests instead of the one set with WithContext().
+func (c statusChecker) StatusCheckContext(ctx context.Context) (gjson.Result, error) {
+	return execute(ctx, c.client, http.MethodGet, "/health")
 }
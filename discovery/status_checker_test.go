@@ -39,7 +39,7 @@ func Test_statusChecker_StatusCheck_ProductOffline(t *testing.T) {
 	srv.Close()
 
 	sc := statusChecker{
-		client: newClient(srv.URL, ""),
+		client: newClient(srv.URL, "", WithRetryPolicy(RetryPolicy{})),
 	}
 
 	status, err := sc.StatusCheck()
@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/tidwall/gjson"
@@ -13,5 +14,10 @@ type summarizer struct {
 
 // Summarize adds /summary to the client's base URL and executes the GET method to get a summary of the entity.
 func (s summarizer) Summarize() (gjson.Result, error) {
-	return execute(s.client, http.MethodGet, "/summary")
+	return s.SummarizeContext(s.client.requestContext())
+}
+
+// SummarizeContext is like Summarize, but it uses the given context for its requests instead of the one set with WithContext().
+func (s summarizer) SummarizeContext(ctx context.Context) (gjson.Result, error) {
+	return execute(ctx, s.client, http.MethodGet, "/summary")
 }
@@ -0,0 +1,166 @@
+package discovery
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// TraceLevel is a type definition used to declare how much information of the requests and responses is written by the tracer.
+type TraceLevel int
+
+// The constants represent the levels of detail of the tracer.
+const (
+	// TraceOff disables the tracing.
+	TraceOff TraceLevel = iota
+	// TraceVerbose writes the method and URL of every request, and the status and duration of its response.
+	TraceVerbose
+	// TraceFull also writes the headers and the bodies of the requests and responses.
+	TraceFull
+)
+
+// traceBodyLimit is the maximum number of bytes of a body that are written by the tracer.
+const traceBodyLimit = 1024
+
+// redactedHeaders are the headers whose values are hidden by the tracer.
+var redactedHeaders = []string{"X-Api-Key", "Authorization", "Proxy-Authorization"}
+
+// tracer writes the requests and responses sent by the clients to a writer.
+// The mutex keeps the exchanges of concurrent requests from being interleaved.
+type tracer struct {
+	writer io.Writer
+	level  TraceLevel
+	mu     *sync.Mutex
+}
+
+// WithTrace writes every request executed by the client and its response to the given writer, with the given level of detail.
+// The values of the X-API-Key and Authorization headers are redacted and the bodies are truncated.
+// The retries of the requests are also written.
+func WithTrace(writer io.Writer, level TraceLevel) ClientOption {
+	t := &tracer{writer: writer, level: level, mu: &sync.Mutex{}}
+	return func(c *client) {
+		if writer == nil || level <= TraceOff {
+			c.tracer = nil
+			return
+		}
+		c.tracer = t
+	}
+}
+
+// traceURL returns the full URL of the request, including its query parameters.
+func traceURL(request *resty.Request, url string) string {
+	if request.RawRequest != nil && request.RawRequest.URL != nil {
+		return request.RawRequest.URL.String()
+	}
+
+	if query := request.QueryParam.Encode(); query != "" {
+		return url + "?" + query
+	}
+
+	return url
+}
+
+// traceHeaders writes the headers sorted by name, with the given prefix. The sensitive values are redacted.
+func traceHeaders(b *strings.Builder, prefix string, headers http.Header) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		value := strings.Join(headers.Values(name), ", ")
+		if slices.Contains(redactedHeaders, http.CanonicalHeaderKey(name)) {
+			value = "[REDACTED]"
+		}
+		fmt.Fprintf(b, "%s %s: %s\n", prefix, name, value)
+	}
+}
+
+// traceBody writes the body with the given prefix, truncated to the traceBodyLimit.
+// Binary bodies, like ZIP files, are only described by their size and content type.
+func traceBody(b *strings.Builder, prefix string, body []byte, contentType string) {
+	if len(body) == 0 {
+		return
+	}
+
+	if contentType != "" && !strings.Contains(contentType, "json") && !strings.HasPrefix(contentType, "text/") {
+		fmt.Fprintf(b, "%s [%d bytes of %s]\n", prefix, len(body), contentType)
+		return
+	}
+
+	if len(body) > traceBodyLimit {
+		fmt.Fprintf(b, "%s %s... (%d more bytes)\n", prefix, body[:traceBodyLimit], len(body)-traceBodyLimit)
+		return
+	}
+
+	fmt.Fprintf(b, "%s %s\n", prefix, body)
+}
+
+// requestBody obtains the body of the request, if it can be written.
+// The files and form values of multipart requests are written as form values.
+func requestBody(b *strings.Builder, request *resty.Request) {
+	switch body := request.Body.(type) {
+	case string:
+		traceBody(b, ">", []byte(body), request.Header.Get("Content-Type"))
+	case []byte:
+		traceBody(b, ">", body, request.Header.Get("Content-Type"))
+	case nil:
+		keys := make([]string, 0, len(request.FormData))
+		for key := range request.FormData {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			fmt.Fprintf(b, "> form %s=%s\n", strings.TrimPrefix(key, "@"), strings.Join(request.FormData[key], ", "))
+		}
+	default:
+		fmt.Fprintf(b, "> [%T body]\n", body)
+	}
+}
+
+// exchange writes a request and the response or error it got.
+func (t *tracer) exchange(request *resty.Request, method, url string, response *resty.Response, err error, elapsed time.Duration) {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "> %s %s\n", method, traceURL(request, url))
+	if t.level >= TraceFull {
+		if request.RawRequest != nil {
+			traceHeaders(b, ">", request.RawRequest.Header)
+		} else {
+			traceHeaders(b, ">", request.Header)
+		}
+		requestBody(b, request)
+	}
+
+	elapsed = elapsed.Round(time.Millisecond)
+	switch {
+	case err != nil && (response == nil || response.RawResponse == nil):
+		fmt.Fprintf(b, "< error after %s: %v\n", elapsed, err)
+	case response != nil:
+		fmt.Fprintf(b, "< %s in %s\n", response.Status(), elapsed)
+		if t.level >= TraceFull {
+			traceHeaders(b, "<", response.Header())
+			traceBody(b, "<", response.Body(), response.Header().Get("Content-Type"))
+		}
+	}
+
+	t.write(b.String())
+}
+
+// retry writes that a request is going to be retried.
+func (t *tracer) retry(method, url string, retry int, wait time.Duration, cause error) {
+	t.write(fmt.Sprintf("* retry %d of %s %s in %s: %s\n", retry, method, url, wait.Round(time.Millisecond), strings.TrimSpace(cause.Error())))
+}
+
+// write writes the text to the tracer's writer without interleaving it with the text of other requests.
+func (t *tracer) write(text string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = io.WriteString(t.writer, text)
+}
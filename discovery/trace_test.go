@@ -0,0 +1,194 @@
+package discovery
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// durationRegex matches the durations written by the tracer, so that the traces can be compared.
+var durationRegex = regexp.MustCompile(`\d+(\.\d+)?(ns|µs|ms|s)\b`)
+
+// normalizeTrace replaces the durations and the URL of the test server in a trace.
+func normalizeTrace(trace, url string) string {
+	return durationRegex.ReplaceAllString(strings.ReplaceAll(trace, url, "URL"), "DURATION")
+}
+
+// TestWithTrace tests that the requests and responses are written with the level of detail of the tracer.
+func TestWithTrace(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    TraceLevel
+		method   string
+		options  []RequestOption
+		response testutils.MockResponse
+		expected string
+	}{
+		{
+			name:   "Verbose trace of a GET request",
+			level:  TraceVerbose,
+			method: http.MethodGet,
+			options: []RequestOption{
+				WithQueryParameters(map[string][]string{"page": {"1"}}),
+			},
+			response: testutils.MockResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: `{"name":"my-seed"}`},
+			expected: "> GET URL/seed?page=1\n< 200 OK in DURATION\n",
+		},
+		{
+			name:     "Verbose trace of a failed request",
+			level:    TraceVerbose,
+			method:   http.MethodDelete,
+			response: testutils.MockResponse{StatusCode: http.StatusNotFound, ContentType: "application/json", Body: `{"status":404}`},
+			expected: "> DELETE URL/seed\n< 404 Not Found in DURATION\n",
+		},
+		{
+			name:    "Full trace of a POST request",
+			level:   TraceFull,
+			method:  http.MethodPost,
+			options: []RequestOption{WithJSONBody(`{"name":"my-seed"}`)},
+			response: testutils.MockResponse{
+				StatusCode:  http.StatusOK,
+				ContentType: "application/json",
+				Body:        `{"id":"1","name":"my-seed"}`,
+			},
+			expected: "> POST URL/seed\n" +
+				"> Accept: application/json\n" +
+				"> Content-Type: application/json\n" +
+				"> User-Agent: go-resty/2.16.5 (https://github.com/go-resty/resty)\n" +
+				"> X-Api-Key: [REDACTED]\n" +
+				"> {\"name\":\"my-seed\"}\n" +
+				"< 200 OK in DURATION\n" +
+				"< Content-Length: 27\n" +
+				"< Content-Type: application/json\n" +
+				"< Date: DATE\n" +
+				"< {\"id\":\"1\",\"name\":\"my-seed\"}\n",
+		},
+		{
+			name:   "The body of the response is truncated",
+			level:  TraceFull,
+			method: http.MethodGet,
+			response: testutils.MockResponse{
+				StatusCode:  http.StatusOK,
+				ContentType: "text/plain",
+				Body:        strings.Repeat("a", traceBodyLimit+10),
+			},
+			expected: "> GET URL/seed\n" +
+				"> User-Agent: go-resty/2.16.5 (https://github.com/go-resty/resty)\n" +
+				"> X-Api-Key: [REDACTED]\n" +
+				"< 200 OK in DURATION\n" +
+				"< Content-Length: 1034\n" +
+				"< Content-Type: text/plain\n" +
+				"< Date: DATE\n" +
+				"< " + strings.Repeat("a", traceBodyLimit) + "... (10 more bytes)\n",
+		},
+		{
+			name:   "Binary bodies are not written",
+			level:  TraceFull,
+			method: http.MethodGet,
+			response: testutils.MockResponse{
+				StatusCode:  http.StatusOK,
+				ContentType: "application/zip",
+				Body:        "PK\x03\x04",
+			},
+			expected: "> GET URL/seed\n" +
+				"> User-Agent: go-resty/2.16.5 (https://github.com/go-resty/resty)\n" +
+				"> X-Api-Key: [REDACTED]\n" +
+				"< 200 OK in DURATION\n" +
+				"< Content-Length: 4\n" +
+				"< Content-Type: application/zip\n" +
+				"< Date: DATE\n" +
+				"< [4 bytes of application/zip]\n",
+		},
+	}
+
+	dateRegex := regexp.MustCompile(`< Date: .*\n`)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(testutils.HttpHandler(t, tc.response.StatusCode, tc.response.ContentType, tc.response.Body, func(t *testing.T, r *http.Request) {
+				assert.Equal(t, tc.method, r.Method)
+			}))
+			t.Cleanup(srv.Close)
+
+			buf := &bytes.Buffer{}
+			c := newClient(srv.URL, "secret-key", WithTrace(buf, tc.level))
+			_, _ = c.execute(c.requestContext(), tc.method, "/seed", tc.options...)
+
+			trace := dateRegex.ReplaceAllString(normalizeTrace(buf.String(), srv.URL), "< Date: DATE\n")
+			assert.Equal(t, tc.expected, trace)
+			assert.NotContains(t, buf.String(), "secret-key")
+		})
+	}
+}
+
+// TestWithTrace_Off tests that nothing is written when the tracer is disabled.
+func TestWithTrace_Off(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/json", `{}`, nil))
+	t.Cleanup(srv.Close)
+
+	buf := &bytes.Buffer{}
+	c := newClient(srv.URL, "apiKey", WithTrace(buf, TraceOff))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	require.NoError(t, err)
+	assert.Nil(t, c.tracer)
+	assert.Empty(t, buf.String())
+}
+
+// TestWithTrace_Retries tests that the tracer writes every attempt of a retried request.
+func TestWithTrace_Retries(t *testing.T) {
+	calls := &atomic.Int32{}
+	srv := httptest.NewServer(testutils.HttpFailingHandler(t, 1,
+		testutils.MockResponse{StatusCode: http.StatusServiceUnavailable, ContentType: "application/json", Body: `{"status":503}`},
+		testutils.MockResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: `{"ok":true}`},
+		calls,
+	))
+	t.Cleanup(srv.Close)
+
+	buf := &bytes.Buffer{}
+	c := newClient(srv.URL, "apiKey", WithRetryPolicy(testRetryPolicy(2)), WithTrace(buf, TraceVerbose))
+	sub := newSubClient(c, "/seed")
+	_, err := sub.execute(sub.requestContext(), http.MethodGet, "")
+	require.NoError(t, err)
+
+	expected := "> GET URL/seed\n" +
+		"< 503 Service Unavailable in DURATION\n" +
+		"* retry 1 of GET URL/seed in DURATION: status: 503, body: {\"status\":503}\n" +
+		"> GET URL/seed\n" +
+		"< 200 OK in DURATION\n"
+	assert.Equal(t, expected, normalizeTrace(buf.String(), srv.URL))
+}
+
+// TestWithTrace_ConnectionError tests that the tracer writes the error of a request that could not be sent.
+func TestWithTrace_ConnectionError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	buf := &bytes.Buffer{}
+	c := newClient(url, "apiKey", WithTrace(buf, TraceVerbose), WithRetryPolicy(RetryPolicy{}))
+	_, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	require.Error(t, err)
+
+	trace := normalizeTrace(buf.String(), url)
+	assert.True(t, strings.HasPrefix(trace, "> GET URL/seed\n< error after DURATION: "), trace)
+}
+
+// TestWithTrace_Export tests that the export requests are also written by the tracer.
+func TestWithTrace_Export(t *testing.T) {
+	srv := httptest.NewServer(testutils.HttpHandler(t, http.StatusOK, "application/octet-stream", "zip", nil))
+	t.Cleanup(srv.Close)
+
+	buf := &bytes.Buffer{}
+	backup := backupRestore{client: newClient(srv.URL, "apiKey", WithTrace(buf, TraceVerbose))}
+	_, err := backup.Export(io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, "> GET URL/export\n< 200 OK in DURATION\n", normalizeTrace(buf.String(), srv.URL))
+}
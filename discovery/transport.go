@@ -0,0 +1,114 @@
+package discovery
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Connection pool settings of the transport shared by the clients.
+const (
+	transportMaxIdleConns        = 100
+	transportMaxIdleConnsPerHost = 32
+	transportIdleConnTimeout     = 90 * time.Second
+)
+
+// NewTransport returns an HTTP transport that can be shared by several clients, so that they reuse the same connections.
+// It keeps the connections alive, attempts to use HTTP/2 when the server supports it, and requests gzip compressed responses,
+// which are decompressed transparently. The proxy is obtained from the environment variables.
+func NewTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+
+	return &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          transportMaxIdleConns,
+		MaxIdleConnsPerHost:   transportMaxIdleConnsPerHost,
+		IdleConnTimeout:       transportIdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// defaultTransport is the transport used by every client that is not configured with the WithTransport() option.
+// Sharing it lets the clients of all the Discovery products reuse the connections of the same connection pool.
+var defaultTransport http.RoundTripper = NewTransport()
+
+// WithTransport sets the HTTP transport used by the client and its sub-clients to send the requests.
+// By default, every client uses the same transport, which is created with the NewTransport() function.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *client) {
+		c.transport = transport
+	}
+}
+
+// TransportConfig contains the TLS and proxy settings of a transport created with the NewTransportWithConfig() function.
+type TransportConfig struct {
+	// CAFile is the path of a PEM file with the certificates of the certificate authorities that are trusted besides the ones of the system.
+	CAFile string
+	// CertFile and KeyFile are the paths of the PEM files with the client certificate and its private key, which are used for mutual TLS.
+	CertFile string
+	KeyFile  string
+	// InsecureSkipVerify disables the verification of the certificates of the servers. It must only be used for testing.
+	InsecureSkipVerify bool
+	// ProxyURL is the URL of the proxy that the requests are sent through. If empty, the proxy is obtained from the environment variables.
+	ProxyURL string
+}
+
+// NewTransportWithConfig returns a transport created with the NewTransport() function that uses the given TLS and proxy settings.
+// It fails if the certificate files cannot be read or the proxy URL is invalid.
+func NewTransportWithConfig(config TransportConfig) (*http.Transport, error) {
+	transport := NewTransport()
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CAFile != "" {
+		pem, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the CA certificates: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("could not read the CA certificates: the file %q does not have any PEM certificate", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (config.CertFile == "") != (config.KeyFile == "") {
+		return nil, errors.New("could not read the client certificate: both the certificate and its key must be set")
+	}
+	if config.CertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if config.ProxyURL != "" {
+		proxy, err := url.Parse(config.ProxyURL)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
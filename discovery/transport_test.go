@@ -0,0 +1,354 @@
+package discovery
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingServer starts a test server that responds with the protocol of the request and counts the connections opened by the clients.
+// If useTLS is true, the server uses TLS and HTTP/2.
+func countingServer(t testing.TB, useTLS bool, connections *atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"proto":"` + r.Proto + `"}`))
+	}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+
+	if useTLS {
+		srv.EnableHTTP2 = true
+		srv.StartTLS()
+	} else {
+		srv.Start()
+	}
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// testTransport returns a new transport that trusts the certificate of the given test server.
+func testTransport(srv *httptest.Server) *http.Transport {
+	transport := NewTransport()
+	if srv.TLS != nil {
+		transport.TLSClientConfig = srv.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+	}
+	return transport
+}
+
+// TestNewTransport tests that the NewTransport() function keeps the connections alive and enables HTTP/2 and compression.
+func TestNewTransport(t *testing.T) {
+	transport := NewTransport()
+
+	assert.True(t, transport.ForceAttemptHTTP2)
+	assert.False(t, transport.DisableKeepAlives)
+	assert.False(t, transport.DisableCompression)
+	assert.Equal(t, transportMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	assert.NotNil(t, transport.Proxy)
+}
+
+// Test_newClient_SharedTransport tests that the clients and their sub-clients use the same default transport.
+func Test_newClient_SharedTransport(t *testing.T) {
+	first := newClient("http://localhost:12010/v2/seed", "apiKey")
+	second := newClient("http://localhost:12020/v2/endpoint", "apiKey")
+	sub := newSubClient(first, "/record")
+
+	assert.Same(t, defaultTransport, first.client.GetClient().Transport)
+	assert.Same(t, defaultTransport, second.client.GetClient().Transport)
+	assert.Same(t, defaultTransport, sub.client.GetClient().Transport)
+}
+
+// TestWithTransport tests that the WithTransport() option sets the transport of the client and its sub-clients.
+func TestWithTransport(t *testing.T) {
+	transport := NewTransport()
+	c := newClient("http://localhost:12010/v2", "apiKey", WithTransport(transport))
+	sub := newSubClient(c, "/seed")
+
+	assert.Same(t, transport, c.client.GetClient().Transport)
+	assert.Same(t, transport, sub.client.GetClient().Transport)
+}
+
+// Test_client_execute_ReusesConnections tests that the clients of a product reuse the connections of the shared transport.
+func Test_client_execute_ReusesConnections(t *testing.T) {
+	tests := []struct {
+		name   string
+		useTLS bool
+		proto  string
+	}{
+		{name: "HTTP/1.1 connections are kept alive", useTLS: false, proto: "HTTP/1.1"},
+		{name: "HTTP/2 is used when the server supports it", useTLS: true, proto: "HTTP/2.0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			connections := &atomic.Int32{}
+			srv := countingServer(t, tc.useTLS, connections)
+			transport := testTransport(srv)
+			t.Cleanup(transport.CloseIdleConnections)
+
+			ingestion := newIngestion(srv.URL, "apiKey", WithTransport(transport))
+			clients := []client{
+				ingestion.Seeds().crud.client,
+				ingestion.Pipelines().crud.client,
+				ingestion.Processors().crud.client,
+				ingestion.StatusChecker().client,
+			}
+
+			for _, c := range clients {
+				res, err := c.execute(c.requestContext(), http.MethodGet, "")
+				require.NoError(t, err)
+				assert.Contains(t, string(res), tc.proto)
+			}
+
+			assert.Equal(t, int32(1), connections.Load())
+		})
+	}
+}
+
+// Test_client_execute_GzipResponse tests that the clients request compressed responses and decompress them.
+func Test_client_execute_GzipResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Accept-Encoding"), "gzip")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		_, _ = writer.Write([]byte(`{"name":"my-seed"}`))
+		_ = writer.Close()
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newClient(srv.URL, "apiKey")
+	res, err := c.execute(c.requestContext(), http.MethodGet, "/seed")
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"my-seed"}`, string(res))
+}
+
+// writePEM writes a PEM block with the given type and bytes to a file in the test's temporary directory and returns its path.
+func writePEM(t *testing.T, name, blockType string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0o600))
+	return path
+}
+
+// clientCertificate creates a self-signed client certificate and writes it and its key to PEM files.
+func clientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "discovery-cli"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return writePEM(t, "client.crt", "CERTIFICATE", certificate), writePEM(t, "client.key", "EC PRIVATE KEY", keyBytes)
+}
+
+// TestNewTransportWithConfig_TLS tests that the transport trusts the CA certificates, sends the client certificate and can skip the verification.
+func TestNewTransportWithConfig_TLS(t *testing.T) {
+	clientCert, clientKey := clientCertificate(t)
+
+	tests := []struct {
+		name          string
+		requireClient bool
+		config        func(srv *httptest.Server) TransportConfig
+		err           string
+	}{
+		{
+			name: "The server certificate is trusted with the CA file",
+			config: func(srv *httptest.Server) TransportConfig {
+				return TransportConfig{CAFile: writePEM(t, "ca.crt", "CERTIFICATE", srv.Certificate().Raw)}
+			},
+		},
+		{
+			name: "The server certificate is not trusted without the CA file",
+			config: func(srv *httptest.Server) TransportConfig {
+				return TransportConfig{}
+			},
+			err: "certificate",
+		},
+		{
+			name: "The verification is skipped",
+			config: func(srv *httptest.Server) TransportConfig {
+				return TransportConfig{InsecureSkipVerify: true}
+			},
+		},
+		{
+			name:          "The client certificate is sent",
+			requireClient: true,
+			config: func(srv *httptest.Server) TransportConfig {
+				return TransportConfig{CAFile: writePEM(t, "ca.crt", "CERTIFICATE", srv.Certificate().Raw), CertFile: clientCert, KeyFile: clientKey}
+			},
+		},
+		{
+			name:          "The server rejects a client without a certificate",
+			requireClient: true,
+			config: func(srv *httptest.Server) TransportConfig {
+				return TransportConfig{CAFile: writePEM(t, "ca.crt", "CERTIFICATE", srv.Certificate().Raw)}
+			},
+			err: "certificate",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.requireClient {
+					assert.Len(t, r.TLS.PeerCertificates, 1)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			srv.TLS = &tls.Config{}
+			if tc.requireClient {
+				srv.TLS.ClientAuth = tls.RequireAnyClientCert
+			}
+			srv.StartTLS()
+			t.Cleanup(srv.Close)
+
+			transport, err := NewTransportWithConfig(tc.config(srv))
+			require.NoError(t, err)
+			t.Cleanup(transport.CloseIdleConnections)
+
+			_, err = newClient(srv.URL, "apiKey", WithTransport(transport)).execute(context.Background(), http.MethodGet, "/seed")
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestNewTransportWithConfig_Proxy tests that the requests are sent through the proxy.
+func TestNewTransportWithConfig_Proxy(t *testing.T) {
+	proxied := &atomic.Int32{}
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		assert.Equal(t, "discovery.example.com:12010", r.Host)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"proxied":true}`))
+	}))
+	t.Cleanup(proxy.Close)
+
+	transport, err := NewTransportWithConfig(TransportConfig{ProxyURL: proxy.URL})
+	require.NoError(t, err)
+
+	res, err := newClient("http://discovery.example.com:12010/v2", "apiKey", WithTransport(transport)).execute(context.Background(), http.MethodGet, "/seed")
+	require.NoError(t, err)
+	assert.Equal(t, `{"proxied":true}`, string(res))
+	assert.Equal(t, int32(1), proxied.Load())
+}
+
+// TestNewTransportWithConfig_Errors tests the errors returned when the TLS or proxy settings are invalid.
+func TestNewTransportWithConfig_Errors(t *testing.T) {
+	notPEM := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0o600))
+	clientCert, _ := clientCertificate(t)
+
+	tests := []struct {
+		name   string
+		config TransportConfig
+		err    string
+	}{
+		{name: "The CA file does not exist", config: TransportConfig{CAFile: "doesnotexist.crt"}, err: "could not read the CA certificates: open doesnotexist.crt"},
+		{name: "The CA file has no certificates", config: TransportConfig{CAFile: notPEM}, err: fmt.Sprintf("could not read the CA certificates: the file %q does not have any PEM certificate", notPEM)},
+		{name: "The client key is missing", config: TransportConfig{CertFile: clientCert}, err: "could not read the client certificate: both the certificate and its key must be set"},
+		{name: "The client key is invalid", config: TransportConfig{CertFile: clientCert, KeyFile: notPEM}, err: "could not read the client certificate"},
+		{name: "The proxy URL is invalid", config: TransportConfig{ProxyURL: "localhost:3128"}, err: "invalid proxy URL \"localhost:3128\""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewTransportWithConfig(tc.config)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+// benchmarkClients is the number of clients used in each iteration of the benchmarks, like a command that sends a request with several sub-clients.
+const benchmarkClients = 10
+
+// benchmarkMultiRequestCommand measures a command that sends one request with each of several clients.
+// If shared is true, every client uses the same transport. Otherwise, every client creates its own transport, like each client did with its own resty client.
+// The benchmark reports the number of connections opened per command.
+func benchmarkMultiRequestCommand(b *testing.B, useTLS, shared bool) {
+	connections := &atomic.Int32{}
+	srv := countingServer(b, useTLS, connections)
+	sharedTransport := testTransport(srv)
+	b.Cleanup(sharedTransport.CloseIdleConnections)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		transports := make([]*http.Transport, 0, benchmarkClients)
+		for j := 0; j < benchmarkClients; j++ {
+			transport := sharedTransport
+			if !shared {
+				transport = testTransport(srv)
+				transports = append(transports, transport)
+			}
+
+			c := newClient(srv.URL+"/v2", "apiKey", WithTransport(transport))
+			if _, err := c.execute(c.requestContext(), http.MethodGet, fmt.Sprintf("/entity/%d", j)); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		for _, transport := range transports {
+			transport.CloseIdleConnections()
+		}
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(connections.Load())/float64(b.N), "conns/op")
+}
+
+// BenchmarkClient_MultiRequestCommand compares the connections and latency of the clients that share a transport with those that do not.
+func BenchmarkClient_MultiRequestCommand(b *testing.B) {
+	benchmarks := []struct {
+		name   string
+		useTLS bool
+		shared bool
+	}{
+		{name: "HTTP shared transport", useTLS: false, shared: true},
+		{name: "HTTP transport per client", useTLS: false, shared: false},
+		{name: "HTTPS shared transport", useTLS: true, shared: true},
+		{name: "HTTPS transport per client", useTLS: true, shared: false},
+	}
+
+	for _, bc := range benchmarks {
+		b.Run(bc.name, func(b *testing.B) {
+			benchmarkMultiRequestCommand(b, bc.useTLS, bc.shared)
+		})
+	}
+}
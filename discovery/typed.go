@@ -0,0 +1,216 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+)
+
+// Decode converts a JSON result into a value of the given type, like a Server.
+func Decode[T any](result gjson.Result) (T, error) {
+	var value T
+	err := json.Unmarshal([]byte(result.Raw), &value)
+	return value, err
+}
+
+// DecodeAll converts an array of JSON results into an array of values of the given type.
+func DecodeAll[T any](results []gjson.Result) ([]T, error) {
+	values := make([]T, 0, len(results))
+	for _, result := range results {
+		value, err := Decode[T](result)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Encode converts a value, like a Server, into a JSON result.
+func Encode[T any](value T) (gjson.Result, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+	return gjson.ParseBytes(data), nil
+}
+
+// decodeSeq returns a sequence that converts the JSON results of the given sequence into values of the given type.
+// If a result cannot be converted, the sequence yields the error and stops.
+func decodeSeq[T any](seq iter.Seq2[gjson.Result, error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for result, err := range seq {
+			var value T
+			if err == nil {
+				value, err = Decode[T](result)
+			}
+			if !yield(value, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// decodeResult converts the result of a request into a value of the given type.
+func decodeResult[T any](result gjson.Result, err error) (T, error) {
+	if err != nil {
+		var value T
+		return value, err
+	}
+	return Decode[T](result)
+}
+
+// decodeResults converts the results of a request into values of the given type.
+func decodeResults[T any](results []gjson.Result, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return DecodeAll[T](results)
+}
+
+// TypedGetter wraps a Getter so that it returns values of the given type instead of JSON results.
+type TypedGetter[T any] struct {
+	getter Getter
+}
+
+// NewTypedGetter returns a TypedGetter that wraps the given Getter.
+// For example, NewTypedGetter[SeedExecution](ingestion.Seeds().Executions(seedId)).
+func NewTypedGetter[T any](getter Getter) TypedGetter[T] {
+	return TypedGetter[T]{getter: getter}
+}
+
+// Get obtains the entity with the given ID.
+func (g TypedGetter[T]) Get(id uuid.UUID) (T, error) {
+	return decodeResult[T](g.getter.Get(id))
+}
+
+// GetContext is like Get, but it uses the given context for its requests.
+func (g TypedGetter[T]) GetContext(ctx context.Context, id uuid.UUID) (T, error) {
+	return decodeResult[T](g.getter.GetContext(ctx, id))
+}
+
+// GetAll obtains every entity.
+func (g TypedGetter[T]) GetAll(options ...RequestOption) ([]T, error) {
+	return decodeResults[T](g.getter.GetAll(options...))
+}
+
+// GetAllContext is like GetAll, but it uses the given context for its requests.
+func (g TypedGetter[T]) GetAllContext(ctx context.Context, options ...RequestOption) ([]T, error) {
+	return decodeResults[T](g.getter.GetAllContext(ctx, options...))
+}
+
+// GetAllSeq returns a sequence that iterates through every entity.
+func (g TypedGetter[T]) GetAllSeq(options ...RequestOption) iter.Seq2[T, error] {
+	return decodeSeq[T](g.getter.GetAllSeq(options...))
+}
+
+// GetAllSeqContext is like GetAllSeq, but it uses the given context for its requests.
+func (g TypedGetter[T]) GetAllSeqContext(ctx context.Context, options ...RequestOption) iter.Seq2[T, error] {
+	return decodeSeq[T](g.getter.GetAllSeqContext(ctx, options...))
+}
+
+// TypedCRUD wraps a CRUD so that it receives and returns values of the given type instead of JSON results.
+type TypedCRUD[T any] struct {
+	TypedGetter[T]
+	crud CRUD
+}
+
+// NewTypedCRUD returns a TypedCRUD that wraps the given CRUD.
+// For example, NewTypedCRUD[Server](core.Servers()).
+func NewTypedCRUD[T any](crud CRUD) TypedCRUD[T] {
+	return TypedCRUD[T]{TypedGetter: NewTypedGetter[T](crud), crud: crud}
+}
+
+// Create creates the entity and returns it as it was stored.
+func (c TypedCRUD[T]) Create(entity T) (T, error) {
+	config, err := Encode(entity)
+	if err != nil {
+		var value T
+		return value, err
+	}
+	return decodeResult[T](c.crud.Create(config))
+}
+
+// CreateContext is like Create, but it uses the given context for its requests.
+func (c TypedCRUD[T]) CreateContext(ctx context.Context, entity T) (T, error) {
+	config, err := Encode(entity)
+	if err != nil {
+		var value T
+		return value, err
+	}
+	return decodeResult[T](c.crud.CreateContext(ctx, config))
+}
+
+// Update updates the entity with the given ID and returns it as it was stored.
+func (c TypedCRUD[T]) Update(id uuid.UUID, entity T) (T, error) {
+	config, err := Encode(entity)
+	if err != nil {
+		var value T
+		return value, err
+	}
+	return decodeResult[T](c.crud.Update(id, config))
+}
+
+// UpdateContext is like Update, but it uses the given context for its requests.
+func (c TypedCRUD[T]) UpdateContext(ctx context.Context, id uuid.UUID, entity T) (T, error) {
+	config, err := Encode(entity)
+	if err != nil {
+		var value T
+		return value, err
+	}
+	return decodeResult[T](c.crud.UpdateContext(ctx, id, config))
+}
+
+// Delete deletes the entity with the given ID and returns the response of Discovery.
+func (c TypedCRUD[T]) Delete(id uuid.UUID) (gjson.Result, error) {
+	return c.crud.Delete(id)
+}
+
+// DeleteContext is like Delete, but it uses the given context for its requests.
+func (c TypedCRUD[T]) DeleteContext(ctx context.Context, id uuid.UUID) (gjson.Result, error) {
+	return c.crud.DeleteContext(ctx, id)
+}
+
+// TypedSearcher wraps a Searcher so that it returns values of the given type instead of JSON results.
+type TypedSearcher[T any] struct {
+	searcher Searcher
+}
+
+// NewTypedSearcher returns a TypedSearcher that wraps the given Searcher.
+// For example, NewTypedSearcher[Seed](ingestion.Seeds()).
+func NewTypedSearcher[T any](searcher Searcher) TypedSearcher[T] {
+	return TypedSearcher[T]{searcher: searcher}
+}
+
+// Search obtains every entity that matches the filter.
+func (s TypedSearcher[T]) Search(filter gjson.Result, options ...RequestOption) ([]T, error) {
+	return decodeResults[T](s.searcher.Search(filter, options...))
+}
+
+// SearchContext is like Search, but it uses the given context for its requests.
+func (s TypedSearcher[T]) SearchContext(ctx context.Context, filter gjson.Result, options ...RequestOption) ([]T, error) {
+	return decodeResults[T](s.searcher.SearchContext(ctx, filter, options...))
+}
+
+// SearchSeq returns a sequence that iterates through every entity that matches the filter.
+func (s TypedSearcher[T]) SearchSeq(filter gjson.Result, options ...RequestOption) iter.Seq2[T, error] {
+	return decodeSeq[T](s.searcher.SearchSeq(filter, options...))
+}
+
+// SearchSeqContext is like SearchSeq, but it uses the given context for its requests.
+func (s TypedSearcher[T]) SearchSeqContext(ctx context.Context, filter gjson.Result, options ...RequestOption) iter.Seq2[T, error] {
+	return decodeSeq[T](s.searcher.SearchSeqContext(ctx, filter, options...))
+}
+
+// SearchByName obtains the entity with the given name.
+func (s TypedSearcher[T]) SearchByName(name string) (T, error) {
+	return decodeResult[T](s.searcher.SearchByName(name))
+}
+
+// SearchByNameContext is like SearchByName, but it uses the given context for its requests.
+func (s TypedSearcher[T]) SearchByNameContext(ctx context.Context, name string) (T, error) {
+	return decodeResult[T](s.searcher.SearchByNameContext(ctx, name))
+}
@@ -0,0 +1,141 @@
+package discovery
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// typedTestServer starts a test server with the endpoints used by the tests of the typed wrappers.
+func typedTestServer(t *testing.T) *httptest.Server {
+	serverJSON := `{"type":"mongo","name":"my-server","id":"21029da3-041c-43b5-a67e-870251f2f6a6","credential":"3b32e410-2f33-412d-9fb8-17970131921c","pool":{"size":5}}`
+	srv := httptest.NewServer(testutils.HttpMultiResponseHandler(t, map[string]testutils.MockResponse{
+		"GET:/v2/server/21029da3-041c-43b5-a67e-870251f2f6a6": {
+			StatusCode: http.StatusOK, ContentType: "application/json", Body: serverJSON,
+		},
+		"GET:/v2/server": {
+			StatusCode: http.StatusOK, ContentType: "application/json",
+			Body: `{"content":[` + serverJSON + `,{"name":"other-server"}],"numberOfElements":2,"pageNumber":0,"totalPages":1,"totalSize":2}`,
+		},
+		"POST:/v2/server": {
+			StatusCode: http.StatusOK, ContentType: "application/json", Body: serverJSON,
+			Assertions: func(t *testing.T, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"type":"mongo","name":"my-server","pool":{"size":5}}`, string(body))
+			},
+		},
+		"POST:/v2/server/search": {
+			StatusCode: http.StatusOK, ContentType: "application/json",
+			Body: `{"content":[{"source":` + serverJSON + `}],"numberOfElements":1,"pageNumber":0,"totalPages":1,"totalSize":1}`,
+		},
+		"GET:/v2/server/3b32e410-2f33-412d-9fb8-17970131921c": {
+			StatusCode: http.StatusOK, ContentType: "application/json", Body: `{"id":"not-a-uuid"}`,
+		},
+		"DELETE:/v2/server/86e7f920-a4e4-4b64-be84-5437a7673db8": {
+			StatusCode: http.StatusNotFound, ContentType: "application/json", Body: `{"status":404,"code":1003,"messages":["Entity not found"]}`,
+		},
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// TestTypedCRUD tests that the TypedCRUD decodes the results into the given type and encodes the entities it receives.
+func TestTypedCRUD(t *testing.T) {
+	srv := typedTestServer(t)
+	servers := NewTypedCRUD[Server](NewClient(WithCore(srv.URL, "apiKey")).Core().Servers())
+	id := uuid.MustParse("21029da3-041c-43b5-a67e-870251f2f6a6")
+
+	server, err := servers.Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, id, server.Id)
+	assert.Equal(t, "my-server", server.Name)
+	assert.Equal(t, uuid.MustParse("3b32e410-2f33-412d-9fb8-17970131921c"), server.Credential)
+
+	all, err := servers.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "other-server", all[1].Name)
+
+	names := []string{}
+	for server, err := range servers.GetAllSeq() {
+		require.NoError(t, err)
+		names = append(names, server.Name)
+	}
+	assert.Equal(t, []string{"my-server", "other-server"}, names)
+
+	created, err := servers.Create(Server{Entity: Entity{Name: "my-server"}, Type: "mongo", Extra: server.Extra})
+	require.NoError(t, err)
+	assert.Equal(t, id, created.Id)
+
+	server, err = servers.GetContext(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, "my-server", server.Name)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = servers.CreateContext(canceled, Server{Entity: Entity{Name: "my-server"}, Type: "mongo"})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// TestTypedCRUD_Errors tests that the TypedCRUD returns the errors of the requests and of the decoding.
+func TestTypedCRUD_Errors(t *testing.T) {
+	srv := typedTestServer(t)
+	servers := NewTypedCRUD[Server](NewClient(WithCore(srv.URL, "apiKey")).Core().Servers())
+
+	_, err := servers.Get(uuid.MustParse("3b32e410-2f33-412d-9fb8-17970131921c"))
+	assert.ErrorContains(t, err, "invalid UUID")
+
+	_, err = servers.Delete(uuid.MustParse("86e7f920-a4e4-4b64-be84-5437a7673db8"))
+	assert.True(t, IsNotFound(err))
+}
+
+// TestTypedSearcher tests that the TypedSearcher decodes the search results into the given type.
+func TestTypedSearcher(t *testing.T) {
+	srv := typedTestServer(t)
+	servers := NewTypedSearcher[Server](NewClient(WithCore(srv.URL, "apiKey")).Core().Servers())
+
+	results, err := servers.Search(gjson.Parse(`{"equals":{"field":"type","value":"mongo"}}`))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "my-server", results[0].Name)
+
+	server, err := servers.SearchByName("my-server")
+	require.NoError(t, err)
+	assert.Equal(t, "mongo", server.Type)
+
+	server, err = servers.SearchByNameContext(context.Background(), "my-server")
+	require.NoError(t, err)
+	assert.Equal(t, "mongo", server.Type)
+}
+
+// TestDecodeSeq tests that the typed sequences yield the decoding errors and stop.
+func TestDecodeSeq(t *testing.T) {
+	seq := func(yield func(gjson.Result, error) bool) {
+		for _, raw := range []string{`{"name":"a"}`, `{"id":1}`, `{"name":"c"}`} {
+			if !yield(gjson.Parse(raw), nil) {
+				return
+			}
+		}
+	}
+
+	names := []string{}
+	var lastErr error
+	for seed, err := range decodeSeq[Seed](seq) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		names = append(names, seed.Name)
+	}
+	assert.Equal(t, []string{"a"}, names)
+	assert.Error(t, lastErr)
+}
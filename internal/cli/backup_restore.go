@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"bufio"
 	"bytes"
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -19,7 +20,7 @@ import (
 
 // BackupRestore defines methods to backup and restore entities in Discovery.
 type BackupRestore interface {
-	Export() ([]byte, string, error)
+	Export(w io.Writer) (string, error)
 	Import(discoveryPackage.OnConflict, string) (gjson.Result, error)
 }
 
@@ -32,9 +33,26 @@ func RenderExportStatus(err error) (gjson.Result, error) {
 	return gjson.Parse(`{"acknowledged": true}`), nil
 }
 
-// WriteExport calls the Export endpoint and writes the results into a file in the given path.
-func WriteExport(client BackupRestore, path string) (gjson.Result, error) {
-	zipBytes, name, err := client.Export()
+// WriteExport calls the Export endpoint and streams the results into a file in the given path.
+// If the path is empty, the file is saved in the current directory with the name sent by Discovery.
+// The export is written to a temporary file in the same directory, which is renamed once the export finishes, so a failed export does not leave an incomplete file.
+// If progress is not nil, the number of bytes received is reported to it.
+func WriteExport(client BackupRestore, path string, progress io.Writer) (gjson.Result, error) {
+	dir := "."
+	if path != "" {
+		dir = filepath.Dir(path)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".discovery-export-*")
+	if err != nil {
+		return RenderExportStatus(NormalizeWriteFileError(cmp.Or(path, dir), err))
+	}
+	defer os.Remove(tmp.Name())
+
+	tracker := NewProgressWriter(progress, "Exporting entities")
+	name, err := client.Export(io.MultiWriter(tmp, tracker))
+	tracker.Finish()
+	closeErr := tmp.Close()
 	if err != nil {
 		return RenderExportStatus(err)
 	}
@@ -43,16 +61,21 @@ func WriteExport(client BackupRestore, path string) (gjson.Result, error) {
 		path = filepath.Join(".", name)
 	}
 
-	err = os.WriteFile(path, zipBytes, 0o644)
-	if err != nil {
-		err = NormalizeWriteFileError(path, err)
+	if closeErr != nil {
+		return RenderExportStatus(NormalizeWriteFileError(path, closeErr))
+	}
+
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return RenderExportStatus(NormalizeWriteFileError(path, err))
 	}
-	return RenderExportStatus(err)
+
+	return RenderExportStatus(NormalizeWriteFileError(path, os.Rename(tmp.Name(), path)))
 }
 
 // ExportEntitiesFromClient exports the entities from a single Discovery product and prints the acknowledgement message.
+// The progress of the export is reported to the error stream if it is a terminal.
 func (d discovery) ExportEntitiesFromClient(client BackupRestore, path string, printer Printer) error {
-	result, err := WriteExport(client, path)
+	result, err := WriteExport(client, path, progressOutput(d.IOStreams()))
 	if err != nil {
 		return err
 	}
@@ -70,8 +93,44 @@ type BackupRestoreClientEntry struct {
 	Client BackupRestore
 }
 
-// WriteExportsIntoFile calls the export endpoints and writes the information into a file.
-func WriteExportsIntoFile(path string, clients []BackupRestoreClientEntry) (string, error) {
+// writeExportEntry streams the export of a Discovery product into a temporary file and then copies it into the zip file, with the name of the product as a prefix.
+// The temporary file is needed because the name of the export is only known once Discovery responds, and it keeps the export out of memory.
+// If progress is not nil, the number of bytes received is reported to it.
+func writeExportEntry(zipWriter *zip.Writer, entry BackupRestoreClientEntry, progress io.Writer) error {
+	tmp, err := os.CreateTemp("", "discovery-export-*")
+	if err != nil {
+		return NormalizeWriteFileError(os.TempDir(), err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	tracker := NewProgressWriter(progress, fmt.Sprintf("Exporting %s entities", entry.Name))
+	name, err := entry.Client.Export(io.MultiWriter(tmp, tracker))
+	tracker.Finish()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	fw, err := zipWriter.CreateHeader(&zip.FileHeader{
+		Name:   fmt.Sprintf("%s-%s", entry.Name, name),
+		Method: zip.Store,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, tmp)
+	return err
+}
+
+// WriteExportsIntoFile calls the export endpoints and streams the information into a file.
+// The result of each export is added to the returned JSON, so a failed export does not stop the others.
+// If progress is not nil, the number of bytes received from each Discovery product is reported to it.
+func WriteExportsIntoFile(path string, clients []BackupRestoreClientEntry, progress io.Writer) (string, error) {
 	zipFile, err := os.OpenFile(
 		path,
 		os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
@@ -87,39 +146,9 @@ func WriteExportsIntoFile(path string, clients []BackupRestoreClientEntry) (stri
 	zipWriter := zip.NewWriter(zipFile)
 	defer zipWriter.Close()
 
-	appendStatus := func(apiName string, err error) (string, error) {
-		exportResult, _ := RenderExportStatus(err)
-		return sjson.SetRaw(result, apiName, exportResult.Raw)
-	}
-
 	for _, entry := range clients {
-		apiName := entry.Name
-		client := entry.Client
-		zipBytes, name, err := client.Export()
-		if err != nil {
-			result, err = appendStatus(apiName, err)
-			if err != nil {
-				return "", err
-			}
-			continue
-		}
-
-		h := &zip.FileHeader{
-			Name:   fmt.Sprintf("%s-%s", apiName, name),
-			Method: zip.Store,
-		}
-
-		fw, err := zipWriter.CreateHeader(h)
-		if err != nil {
-			result, err = appendStatus(apiName, err)
-			if err != nil {
-				return "", err
-			}
-			continue
-		}
-
-		_, err = fw.Write(zipBytes)
-		result, err = appendStatus(apiName, err)
+		exportResult, _ := RenderExportStatus(writeExportEntry(zipWriter, entry, progress))
+		result, err = sjson.SetRaw(result, entry.Name, exportResult.Raw)
 		if err != nil {
 			return "", err
 		}
@@ -129,12 +158,13 @@ func WriteExportsIntoFile(path string, clients []BackupRestoreClientEntry) (stri
 }
 
 // ExportEntitiesFromClients exports the entities from Discovery Core, Ingestion, and QueryFlow, writes the export files into the given path, and prints out the results.
+// The progress of the exports is reported to the error stream if it is a terminal.
 func (d discovery) ExportEntitiesFromClients(clients []BackupRestoreClientEntry, path string, printer Printer) error {
 	if path == "" {
 		path = filepath.Join(".", "discovery.zip")
 	}
 
-	result, err := WriteExportsIntoFile(path, clients)
+	result, err := WriteExportsIntoFile(path, clients, progressOutput(d.IOStreams()))
 	if err != nil {
 		return NewErrorWithCause(ErrorExitCode, err, "Could not export entities")
 	}
@@ -71,9 +71,10 @@ var queryflowImport, _ = os.ReadFile("testdata/queryflow-import.json")
 // WorkingCoreBackupRestore mocks a working backup restore.
 type WorkingCoreBackupRestore struct{}
 
-// Export returns zip bytes as if the request worked successfully.
-func (g *WorkingCoreBackupRestore) Export() ([]byte, string, error) {
-	return coreBytes, "export-20251110T1455.zip", nil
+// Export writes zip bytes as if the request worked successfully.
+func (g *WorkingCoreBackupRestore) Export(w io.Writer) (string, error) {
+	_, err := w.Write(coreBytes)
+	return "export-20251110T1455.zip", err
 }
 
 // Import implements the interface.
@@ -84,9 +85,10 @@ func (g *WorkingCoreBackupRestore) Import(discoveryPackage.OnConflict, string) (
 // WorkingIngestionBackupRestore mocks a working backup restore.
 type WorkingIngestionBackupRestore struct{}
 
-// Export returns zip bytes as if the request worked successfully.
-func (g *WorkingIngestionBackupRestore) Export() ([]byte, string, error) {
-	return ingestionBytes, "export-20251110T1455.zip", nil
+// Export writes zip bytes as if the request worked successfully.
+func (g *WorkingIngestionBackupRestore) Export(w io.Writer) (string, error) {
+	_, err := w.Write(ingestionBytes)
+	return "export-20251110T1455.zip", err
 }
 
 // Import implements the interface.
@@ -97,9 +99,10 @@ func (g *WorkingIngestionBackupRestore) Import(discoveryPackage.OnConflict, stri
 // WorkingQueryFlowBackupRestore mocks a working backup restore.
 type WorkingQueryFlowBackupRestore struct{}
 
-// Export returns zip bytes as if the request worked successfully.
-func (g *WorkingQueryFlowBackupRestore) Export() ([]byte, string, error) {
-	return queryflowBytes, "export-20251110T1455.zip", nil
+// Export writes zip bytes as if the request worked successfully.
+func (g *WorkingQueryFlowBackupRestore) Export(w io.Writer) (string, error) {
+	_, err := w.Write(queryflowBytes)
+	return "export-20251110T1455.zip", err
 }
 
 // Import implements the interface.
@@ -156,7 +159,7 @@ func TestWriteExport(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			acknowledge, err := WriteExport(tc.client, tc.path)
+			acknowledge, err := WriteExport(tc.client, tc.path, nil)
 
 			assert.Equal(t, tc.expectedAcknowledge, acknowledge)
 			if tc.err != nil {
@@ -174,6 +177,27 @@ func TestWriteExport(t *testing.T) {
 	}
 }
 
+// TestWriteExport_Streams tests that WriteExport reports the progress of the export and does not leave an incomplete file when the export fails.
+func TestWriteExport_Streams(t *testing.T) {
+	dir := t.TempDir()
+	progress := &bytes.Buffer{}
+	acknowledge, err := WriteExport(new(WorkingCoreBackupRestore), filepath.Join(dir, "export.zip"), progress)
+	require.NoError(t, err)
+	assert.Equal(t, gjson.Parse(`{"acknowledged": true}`), acknowledge)
+	assert.Equal(t, fmt.Sprintf("\rExporting entities: %s\rExporting entities: %s\n", formatBytes(int64(len(coreBytes))), formatBytes(int64(len(coreBytes)))), progress.String())
+
+	info, err := os.Stat(filepath.Join(dir, "export.zip"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(coreBytes)), info.Size())
+
+	failing := t.TempDir()
+	_, err = WriteExport(new(mocks.FailingBackupRestore), filepath.Join(failing, "export.zip"), nil)
+	require.Error(t, err)
+	entries, err := os.ReadDir(failing)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
 // TestExportEntitiesFromClient tests the ExportEntitiesFromClient() function.
 func TestExportEntitiesFromClient(t *testing.T) {
 	testutils.ChangeDirectoryHelper(t)
@@ -306,7 +330,7 @@ func TestWriteExportsIntoFile(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			acknowledge, err := WriteExportsIntoFile(tc.path, tc.clients)
+			acknowledge, err := WriteExportsIntoFile(tc.path, tc.clients, nil)
 			assert.Equal(t, tc.expectedOutput, acknowledge)
 			if tc.err != nil {
 				require.Error(t, err)
@@ -322,7 +346,8 @@ func TestWriteExportsIntoFile(t *testing.T) {
 					files[f.Name] = f
 				}
 				for _, client := range tc.clients {
-					exportBytes, filename, err := client.Client.Export()
+					exportBytes := &bytes.Buffer{}
+					filename, err := client.Client.Export(exportBytes)
 					if err == nil {
 						exportedFile, ok := files[fmt.Sprintf("%s-%s", client.Name, filename)]
 						require.True(t, ok)
@@ -331,7 +356,7 @@ func TestWriteExportsIntoFile(t *testing.T) {
 						gotBytes, err := io.ReadAll(fileContent)
 						require.NoError(t, err)
 						fileContent.Close()
-						assert.Equal(t, exportBytes, gotBytes)
+						assert.Equal(t, exportBytes.Bytes(), gotBytes)
 					}
 				}
 			}
@@ -339,6 +364,16 @@ func TestWriteExportsIntoFile(t *testing.T) {
 	}
 }
 
+// TestWriteExportsIntoFile_Progress tests that the progress of the export of each Discovery product is reported.
+func TestWriteExportsIntoFile_Progress(t *testing.T) {
+	progress := &bytes.Buffer{}
+	clients := []BackupRestoreClientEntry{{Name: "core", Client: new(WorkingCoreBackupRestore)}, {Name: "ingestion", Client: new(mocks.FailingBackupRestore)}}
+	_, err := WriteExportsIntoFile(filepath.Join(t.TempDir(), "export.zip"), clients, progress)
+	require.NoError(t, err)
+	assert.Contains(t, progress.String(), fmt.Sprintf("\rExporting core entities: %s\n", formatBytes(int64(len(coreBytes)))))
+	assert.Contains(t, progress.String(), "\rExporting ingestion entities: 0 B\n")
+}
+
 // TestExportEntitiesFromClients tests the TestExportEntitiesFromClients() function.
 func TestExportEntitiesFromClients(t *testing.T) {
 	testutils.ChangeDirectoryHelper(t)
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"context"
+	"strings"
+
 	"github.com/google/uuid"
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/pureinsights/discovery-cli/internal/iostreams"
@@ -29,18 +32,25 @@ type Discovery interface {
 	StoreFiles(client CoreFileController, key string, recursive bool, printer Printer) error
 	DeleteFile(client CoreFileController, key string, printer Printer) error
 	GetEntity(client Getter, id uuid.UUID, printer Printer) error
-	GetEntities(client Getter, printer Printer) error
+	GetEntities(client Getter, config ListConfig, printer StreamPrinter) error
 	searchEntity(client Searcher, id string) (gjson.Result, error)
 	SearchEntity(client Searcher, id string, printer Printer) error
-	SearchEntities(client Searcher, filter gjson.Result, printer Printer) error
+	SearchEntities(client Searcher, filter gjson.Result, config ListConfig, printer StreamPrinter) error
 	UpsertEntities(client Creator, configurations gjson.Result, abortOnError bool, printer Printer) error
 	SearchUpsertEntities(client SearchCreator, configurations gjson.Result, abortOnError bool, printer Printer) error
 	DeleteEntity(client Deleter, id uuid.UUID, printer Printer) error
 	SearchDeleteEntity(client SearchDeleter, name string, printer Printer) error
+	SearchCloneEntity(client SearchCloner, name string, config CloneConfig, printer Printer) error
+	SearchEnableEntities(client SearchEnabler, names []string, filter gjson.Result, enable bool, printer Printer) error
 	SearchDumpBucket(client Searcher, contentProvider func(string) StagingContentController, nameOrID string, config DumpConfig, printer Printer) error
+	PurgeBucket(client StagingBucketPurger, nameOrID string, yes bool, printer Printer) error
+	ListBucketIndices(client Searcher, nameOrID string, printer Printer) error
+	AddBucketIndex(client StagingBucketIndexController, nameOrID, index, fields string, printer Printer) error
+	RemoveBucketIndex(client StagingBucketIndexController, nameOrID, index string, printer Printer) error
 	StartSeed(client IngestionSeedController, name string, scanType discoveryPackage.ScanType, properties gjson.Result, printer Printer) error
 	HaltSeed(client IngestionSeedController, name string, printer Printer) error
 	HaltSeedExecution(client IngestionSeedExecutionController, execution uuid.UUID, printer Printer) error
+	ResetSeed(ctx context.Context, client IngestionSeedResetter, executions func(uuid.UUID) LastExecutionsGetter, name string, config ResetSeedConfig, printer Printer) error
 	AppendSeedRecord(seed gjson.Result, client RecordGetter, id string, printer Printer) error
 	AppendSeedRecords(seed gjson.Result, client RecordGetter, printer Printer) error
 	SeedExecution(client SeedExecutionGetter, seedExecutionId uuid.UUID, summarizers map[string]Summarizer, details bool, printer Printer) error
@@ -52,6 +62,9 @@ type Discovery interface {
 	StatusCheck(client StatusChecker, product string, printer Printer) error
 	StatusCheckOfClients(clients []StatusCheckClientEntry, printer Printer) error
 	PingServer(client ServerPinger, server string, printer Printer) error
+	ChangeLogLevel(ctx context.Context, client LogLeveler, config LogLevelConfig, printer Printer) error
+	InvokeEndpoint(client EndpointInvoker, uri string, config InvokeConfig, printer Printer) error
+	DebugEndpoint(client EndpointInvoker, processors Getter, uri string, invoke InvokeConfig, config DebugConfig) error
 	Deploy(fileClient CoreFileController, clients []BackupRestoreClientEntry, path string, printer Printer) error
 }
 
@@ -85,3 +98,15 @@ func NewDiscovery(io *iostreams.IOStreams, config *viper.Viper, configPath strin
 func (d discovery) ConfigPath() string {
 	return d.configPath
 }
+
+// confirm asks the user the given yes or no question. It returns true if the user answered "y" or "yes", ignoring the case.
+// Any other answer, like an empty one, is a no.
+func confirm(ios *iostreams.IOStreams, question string) (bool, error) {
+	answer, err := ios.AskUser(question + " [y/N]: ")
+	if err != nil {
+		return false, NewErrorWithCause(ErrorExitCode, err, "Could not read the answer of the user")
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
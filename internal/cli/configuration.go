@@ -141,10 +141,23 @@ func (d discovery) askUserConfig(profile, propertyName, property string, sensiti
 	return nil
 }
 
-// saveConfig separates the API Keys from Discovery's Viper configuration and writes the config and credentials into their own files.
+// credentialProperties are the suffixes of the properties of the Discovery components that are saved in the credentials file,
+// like the API key in core_key or the OAuth2 client secret in core_client_secret.
+var credentialProperties = []string{"key", "auth", "token", "username", "password", "token_url", "client_id", "client_secret", "scopes"}
+
+// isCredential checks if the property is one of the credential properties of a Discovery component.
+func isCredential(property string) bool {
+	for _, component := range []string{"core", "ingestion", "queryflow", "staging"} {
+		if suffix, found := strings.CutPrefix(property, component+"_"); found && slices.Contains(credentialProperties, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// saveConfig separates the credentials, like the API keys, from Discovery's Viper configuration and writes the config and credentials into their own files.
 func saveConfig(v *viper.Viper, path string) error {
-	apiKeys := []string{"core_key", "ingestion_key", "queryflow_key", "staging_key"}
-	temporaryProperties := []string{"profile"}
+	temporaryProperties := []string{"profile", "timeout", "retries", "retry_wait", "concurrency", "rate_limit", "verbose", "trace"}
 
 	config := viper.New()
 	credentials := viper.New()
@@ -153,7 +166,7 @@ func saveConfig(v *viper.Viper, path string) error {
 		switch {
 		case slices.Contains(temporaryProperties, setting):
 
-		case slices.Contains(apiKeys, strings.Split(setting, ".")[len(strings.Split(setting, "."))-1]):
+		case isCredential(strings.Split(setting, ".")[len(strings.Split(setting, "."))-1]):
 			credentials.Set(setting, v.Get(setting))
 		default:
 			config.Set(setting, v.Get(setting))
@@ -234,7 +247,33 @@ func (d discovery) SaveStagingConfigFromUser(profile string) error {
 	return d.saveUrlAndAPIKey(profile, "staging", "Staging")
 }
 
-// SaveConfigFromUser asks the user for the URLs and API Keys of the Discovery's components to save them in a profile.
+// connectionProperties are the TLS and proxy properties of a profile.
+// The name is shown to the user and the description is used in the error messages.
+var connectionProperties = []struct {
+	property, name, description string
+}{
+	{"ca_cert", "CA certificate file", "CA certificate file"},
+	{"client_cert", "Client certificate file", "client certificate file"},
+	{"client_key", "Client key file", "client key file"},
+	{"insecure_skip_verify", "Skip TLS verification (true/false)", "TLS verification setting"},
+	{"proxy_url", "Proxy URL", "proxy URL"},
+}
+
+// saveConnectionConfigFromUser asks the user for the TLS and proxy settings of the profile and saves them.
+func (d discovery) saveConnectionConfigFromUser(profile string) error {
+	for _, p := range connectionProperties {
+		if err := d.askUserConfig(profile, p.name, p.property, false); err != nil {
+			return NewErrorWithCause(ErrorExitCode, err, "Failed to get the %s", p.description)
+		}
+	}
+
+	if err := saveConfig(d.Config(), d.ConfigPath()); err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Failed to save the TLS and proxy configuration")
+	}
+	return nil
+}
+
+// SaveConfigFromUser asks the user for the URLs and API Keys of the Discovery's components, and the TLS and proxy settings, to save them in a profile.
 // It then writes the current configuration into the given file.
 func (d discovery) SaveConfigFromUser(profile string) error {
 	err := d.SaveCoreConfigFromUser(profile)
@@ -249,7 +288,11 @@ func (d discovery) SaveConfigFromUser(profile string) error {
 	if err != nil {
 		return err
 	}
-	return d.SaveStagingConfigFromUser(profile)
+	err = d.SaveStagingConfigFromUser(profile)
+	if err != nil {
+		return err
+	}
+	return d.saveConnectionConfigFromUser(profile)
 }
 
 // printConfig is the auxiliary function to print a property's value to the user.
@@ -308,7 +351,17 @@ func (d discovery) PrintStagingConfigToUser(profile string, sensitive bool) erro
 	return d.printURLAndAPIKey(profile, "staging", "Staging", sensitive)
 }
 
-// PrintConfigToUser prints the Discovery Components' configuration properties for the given profile.
+// printConnectionConfig prints the TLS and proxy settings of the profile that are set to the Out IOStream.
+func (d discovery) printConnectionConfig(profile string) error {
+	for _, p := range connectionProperties {
+		if err := d.printConfig(profile, p.name, p.property, false); err != nil {
+			return NewErrorWithCause(ErrorExitCode, err, "Could not print the %s", p.description)
+		}
+	}
+	return nil
+}
+
+// PrintConfigToUser prints the Discovery Components' configuration properties, and the TLS and proxy settings, for the given profile.
 // The caller of the function can determine if the API Keys are sensitive so that they can be obfuscated.
 func (d discovery) PrintConfigToUser(profile string, sensitive bool) error {
 	err := d.PrintCoreConfigToUser(profile, sensitive)
@@ -323,5 +376,9 @@ func (d discovery) PrintConfigToUser(profile string, sensitive bool) error {
 	if err != nil {
 		return err
 	}
-	return d.PrintStagingConfigToUser(profile, sensitive)
+	err = d.PrintStagingConfigToUser(profile, sensitive)
+	if err != nil {
+		return err
+	}
+	return d.printConnectionConfig(profile)
 }
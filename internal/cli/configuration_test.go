@@ -12,10 +12,12 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/pureinsights/discovery-cli/internal/fileutils"
 	"github.com/pureinsights/discovery-cli/internal/iostreams"
 	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -533,6 +535,41 @@ func Test_saveConfig(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name:      "The authentication properties are saved in the credentials file",
+			writePath: t.TempDir(),
+			config: map[string]string{
+				"cn.core_url":            "http://localhost:12010",
+				"cn.core_auth":           "oauth2",
+				"cn.core_token_url":      "https://auth.example.com/token",
+				"cn.core_client_id":      "client",
+				"cn.core_client_secret":  "secret",
+				"cn.core_scopes":         "read,write",
+				"cn.staging_auth":        "basic",
+				"cn.staging_username":    "user",
+				"cn.staging_password":    "pass",
+				"cn.queryflow_auth":      "bearer",
+				"cn.queryflow_token":     "token",
+				"cn.ingestion_something": "value",
+			},
+			expectedConfig: map[string]string{
+				"cn.core_url":            "http://localhost:12010",
+				"cn.ingestion_something": "value",
+			},
+			expectedCredentials: map[string]string{
+				"cn.core_auth":          "oauth2",
+				"cn.core_token_url":     "https://auth.example.com/token",
+				"cn.core_client_id":     "client",
+				"cn.core_client_secret": "secret",
+				"cn.core_scopes":        "read,write",
+				"cn.staging_auth":       "basic",
+				"cn.staging_username":   "user",
+				"cn.staging_password":   "pass",
+				"cn.queryflow_auth":     "bearer",
+				"cn.queryflow_token":    "token",
+			},
+			err: nil,
+		},
 		{
 			name:      "No keys exist",
 			writePath: t.TempDir(),
@@ -569,6 +606,25 @@ func Test_saveConfig(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name:      "The profile's timeout is saved in the config file",
+			writePath: t.TempDir(),
+			config: map[string]string{
+				"timeout":     "10s",
+				"cn.core_url": "http://localhost:12010",
+				"cn.core_key": "core321",
+				"cn.timeout":  "1m",
+			},
+			expectedConfig: map[string]string{
+				"cn.core_url": "http://localhost:12010",
+				"cn.timeout":  "1m",
+				"timeout":     "",
+			},
+			expectedCredentials: map[string]string{
+				"cn.core_key": "core321",
+			},
+			err: nil,
+		},
 		{
 			name:      "There are keys with multiple periods in their viper keys",
 			writePath: t.TempDir(),
@@ -660,6 +716,38 @@ func Test_saveConfig(t *testing.T) {
 	}
 }
 
+// Test_saveConfig_BoundFlags tests that saveConfig() does not save the values of the global flags that are bound to the configuration,
+// so that they do not override the properties of the profiles the next time the configuration is read.
+func Test_saveConfig_BoundFlags(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.PersistentFlags().Duration("timeout", 0, "")
+	cmd.PersistentFlags().Int("retries", 3, "")
+	cmd.PersistentFlags().Duration("retry-wait", time.Second, "")
+	cmd.PersistentFlags().Int("concurrency", 1, "")
+	cmd.PersistentFlags().Float64("rate-limit", 0, "")
+	cmd.PersistentFlags().Bool("verbose", false, "")
+	cmd.PersistentFlags().Bool("trace", false, "")
+
+	vpr := viper.New()
+	for key, flag := range map[string]string{"timeout": "timeout", "retries": "retries", "retry_wait": "retry-wait", "concurrency": "concurrency", "rate_limit": "rate-limit", "verbose": "verbose", "trace": "trace"} {
+		require.NoError(t, vpr.BindPFlag(key, cmd.PersistentFlags().Lookup(flag)))
+	}
+	vpr.Set("cn.core_url", "http://localhost:12010")
+	vpr.Set("cn.rate_limit", "5")
+
+	path := t.TempDir()
+	require.NoError(t, saveConfig(vpr, path))
+
+	configVpr := viper.New()
+	configVpr.SetConfigFile(filepath.Join(path, "config.toml"))
+	require.NoError(t, configVpr.ReadInConfig())
+	for _, key := range []string{"timeout", "retries", "retry_wait", "concurrency", "rate_limit", "verbose", "trace"} {
+		assert.False(t, configVpr.IsSet(key), key)
+	}
+	assert.Equal(t, "5", configVpr.GetString("cn.rate_limit"))
+	assert.Equal(t, "http://localhost:12010", configVpr.GetString("cn.core_url"))
+}
+
 // TestSetDiscoveryDir_MkDirAllFails tests the SetDiscoveryDir() function when the ~/.discovery directory could not be made.
 func TestSetDiscoveryDir_MkDirAllFails(t *testing.T) {
 	tmp := t.TempDir()
@@ -1387,6 +1475,98 @@ func Test_discovery_SaveStagingConfigFromUser(t *testing.T) {
 	}
 }
 
+// Test_discovery_saveConnectionConfigFromUser tests the discovery.saveConnectionConfigFromUser() function.
+func Test_discovery_saveConnectionConfigFromUser(t *testing.T) {
+	const profile = "cn"
+
+	tests := []struct {
+		name       string
+		input      string
+		config     map[string]string
+		inReader   io.Reader
+		writePath  string
+		err        error
+		expectKeys map[string]string
+	}{
+		{
+			name:      "Set every TLS and proxy property",
+			input:     "/certs/ca.pem\n/certs/client.pem\n/certs/client-key.pem\nfalse\nhttp://proxy.example.com:3128\n",
+			writePath: t.TempDir(),
+			expectKeys: map[string]string{
+				"ca_cert":              "/certs/ca.pem",
+				"client_cert":          "/certs/client.pem",
+				"client_key":           "/certs/client-key.pem",
+				"insecure_skip_verify": "false",
+				"proxy_url":            "http://proxy.example.com:3128",
+			},
+		},
+		{
+			name:      "Keep the existing values and set the proxy to empty",
+			input:     "\n\n\n\n \n",
+			writePath: t.TempDir(),
+			config: map[string]string{
+				"cn.ca_cert":   "/certs/ca.pem",
+				"cn.proxy_url": "http://proxy.example.com:3128",
+			},
+			expectKeys: map[string]string{
+				"ca_cert":   "/certs/ca.pem",
+				"proxy_url": "",
+			},
+		},
+		{
+			name:      "Reading the proxy URL fails",
+			inReader:  io.MultiReader(strings.NewReader("\n\n\n\n"), testutils.ErrReader{Err: errors.New("read failed")}),
+			writePath: t.TempDir(),
+			err:       NewErrorWithCause(ErrorExitCode, fmt.Errorf("read failed"), "Failed to get the proxy URL"),
+		},
+		{
+			name:      "Invalid write location",
+			input:     strings.Repeat("\n", 5),
+			writePath: "doesnotexist",
+			err:       NewErrorWithCause(ErrorExitCode, fmt.Errorf("the given path does not exist: %s", filepath.Join("doesnotexist", "config.toml")), "Failed to save the TLS and proxy configuration"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var in io.Reader
+			if tc.inReader != nil {
+				in = tc.inReader
+			} else {
+				in = strings.NewReader(tc.input)
+			}
+
+			ios := iostreams.IOStreams{
+				In:  in,
+				Out: &bytes.Buffer{},
+				Err: os.Stderr,
+			}
+
+			vpr := viper.New()
+			for k, v := range tc.config {
+				vpr.Set(k, v)
+			}
+
+			d := discovery{iostreams: &ios, config: vpr, configPath: tc.writePath}
+
+			err := d.saveConnectionConfigFromUser(profile)
+			if tc.err != nil {
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				vpr, err := InitializeConfig(ios, tc.writePath)
+				require.NoError(t, err)
+
+				for k, expected := range tc.expectKeys {
+					assert.Equal(t, expected, vpr.GetString(profile+"."+k))
+				}
+			}
+		})
+	}
+}
+
 // Test_discovery_printConfig tests the discovery.PrintConfig() function.
 func Test_discovery_printConfig(t *testing.T) {
 	tests := []struct {
@@ -1968,6 +2148,31 @@ func Test_discovery_PrintConfigToUser(t *testing.T) {
 			outWriter:      nil,
 			err:            nil,
 		},
+		{
+			name:      "Print the TLS and proxy settings",
+			profile:   "cn",
+			sensitive: true,
+			config: map[string]string{
+				"cn.core_url":             "http://localhost:12010",
+				"cn.ca_cert":              "/certs/ca.pem",
+				"cn.insecure_skip_verify": "false",
+				"cn.proxy_url":            "http://proxy.example.com:3128",
+			},
+			expectedOutput: fmt.Sprintf("%s: %q\n%s: %q\n%s: %q\n%s: %q\n", "Core URL", "http://localhost:12010", "CA certificate file", "/certs/ca.pem", "Skip TLS verification (true/false)", "false", "Proxy URL", "http://proxy.example.com:3128"),
+			outWriter:      nil,
+			err:            nil,
+		},
+		{
+			name:      "Print Fail on Printing the TLS and proxy settings",
+			profile:   "cn",
+			sensitive: false,
+			config: map[string]string{
+				"cn.core_url": "http://localhost:12010",
+				"cn.ca_cert":  "/certs/ca.pem",
+			},
+			outWriter: &testutils.FailOnNWriter{Writer: &bytes.Buffer{}, N: 2},
+			err:       NewErrorWithCause(ErrorExitCode, fmt.Errorf("write failed"), "Could not print the CA certificate file"),
+		},
 		{
 			name:      "Print Fail on Printing Core Config",
 			profile:   "cn",
@@ -1,11 +1,18 @@
 package cli
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tidwall/gjson"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 )
 
 // CoreFileController defines the methods to interact with files.
@@ -175,3 +182,93 @@ func (d discovery) PingServer(client ServerPinger, server string, printer Printe
 
 	return printer(*d.IOStreams(), pingResult)
 }
+
+// KnownLogComponents are the names of the Discovery components whose log level can be changed.
+var KnownLogComponents = []string{"core-api", "ingestion-api", "ingestion-worker", "queryflow-api", "staging-api"}
+
+// logLevels are the log levels that Discovery accepts, in the order of their verbosity.
+var logLevels = []discoveryPackage.LogLevel{discoveryPackage.LevelError, discoveryPackage.LevelWarn, discoveryPackage.LevelInfo, discoveryPackage.LevelDebug, discoveryPackage.LevelTrace}
+
+// ParseLogLevel converts the given level to one of Discovery's log levels. The level is not case-sensitive.
+func ParseLogLevel(level string) (discoveryPackage.LogLevel, error) {
+	logLevel := discoveryPackage.LogLevel(strings.ToUpper(strings.TrimSpace(level)))
+	if !slices.Contains(logLevels, logLevel) {
+		return "", NewError(ErrorExitCode, "Invalid log level %q. The level must be ERROR, WARN, INFO, DEBUG or TRACE", level)
+	}
+	return logLevel, nil
+}
+
+// LogLeveler defines the method to change the log level of Discovery's components.
+type LogLeveler interface {
+	Log(componentName string, level discoveryPackage.LogLevel, loggerName string) (gjson.Result, error)
+}
+
+// LogLevelConfig contains the configuration used to change the log level of a component.
+type LogLevelConfig struct {
+	// Component is the name of the Discovery component.
+	Component string
+	// Level is the new log level of the component.
+	Level discoveryPackage.LogLevel
+	// Logger is the name of the logger whose level is changed. If it is empty, every logger of the component is changed.
+	Logger string
+	// Duration is the time after which the level is restored. If it is zero, the level is not restored.
+	Duration time.Duration
+	// RestoreLevel is the level of the component once the duration ends.
+	// Discovery does not return the current level of a component, so it cannot be restored to the level it had before the change.
+	RestoreLevel discoveryPackage.LogLevel
+	// Detach ends the command right after the change, so the level is not restored. A reminder with the command to restore it is written instead.
+	Detach bool
+}
+
+// restoreCommand returns the command that restores the log level of the configuration.
+func (c LogLevelConfig) restoreCommand() string {
+	command := fmt.Sprintf("discovery core log-level %s %s", c.Component, c.RestoreLevel)
+	if c.Logger != "" {
+		command += " --logger " + c.Logger
+	}
+	return command
+}
+
+// ChangeLogLevel changes the log level of a component and prints the response using the given printer.
+// Discovery acknowledges the change even if the component does not exist, so a warning is written when the component is not one of the KnownLogComponents.
+// If the configuration has a duration, the level is restored once the duration ends or the context is canceled, and the response of the restoration is also printed.
+// If the configuration is detached, the level is not restored and a reminder is written instead.
+func (d discovery) ChangeLogLevel(ctx context.Context, client LogLeveler, config LogLevelConfig, printer Printer) error {
+	if !slices.Contains(KnownLogComponents, config.Component) {
+		fmt.Fprintf(d.IOStreams().Err, "WARNING: %q is not a known Discovery component. Discovery acknowledges the change even if the component does not exist. The known components are: %s.\n", config.Component, strings.Join(KnownLogComponents, ", "))
+	}
+
+	result, err := client.Log(config.Component, config.Level, config.Logger)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not change the log level of component %q", config.Component)
+	}
+
+	if printer == nil {
+		printer = JsonObjectPrinter(true)
+	}
+
+	err = printer(*d.IOStreams(), result)
+	if err != nil || config.Duration == 0 {
+		return err
+	}
+
+	if config.Detach {
+		fmt.Fprintf(d.IOStreams().Err, "REMINDER: The log level of %q will not be restored automatically. Restore it in %s with:\n  %s\n", config.Component, config.Duration, config.restoreCommand())
+		return nil
+	}
+
+	fmt.Fprintf(d.IOStreams().Err, "The log level of %q will be restored to %s in %s. Interrupt the command to restore it now.\n", config.Component, config.RestoreLevel, config.Duration)
+	timer := time.NewTimer(config.Duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	result, err = client.Log(config.Component, config.RestoreLevel, config.Logger)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not restore the log level of component %q to %s. Restore it with:\n  %s", config.Component, config.RestoreLevel, config.restoreCommand())
+	}
+
+	return printer(*d.IOStreams(), result)
+}
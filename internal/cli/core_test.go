@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/pureinsights/discovery-cli/internal/iostreams"
@@ -673,3 +675,148 @@ func Test_discovery_DeleteFile(t *testing.T) {
 		})
 	}
 }
+
+// TestParseLogLevel tests the ParseLogLevel() function.
+func TestParseLogLevel(t *testing.T) {
+	level, err := ParseLogLevel(" debug ")
+	require.NoError(t, err)
+	assert.Equal(t, discoveryPackage.LevelDebug, level)
+
+	_, err = ParseLogLevel("verbose")
+	assert.EqualError(t, err, NewError(ErrorExitCode, "Invalid log level \"verbose\". The level must be ERROR, WARN, INFO, DEBUG or TRACE").Error())
+}
+
+// Test_discovery_ChangeLogLevel tests the discovery.ChangeLogLevel() function.
+func Test_discovery_ChangeLogLevel(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		client         LogLeveler
+		config         LogLevelConfig
+		printer        Printer
+		expectedOutput string
+		expectedErr    string
+		expectedLevels []discoveryPackage.LogLevel
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working case
+		{
+			name:           "ChangeLogLevel changes the level of a known component",
+			ctx:            context.Background(),
+			client:         new(mocks.WorkingLogLeveler),
+			config:         LogLevelConfig{Component: "ingestion-api", Level: discoveryPackage.LevelDebug},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"acknowledged\":true}\n",
+			expectedErr:    "",
+			expectedLevels: []discoveryPackage.LogLevel{discoveryPackage.LevelDebug},
+		},
+		{
+			name:           "ChangeLogLevel warns about an unknown component",
+			ctx:            context.Background(),
+			client:         new(mocks.WorkingLogLeveler),
+			config:         LogLevelConfig{Component: "ingestion", Level: discoveryPackage.LevelWarn},
+			printer:        nil,
+			expectedOutput: "{\n  \"acknowledged\": true\n}\n",
+			expectedErr:    "WARNING: \"ingestion\" is not a known Discovery component. Discovery acknowledges the change even if the component does not exist. The known components are: core-api, ingestion-api, ingestion-worker, queryflow-api, staging-api.\n",
+			expectedLevels: []discoveryPackage.LogLevel{discoveryPackage.LevelWarn},
+		},
+		{
+			name:           "ChangeLogLevel restores the level once the duration ends",
+			ctx:            context.Background(),
+			client:         new(mocks.WorkingLogLeveler),
+			config:         LogLevelConfig{Component: "queryflow-api", Level: discoveryPackage.LevelTrace, Duration: time.Millisecond, RestoreLevel: discoveryPackage.LevelInfo},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"acknowledged\":true}\n{\"acknowledged\":true}\n",
+			expectedErr:    "The log level of \"queryflow-api\" will be restored to INFO in 1ms. Interrupt the command to restore it now.\n",
+			expectedLevels: []discoveryPackage.LogLevel{discoveryPackage.LevelTrace, discoveryPackage.LevelInfo},
+		},
+		{
+			name:           "ChangeLogLevel restores the level when the context is canceled",
+			ctx:            canceled,
+			client:         new(mocks.WorkingLogLeveler),
+			config:         LogLevelConfig{Component: "queryflow-api", Level: discoveryPackage.LevelDebug, Duration: time.Hour, RestoreLevel: discoveryPackage.LevelWarn},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"acknowledged\":true}\n{\"acknowledged\":true}\n",
+			expectedErr:    "The log level of \"queryflow-api\" will be restored to WARN in 1h0m0s. Interrupt the command to restore it now.\n",
+			expectedLevels: []discoveryPackage.LogLevel{discoveryPackage.LevelDebug, discoveryPackage.LevelWarn},
+		},
+		{
+			name:           "ChangeLogLevel writes a reminder when it is detached",
+			ctx:            context.Background(),
+			client:         new(mocks.WorkingLogLeveler),
+			config:         LogLevelConfig{Component: "core-api", Level: discoveryPackage.LevelDebug, Logger: "com.pureinsights", Duration: 15 * time.Minute, RestoreLevel: discoveryPackage.LevelInfo, Detach: true},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"acknowledged\":true}\n",
+			expectedErr:    "REMINDER: The log level of \"core-api\" will not be restored automatically. Restore it in 15m0s with:\n  discovery core log-level core-api INFO --logger com.pureinsights\n",
+			expectedLevels: []discoveryPackage.LogLevel{discoveryPackage.LevelDebug},
+		},
+
+		// Error case
+		{
+			name:   "Log fails",
+			ctx:    context.Background(),
+			client: new(mocks.FailingLogLeveler),
+			config: LogLevelConfig{Component: "core-api", Level: discoveryPackage.LevelDebug},
+			err:    NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{"status":400,"code":3002,"messages":["Required QueryValue [componentName] not specified"],"timestamp":"2025-08-28T00:03:31.103683200Z"}`)}, "Could not change the log level of component \"core-api\""),
+		},
+		{
+			name:           "Restoring the level fails",
+			ctx:            context.Background(),
+			client:         new(mocks.FailingLogLevelerRestoreFails),
+			config:         LogLevelConfig{Component: "core-api", Level: discoveryPackage.LevelDebug, Duration: time.Millisecond, RestoreLevel: discoveryPackage.LevelInfo},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"acknowledged\":true}\n",
+			err:            NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status": 500, "code": 5000, "messages": ["Internal server error"]}`)}, "Could not restore the log level of component \"core-api\" to INFO. Restore it with:\n  discovery core log-level core-api INFO"),
+		},
+		{
+			name:      "Printing fails",
+			ctx:       context.Background(),
+			client:    new(mocks.WorkingLogLeveler),
+			config:    LogLevelConfig{Component: "core-api", Level: discoveryPackage.LevelDebug, Duration: time.Hour},
+			printer:   nil,
+			outWriter: testutils.ErrWriter{Err: errors.New("write failed")},
+			err:       NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not print JSON object"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: errBuf,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.ChangeLogLevel(tc.ctx, tc.client, tc.config, tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedErr, errBuf.String())
+				assert.Equal(t, tc.expectedLevels, tc.client.(*mocks.WorkingLogLeveler).Levels)
+			}
+
+			if tc.expectedOutput != "" {
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
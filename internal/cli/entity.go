@@ -3,7 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
-	"net/http"
+	"iter"
 	"strings"
 
 	"github.com/google/uuid"
@@ -13,16 +13,6 @@ import (
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 )
 
-const (
-	// EqualsFilter contains the JSON string for the Equals DSL filter.
-	EqualsFilter = `{
-	"equals": {
-		"field": "%s",
-		"value": "%s"
-		}
-	}`
-)
-
 // GetEntityId obtains the UUID from the result of a search.
 func GetEntityId(d Discovery, client Searcher, name string) (uuid.UUID, error) {
 	entity, err := d.searchEntity(client, name)
@@ -36,7 +26,7 @@ func GetEntityId(d Discovery, client Searcher, name string) (uuid.UUID, error) {
 // Getter defines the Get and GetAll methods.
 type Getter interface {
 	Get(uuid.UUID) (gjson.Result, error)
-	GetAll() ([]gjson.Result, error)
+	GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error)
 }
 
 // GetEntity obtains the entity with the given ID using the given client and then prints out the result using the received printer or the JSON printer.
@@ -53,45 +43,125 @@ func (d discovery) GetEntity(client Getter, id uuid.UUID, printer Printer) error
 	return printer(*d.IOStreams(), object)
 }
 
-// GetEntities obtains all the entities using the given client and then prints out the result using the received printer or the JSON array printer.
-func (d discovery) GetEntities(client Getter, printer Printer) error {
-	objects, err := client.GetAll()
-	if err != nil {
-		return NewErrorWithCause(ErrorExitCode, err, "Could not get all entities")
+// StreamGetter is implemented by the clients that can iterate through every entity as the pages are received.
+type StreamGetter interface {
+	GetAllSeq(...discoveryPackage.RequestOption) iter.Seq2[gjson.Result, error]
+}
+
+// allEntities returns a sequence with every entity of the client.
+// If the client implements the StreamGetter interface, the entities are streamed. If not, they are obtained with the GetAll() method.
+func allEntities(client Getter, options ...discoveryPackage.RequestOption) iter.Seq2[gjson.Result, error] {
+	if streamGetter, ok := client.(StreamGetter); ok {
+		return streamGetter.GetAllSeq(options...)
 	}
 
+	return resultsSeq(client.GetAll(options...))
+}
+
+// ListConfig contains the settings of the requests that list entities.
+// The options can sort the entities and set the size of the pages, and the limit is the maximum number of entities that are listed.
+// A limit lower than 1 lists every entity.
+type ListConfig struct {
+	Options []discoveryPackage.RequestOption
+	Limit   int
+}
+
+// GetEntities obtains all the entities using the given client and then prints out the result using the received printer or the JSON array printer.
+// The entities are printed as they are received. Once the limit of the configuration is reached, no more pages are requested.
+func (d discovery) GetEntities(client Getter, config ListConfig, printer StreamPrinter) error {
 	if printer == nil {
-		printer = JsonArrayPrinter(false)
+		printer = JsonArrayStreamPrinter(false)
+	}
+
+	var getErr error
+	err := printer(*d.IOStreams(), captureError(discoveryPackage.Limit(allEntities(client, config.Options...), config.Limit), &getErr))
+	if getErr != nil {
+		return NewErrorWithCause(ErrorExitCode, getErr, "Could not get all entities")
 	}
 
-	return printer(*d.IOStreams(), objects...)
+	return err
 }
 
 // Searcher is the interface that implements searching methods.
 type Searcher interface {
 	Getter
-	Search(gjson.Result) ([]gjson.Result, error)
+	Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error)
 	SearchByName(name string) (gjson.Result, error)
 }
 
-// searchEntity tries to search an entity by name, and if it fails, it tries to get the entity by its id.
+// StreamSearcher is implemented by the clients that can iterate through the results of a search as the pages are received.
+type StreamSearcher interface {
+	SearchSeq(gjson.Result, ...discoveryPackage.RequestOption) iter.Seq2[gjson.Result, error]
+}
+
+// searchResults returns a sequence with the results of the search.
+// If the client implements the StreamSearcher interface, the results are streamed. If not, they are obtained with the Search() method.
+func searchResults(client Searcher, filter gjson.Result, options ...discoveryPackage.RequestOption) iter.Seq2[gjson.Result, error] {
+	if streamSearcher, ok := client.(StreamSearcher); ok {
+		return streamSearcher.SearchSeq(filter, options...)
+	}
+
+	return resultsSeq(client.Search(filter, options...))
+}
+
+// ambiguousNameError converts the error of a name that matches several entities to an error that lists the candidates, so that the user can choose one of them.
+func ambiguousNameError(err discoveryPackage.AmbiguousNameError) Error {
+	var candidates strings.Builder
+	for _, candidate := range err.Candidates {
+		candidates.WriteString("\n- id: " + candidate.Get("id").String())
+		if entityType := candidate.Get("type"); entityType.Exists() {
+			candidates.WriteString(", type: " + entityType.String())
+		}
+
+		if value := candidate.Get("value"); value.Exists() {
+			candidates.WriteString(", value: " + value.String())
+		}
+
+		if candidate.Get("labels").Exists() {
+			labels := []string{}
+			for _, label := range candidate.Get("labels").Array() {
+				if value := label.Get("value"); value.Exists() {
+					labels = append(labels, label.Get("key").String()+":"+value.String())
+				} else {
+					labels = append(labels, label.Get("key").String())
+				}
+			}
+			candidates.WriteString(", labels: [" + strings.Join(labels, ", ") + "]")
+		}
+	}
+
+	choice := "the id"
+	if err.Format != "" {
+		choice += " or the " + err.Format + " format"
+	}
+	return NewErrorWithCause(ErrorExitCode, err, "Several entities have the name %q. Use %s to choose one of them:%s", err.Name, choice, candidates.String())
+}
+
+// searchEntity gets the entity by its id if the given id is a UUID, and if it is not found or the id is not a UUID, it searches the entity by name.
+// Getting the entity first avoids searching every entity of the clients that search on the client side, like the labels and secrets.
+// The name can have the {type}/{name} format to choose between entities with the same name. If several entities have the name, it returns an error that lists them.
 func (d discovery) searchEntity(client Searcher, id string) (gjson.Result, error) {
-	result, err := client.SearchByName(id)
-	if err != nil {
-		discoveryErr, ok := err.(discoveryPackage.Error)
-		if !ok {
-			return gjson.Result{}, err
+	var getErr error
+	if parsedId, uuidErr := uuid.Parse(id); uuidErr == nil {
+		result, err := client.Get(parsedId)
+		if err == nil || !discoveryPackage.IsNotFound(err) {
+			return result, err
 		}
+		getErr = err
+	}
 
-		if discoveryErr.Status != http.StatusNotFound {
-			return gjson.Result{}, discoveryErr
+	result, err := client.SearchByName(id)
+	if err != nil {
+		var ambiguousErr discoveryPackage.AmbiguousNameError
+		if errors.As(err, &ambiguousErr) {
+			return gjson.Result{}, ambiguousNameError(ambiguousErr)
 		}
 
-		if parsedId, uuidErr := uuid.Parse(id); uuidErr == nil {
-			return client.Get(parsedId)
+		if getErr != nil && discoveryPackage.IsNotFound(err) {
+			return gjson.Result{}, getErr
 		}
 
-		return gjson.Result{}, discoveryErr
+		return gjson.Result{}, err
 	}
 
 	return result, nil
@@ -116,74 +186,65 @@ func (d discovery) SearchEntity(client Searcher, id string, printer Printer) err
 	return printer(*d.IOStreams(), result)
 }
 
-// SearchEntities searches for entities and prints the results into the Out IOStream.
-func (d discovery) SearchEntities(client Searcher, filter gjson.Result, printer Printer) error {
-	results, err := client.Search(filter)
-	if err != nil {
-		return NewErrorWithCause(ErrorExitCode, err, "Could not search for the entities")
+// SearchEntities searches for entities and prints the results into the Out IOStream as they are received.
+// Once the limit of the configuration is reached, no more pages are requested.
+func (d discovery) SearchEntities(client Searcher, filter gjson.Result, config ListConfig, printer StreamPrinter) error {
+	if printer == nil {
+		printer = JsonArrayStreamPrinter(false)
 	}
 
-	if printer == nil {
-		printer = JsonArrayPrinter(false)
+	var searchErr error
+	err := printer(*d.IOStreams(), captureError(discoveryPackage.Limit(searchResults(client, filter, config.Options...), config.Limit), &searchErr))
+	if searchErr != nil {
+		return NewErrorWithCause(ErrorExitCode, searchErr, "Could not search for the entities")
 	}
 
-	return printer(*d.IOStreams(), results...)
+	return err
 }
 
-// parseFilter converts a filter in the format type=key:value to the JSON DSL Filter in Discovery.
-func parseFilter(filter string) (string, []string, error) {
-	filterType, keyValue, found := strings.Cut(filter, "=")
-	if !found {
-		return "", []string(nil), NewError(ErrorExitCode, "Filter %q does not follow the format {type}={key}[:{value}]", filter)
-	}
-	filters := []string{}
-	switch filterType {
-	case "label":
-		key, value, found := strings.Cut(keyValue, ":")
-		if key == "" {
-			return "", []string(nil), NewError(ErrorExitCode, "The label's key in the filter %q cannot be empty", filter)
-		}
-		filters = append(filters, fmt.Sprintf(EqualsFilter, "labels.key", key))
-		if found {
-			if value == "" {
-				return "", []string(nil), NewError(ErrorExitCode, "The label's value in the filter %q cannot be empty if ':' is included", filter)
-			}
-			filters = append(filters, fmt.Sprintf(EqualsFilter, "labels.value", value))
-		}
-	case "type":
-		if keyValue == "" {
-			return "", []string(nil), NewError(ErrorExitCode, "The value in the type filter %q cannot be empty", filter)
+// parseFilter converts a filter expression to the JSON DSL Filter in Discovery.
+// If the filter is a single label or type condition, like label=key:value or type=mongo, it returns its type and the equals filters of the condition, so that they can be grouped with the other conditions of the same type.
+// The value of a single label or type condition is the rest of the filter, so it can have spaces, quotes and the "&" and "|" characters.
+// Otherwise, it returns the "expression" type and the compiled filter.
+func parseFilter(filter string) (string, []gjson.Result, error) {
+	if isSingleEqualityFilter(filter) {
+		name, value, _ := strings.Cut(filter, "=")
+		condition := filterCondition{source: filter, name: name, operator: "=", value: filterValue{{text: value}}}
+		filters, err := condition.equalityFilters()
+		if err != nil {
+			return "", []gjson.Result(nil), err
 		}
-
-		filters = append(filters, fmt.Sprintf(EqualsFilter, "type", keyValue))
-	default:
-		return "", []string(nil), NewError(ErrorExitCode, "Filter type %q does not exist", filterType)
+		return name, filters, nil
 	}
 
-	return filterType, filters, nil
-}
+	node, err := parseFilterExpression(filter)
+	if err != nil {
+		return "", []gjson.Result(nil), err
+	}
 
-// getAndFilterString returns the filter string for the given filters.
-// If there are multiple filters, they are joined with an "and" filter.
-// If there is only one filter, it is returned.
-// If there are no filters, an empty filter is returned.
-func getAndFilterString(filters []string) (string, error) {
-	if len(filters) > 1 {
-		return sjson.SetRaw("{}", "and", "["+strings.Join(filters, ",")+"]")
-	} else if len(filters) == 1 {
-		return filters[0], nil
+	condition := node.condition
+	if node.operator == "" && condition.operator == "=" && (condition.name == "label" || condition.name == "type") {
+		filters, err := condition.equalityFilters()
+		if err != nil {
+			return "", []gjson.Result(nil), err
+		}
+		return condition.name, filters, nil
 	}
 
-	return "{}", nil
+	compiled, err := node.compile()
+	if err != nil {
+		return "", []gjson.Result(nil), err
+	}
+	return "expression", []gjson.Result{compiled}, nil
 }
 
 // BuildEntitiesFilter builds a filter based on the arguments sent to the get command.
-// The filters are combined through the "and" operator.
+// The label filters and the type filters are each combined through the "and" operator, and then both groups are combined the same way with the rest of the filter expressions.
 func BuildEntitiesFilter(filters []string) (gjson.Result, error) {
-	labelFilters := []string{}
-	typeFilters := []string{}
+	labelFilters := []gjson.Result{}
+	typeFilters := []gjson.Result{}
+	expressionFilters := []gjson.Result{}
 
-	var err error
 	for _, filter := range filters {
 		filterType, parsedFilters, err := parseFilter(filter)
 		if err != nil {
@@ -194,33 +255,13 @@ func BuildEntitiesFilter(filters []string) (gjson.Result, error) {
 			labelFilters = append(labelFilters, parsedFilters...)
 		case "type":
 			typeFilters = append(typeFilters, parsedFilters...)
+		default:
+			expressionFilters = append(expressionFilters, parsedFilters...)
 		}
 	}
 
-	labelFilterString, err := getAndFilterString(labelFilters)
-	if err != nil {
-		return gjson.Result{}, NewErrorWithCause(ErrorExitCode, err, "Could not create label filters")
-	}
-
-	typeFilterString, err := getAndFilterString(typeFilters)
-	if err != nil {
-		return gjson.Result{}, NewErrorWithCause(ErrorExitCode, err, "Could not create type filters")
-	}
-
-	filterString := "{}"
-	switch {
-	case len(labelFilters) > 0 && len(typeFilters) > 0:
-		filterString, err = getAndFilterString([]string{labelFilterString, typeFilterString})
-		if err != nil {
-			return gjson.Result{}, NewErrorWithCause(ErrorExitCode, err, "Could not combine label and type filters")
-		}
-	case len(labelFilters) > 0:
-		filterString = labelFilterString
-	default:
-		filterString = typeFilterString
-	}
-
-	return gjson.Parse(filterString), nil
+	groups := append([]gjson.Result{discoveryPackage.And(labelFilters...), discoveryPackage.And(typeFilters...)}, expressionFilters...)
+	return discoveryPackage.And(groups...), nil
 }
 
 // Creator defines the methods to create and update entities.
@@ -286,6 +327,7 @@ type SearchCreator interface {
 
 // SearchUpsertEntity creates or updates an entity in Discovery with the given configuration.
 // It searches for the entity by name or id to check if it exists and needs to update it.
+// If several entities have the name, it returns an error instead of creating another one.
 func (d discovery) SearchUpsertEntity(client SearchCreator, config gjson.Result) (gjson.Result, error) {
 	if config.Get("id").Exists() {
 		if parsedId, uuidErr := uuid.Parse(config.Get("id").String()); uuidErr == nil {
@@ -295,6 +337,9 @@ func (d discovery) SearchUpsertEntity(client SearchCreator, config gjson.Result)
 		}
 	} else if config.Get("name").Exists() {
 		id, err := GetEntityId(d, client, config.Get("name").String())
+		if errors.As(err, &discoveryPackage.AmbiguousNameError{}) {
+			return gjson.Result{}, err
+		}
 		if err != nil || id == uuid.Nil {
 			return client.Create(config)
 		} else {
@@ -382,6 +427,253 @@ func (d discovery) SearchDeleteEntity(client SearchDeleter, name string, printer
 	return d.DeleteEntity(client, deleteId, printer)
 }
 
+// Cloner is the interface that implements the clone method.
+type Cloner interface {
+	Clone(id uuid.UUID, params map[string][]string) (gjson.Result, error)
+}
+
+// SearchCloner is the interface of the clients that can clone the entities referenced by name and update the copies.
+type SearchCloner interface {
+	Cloner
+	Creator
+	Searcher
+}
+
+// CloneConfig is a struct that contains the parameters of the clone operation.
+type CloneConfig struct {
+	// Name is the name of the copy. If it is empty, Discovery names the copy.
+	Name string
+	// URI is the URI of the copy, which is needed by the entities that are exposed through one, like endpoints.
+	URI string
+	// Deep indicates whether the entities that are referenced by the cloned entity are also cloned.
+	Deep bool
+	// Labels are the labels in the format {key}[:{value}] that are added to the copy.
+	Labels []string
+}
+
+// params returns the query parameters of the clone request.
+func (c CloneConfig) params() map[string][]string {
+	params := map[string][]string{}
+	if c.Name != "" {
+		params["name"] = []string{c.Name}
+	}
+	if c.URI != "" {
+		params["uri"] = []string{c.URI}
+	}
+	if c.Deep {
+		params["deep"] = []string{"true"}
+	}
+	return params
+}
+
+// parseLabels converts the labels in the format {key}[:{value}] to the labels of Discovery's entities.
+func parseLabels(labels []string) ([]map[string]string, error) {
+	parsed := make([]map[string]string, 0, len(labels))
+	for _, label := range labels {
+		key, value, hasValue := strings.Cut(label, ":")
+		if key == "" {
+			return nil, NewError(ErrorExitCode, "Label %q does not follow the format {key}[:{value}]", label)
+		}
+
+		if hasValue {
+			parsed = append(parsed, map[string]string{"key": key, "value": value})
+		} else {
+			parsed = append(parsed, map[string]string{"key": key})
+		}
+	}
+	return parsed, nil
+}
+
+// addLabels adds the labels to the entity. The labels of the entity that have the same key as one of the new labels are replaced.
+func addLabels(entity gjson.Result, labels []map[string]string) (gjson.Result, error) {
+	merged := []any{}
+	for _, label := range entity.Get("labels").Array() {
+		replaced := false
+		for _, newLabel := range labels {
+			if label.Get("key").String() == newLabel["key"] {
+				replaced = true
+				break
+			}
+		}
+
+		if !replaced {
+			merged = append(merged, label.Value())
+		}
+	}
+
+	for _, label := range labels {
+		merged = append(merged, label)
+	}
+
+	raw, err := sjson.Set(entity.Raw, "labels", merged)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+	return gjson.Parse(raw), nil
+}
+
+// SearchCloneEntity searches for the entity with the given name or UUID and then clones it with the parameters of the configuration.
+// If the configuration has labels, they are added to the copy, which is then updated. The copy is printed out using the printer parameter.
+func (d discovery) SearchCloneEntity(client SearchCloner, name string, config CloneConfig, printer Printer) error {
+	labels, err := parseLabels(config.Labels)
+	if err != nil {
+		return err
+	}
+
+	result, err := d.searchEntity(client, name)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not search for entity with name %q", name)
+	}
+
+	cloneId, err := uuid.Parse(result.Get("id").String())
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not clone entity with name %q", name)
+	}
+
+	clone, err := client.Clone(cloneId, config.params())
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not clone entity with id %q", cloneId.String())
+	}
+
+	if len(labels) > 0 {
+		cloneId, err = uuid.Parse(clone.Get("id").String())
+		if err != nil {
+			return NewErrorWithCause(ErrorExitCode, err, "Could not add the labels to the copy of the entity with name %q", name)
+		}
+
+		labeled, err := addLabels(clone, labels)
+		if err != nil {
+			return NewErrorWithCause(ErrorExitCode, err, "Could not add the labels to the copy with id %q", cloneId.String())
+		}
+
+		clone, err = client.Update(cloneId, labeled)
+		if err != nil {
+			return NewErrorWithCause(ErrorExitCode, err, "Could not add the labels to the copy with id %q", cloneId.String())
+		}
+	}
+
+	if printer == nil {
+		printer = JsonObjectPrinter(true)
+	}
+
+	return printer(*d.IOStreams(), clone)
+}
+
+// Enabler is the interface that implements the methods to enable and disable entities.
+type Enabler interface {
+	Enable(id uuid.UUID) (gjson.Result, error)
+	Disable(id uuid.UUID) (gjson.Result, error)
+}
+
+// SearchEnabler is the interface that implements the enable and disable methods that work with names and filters.
+type SearchEnabler interface {
+	Enabler
+	Searcher
+}
+
+// toggleFailure creates the result of an entity that could not be enabled or disabled.
+// If the error was returned by Discovery with a valid JSON body, the result has the body of the response. If not, it has the message of the error.
+func toggleFailure(entity gjson.Result, err error) gjson.Result {
+	var discoveryErr discoveryPackage.Error
+	if errors.As(err, &discoveryErr) && gjson.Valid(discoveryErr.Body.Raw) {
+		raw, _ := sjson.SetRaw(entity.Raw, "error", discoveryErr.Body.Raw)
+		return gjson.Parse(raw)
+	}
+
+	raw, _ := sjson.Set(entity.Raw, "error", err.Error())
+	return gjson.Parse(raw)
+}
+
+// toggleEntity enables or disables the given entity and returns its result, which has the id and name of the entity.
+func toggleEntity(client Enabler, entity gjson.Result, enable bool) (gjson.Result, bool) {
+	raw, _ := sjson.Set(`{}`, "id", entity.Get("id").String())
+	raw, _ = sjson.Set(raw, "name", entity.Get("name").String())
+	reference := gjson.Parse(raw)
+
+	id, err := uuid.Parse(entity.Get("id").String())
+	if err != nil {
+		return toggleFailure(reference, err), false
+	}
+
+	var response gjson.Result
+	if enable {
+		response, err = client.Enable(id)
+	} else {
+		response, err = client.Disable(id)
+	}
+	if err != nil {
+		return toggleFailure(reference, err), false
+	}
+
+	if response.IsObject() {
+		raw = reference.Raw
+		response.ForEach(func(key, value gjson.Result) bool {
+			raw, _ = sjson.SetRaw(raw, key.String(), value.Raw)
+			return true
+		})
+		reference = gjson.Parse(raw)
+	}
+	return reference, true
+}
+
+// SearchEnableEntities enables or disables the entities with the given names or UUIDs and the entities that match the filter.
+// If the filter does not exist, only the entities of the names are changed. The entities are found before any of them is changed, and an entity is only changed once even if it is found several times.
+// Every entity is changed even if some of them fail. The result of each entity is printed out as an array using the printer parameter, and an error is returned if any of them failed.
+func (d discovery) SearchEnableEntities(client SearchEnabler, names []string, filter gjson.Result, enable bool, printer Printer) error {
+	action := "disable"
+	if enable {
+		action = "enable"
+	}
+
+	results := []gjson.Result{}
+	entities := []gjson.Result{}
+	failed := 0
+	for _, name := range names {
+		entity, err := d.searchEntity(client, name)
+		if err != nil {
+			raw, _ := sjson.Set(`{}`, "name", name)
+			results = append(results, toggleFailure(gjson.Parse(raw), err))
+			failed++
+			continue
+		}
+		entities = append(entities, entity)
+	}
+
+	if filter.Exists() {
+		for entity, err := range searchResults(client, filter) {
+			if err != nil {
+				return NewErrorWithCause(ErrorExitCode, err, "Could not search for the entities to %s", action)
+			}
+			entities = append(entities, entity)
+		}
+	}
+
+	toggled := map[string]bool{}
+	for _, entity := range entities {
+		id := entity.Get("id").String()
+		if toggled[id] {
+			continue
+		}
+		toggled[id] = true
+
+		result, ok := toggleEntity(client, entity, enable)
+		results = append(results, result)
+		if !ok {
+			failed++
+		}
+	}
+
+	if printer == nil {
+		printer = JsonArrayPrinter(false)
+	}
+
+	var toggleErr error
+	if failed > 0 {
+		toggleErr = NewError(ErrorExitCode, "Could not %s %d of the %d entities", action, failed, len(results))
+	}
+	return errors.Join(printer(*d.IOStreams(), results...), toggleErr)
+}
+
 // SearchDumpBucket searches for the bucket with the given name or UUID and then dumps it.
 func (d discovery) SearchDumpBucket(client Searcher, contentProvider func(string) StagingContentController, nameOrID string, config DumpConfig, printer Printer) error {
 	result, err := d.searchEntity(client, nameOrID)
@@ -178,7 +178,7 @@ func Test_discovery_GetEntities(t *testing.T) {
 	tests := []struct {
 		name           string
 		client         Getter
-		printer        Printer
+		printer        StreamPrinter
 		expectedOutput string
 		outWriter      io.Writer
 		err            error
@@ -187,7 +187,7 @@ func Test_discovery_GetEntities(t *testing.T) {
 		{
 			name:           "GetEntities correctly prints an array with the pretty printer",
 			client:         new(mocks.WorkingGetter),
-			printer:        JsonArrayPrinter(true),
+			printer:        JsonArrayStreamPrinter(true),
 			expectedOutput: "[\n  {\n    \"active\": true,\n    \"creationTimestamp\": \"2025-08-21T17:57:16Z\",\n    \"id\": \"3393f6d9-94c1-4b70-ba02-5f582727d998\",\n    \"labels\": [],\n    \"lastUpdatedTimestamp\": \"2025-08-21T17:57:16Z\",\n    \"name\": \"MongoDB text processor 4\",\n    \"type\": \"mongo\"\n  },\n  {\n    \"active\": true,\n    \"creationTimestamp\": \"2025-08-14T18:02:38Z\",\n    \"id\": \"5f125024-1e5e-4591-9fee-365dc20eeeed\",\n    \"labels\": [],\n    \"lastUpdatedTimestamp\": \"2025-08-18T20:55:43Z\",\n    \"name\": \"MongoDB text processor\",\n    \"type\": \"mongo\"\n  },\n  {\n    \"active\": true,\n    \"creationTimestamp\": \"2025-08-14T18:02:38Z\",\n    \"id\": \"86e7f920-a4e4-4b64-be84-5437a7673db8\",\n    \"labels\": [],\n    \"lastUpdatedTimestamp\": \"2025-08-14T18:02:38Z\",\n    \"name\": \"Script processor\",\n    \"type\": \"script\"\n  }\n]\n",
 			err:            nil,
 		},
@@ -233,7 +233,7 @@ func Test_discovery_GetEntities(t *testing.T) {
 			}
 
 			d := NewDiscovery(&ios, viper.New(), "")
-			err := d.GetEntities(tc.client, tc.printer)
+			err := d.GetEntities(tc.client, ListConfig{}, tc.printer)
 
 			if tc.err != nil {
 				require.Error(t, err)
@@ -361,6 +361,13 @@ func Test_searchEntity(t *testing.T) {
 			expected: gjson.Result{},
 			err:      errors.New("not discovery error"),
 		},
+		{
+			name:     "Several entities have the name",
+			client:   new(mocks.AmbiguousSearcher),
+			id:       "MongoDB Atlas Server",
+			expected: gjson.Result{},
+			err:      NewErrorWithCause(ErrorExitCode, discoveryPackage.AmbiguousNameError{Name: "MongoDB Atlas Server", Candidates: make([]gjson.Result, 2)}, "Several entities have the name \"MongoDB Atlas Server\". Use the id or the {type}/{name} format to choose one of them:\n- id: 986ce864-af76-4fcb-8b4f-f4e4c6ab0951, type: mongo, labels: []\n- id: 8f14c11c-bb66-49d3-aa2a-dedff4608c17, type: openai, labels: [A:B, C]"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -392,6 +399,78 @@ func Test_searchEntity(t *testing.T) {
 	}
 }
 
+// Test_searchEntity_GetsUUIDFirst tests that searchEntity() gets the entity by its id before searching it by name when the id is a UUID.
+func Test_searchEntity_GetsUUIDFirst(t *testing.T) {
+	notFound := discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{"status":404,"code":1003,"messages":["Label not found: 986ce864-af76-4fcb-8b4f-f4e4c6ab0951"]}`)}
+	nameNotFound := discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{"status":404,"code":1003,"messages":["Entity not found: entity with name \"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\" does not exist"]}`)}
+	tests := []struct {
+		name          string
+		client        *mocks.RecordingSearcher
+		id            string
+		expected      gjson.Result
+		expectedCalls []string
+		err           error
+	}{
+		{
+			name:          "The entity is found by its id without searching it by name",
+			client:        &mocks.RecordingSearcher{GetResult: gjson.Parse(`{"id":"986ce864-af76-4fcb-8b4f-f4e4c6ab0951"}`)},
+			id:            "986ce864-af76-4fcb-8b4f-f4e4c6ab0951",
+			expected:      gjson.Parse(`{"id":"986ce864-af76-4fcb-8b4f-f4e4c6ab0951"}`),
+			expectedCalls: []string{"Get:986ce864-af76-4fcb-8b4f-f4e4c6ab0951"},
+		},
+		{
+			name:          "The id is not found, so the entity is searched by name",
+			client:        &mocks.RecordingSearcher{GetErr: notFound, SearchByNameResult: gjson.Parse(`{"name":"986ce864-af76-4fcb-8b4f-f4e4c6ab0951"}`)},
+			id:            "986ce864-af76-4fcb-8b4f-f4e4c6ab0951",
+			expected:      gjson.Parse(`{"name":"986ce864-af76-4fcb-8b4f-f4e4c6ab0951"}`),
+			expectedCalls: []string{"Get:986ce864-af76-4fcb-8b4f-f4e4c6ab0951", "SearchByName:986ce864-af76-4fcb-8b4f-f4e4c6ab0951"},
+		},
+		{
+			name:          "Neither the id nor the name are found",
+			client:        &mocks.RecordingSearcher{GetErr: notFound, SearchByNameErr: nameNotFound},
+			id:            "986ce864-af76-4fcb-8b4f-f4e4c6ab0951",
+			expected:      gjson.Result{},
+			expectedCalls: []string{"Get:986ce864-af76-4fcb-8b4f-f4e4c6ab0951", "SearchByName:986ce864-af76-4fcb-8b4f-f4e4c6ab0951"},
+			err:           notFound,
+		},
+		{
+			name:          "Getting the entity by its id fails with another error",
+			client:        &mocks.RecordingSearcher{GetErr: discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"status":401}`)}},
+			id:            "986ce864-af76-4fcb-8b4f-f4e4c6ab0951",
+			expected:      gjson.Result{},
+			expectedCalls: []string{"Get:986ce864-af76-4fcb-8b4f-f4e4c6ab0951"},
+			err:           discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"status":401}`)},
+		},
+		{
+			name:          "A name that is not a UUID is only searched by name",
+			client:        &mocks.RecordingSearcher{SearchByNameResult: gjson.Parse(`{"key":"env"}`)},
+			id:            "env:dev",
+			expected:      gjson.Parse(`{"key":"env"}`),
+			expectedCalls: []string{"SearchByName:env:dev"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: &bytes.Buffer{},
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			result, err := d.searchEntity(tc.client, tc.id)
+			assert.Equal(t, tc.expected, result)
+			assert.Equal(t, tc.expectedCalls, tc.client.Calls)
+			if tc.err != nil {
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 // TestSearchEntity tests the cli.SearchEntity() function.
 func TestSearchEntity(t *testing.T) {
 	tests := []struct {
@@ -571,7 +650,7 @@ func Test_discovery_SearchEntities(t *testing.T) {
 	tests := []struct {
 		name           string
 		client         Searcher
-		printer        Printer
+		printer        StreamPrinter
 		expectedOutput string
 		outWriter      io.Writer
 		err            error
@@ -580,7 +659,7 @@ func Test_discovery_SearchEntities(t *testing.T) {
 		{
 			name:           "SearchEntities correctly prints an array with the sent printer",
 			client:         new(mocks.WorkingSearcher),
-			printer:        JsonArrayPrinter(true),
+			printer:        JsonArrayStreamPrinter(true),
 			expectedOutput: "[\n  {\n    \"highlight\": {},\n    \"score\": 0.20970252,\n    \"source\": {\n      \"active\": true,\n      \"creationTimestamp\": \"2025-09-29T15:50:17Z\",\n      \"id\": \"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\n      \"labels\": [],\n      \"lastUpdatedTimestamp\": \"2025-09-29T15:50:17Z\",\n      \"name\": \"MongoDB Atlas server clone\",\n      \"type\": \"mongo\"\n    }\n  },\n  {\n    \"highlight\": {},\n    \"score\": 0.20970252,\n    \"source\": {\n      \"active\": true,\n      \"creationTimestamp\": \"2025-09-29T15:50:19Z\",\n      \"id\": \"8f14c11c-bb66-49d3-aa2a-dedff4608c17\",\n      \"labels\": [],\n      \"lastUpdatedTimestamp\": \"2025-09-29T15:50:19Z\",\n      \"name\": \"MongoDB Atlas server clone 1\",\n      \"type\": \"mongo\"\n    }\n  },\n  {\n    \"highlight\": {},\n    \"score\": 0.20970252,\n    \"source\": {\n      \"active\": true,\n      \"creationTimestamp\": \"2025-09-29T15:50:20Z\",\n      \"id\": \"3a0214a4-72cc-4eee-ad0c-9e3af9b08a6c\",\n      \"labels\": [],\n      \"lastUpdatedTimestamp\": \"2025-09-29T15:50:20Z\",\n      \"name\": \"MongoDB Atlas server clone 3\",\n      \"type\": \"mongo\"\n    }\n  }\n]\n",
 			err:            nil,
 		},
@@ -626,7 +705,7 @@ func Test_discovery_SearchEntities(t *testing.T) {
 			}
 
 			d := NewDiscovery(&ios, viper.New(), "")
-			err := d.SearchEntities(tc.client, gjson.Result{}, tc.printer)
+			err := d.SearchEntities(tc.client, gjson.Result{}, ListConfig{}, tc.printer)
 
 			if tc.err != nil {
 				require.Error(t, err)
@@ -654,48 +733,69 @@ func Test_parseFilter(t *testing.T) {
 			name:               "Send label with key and value",
 			filter:             "label=A:C",
 			expectedFilterType: "label",
-			expectedFilters: []string{`{
-	"equals": {
-		"field": "labels.key",
-		"value": "A"
-		}
-	}`, `{
-	"equals": {
-		"field": "labels.value",
-		"value": "C"
-		}
-	}`},
-			err: nil,
+			expectedFilters:    []string{`{"equals":{"field":"labels.key","value":"A"}}`, `{"equals":{"field":"labels.value","value":"C"}}`},
+			err:                nil,
 		},
 		{
 			name:               "Send label with only key",
 			filter:             "label=B",
 			expectedFilterType: "label",
-			expectedFilters: []string{`{
-	"equals": {
-		"field": "labels.key",
-		"value": "B"
-		}
-	}`},
-			err: nil,
+			expectedFilters:    []string{`{"equals":{"field":"labels.key","value":"B"}}`},
+			err:                nil,
 		},
 		{
 			name:               "Send type filter",
 			filter:             "type=mongo",
 			expectedFilterType: "type",
-			expectedFilters: []string{`{
-	"equals": {
-		"field": "type",
-		"value": "mongo"
-		}
-	}`,
-			},
-			err: nil,
+			expectedFilters:    []string{`{"equals":{"field":"type","value":"mongo"}}`},
+			err:                nil,
+		},
+		{
+			name:               "Send label with quotes",
+			filter:             `label=a:"quoted"`,
+			expectedFilterType: "label",
+			expectedFilters:    []string{`{"equals":{"field":"labels.key","value":"a"}}`, `{"equals":{"field":"labels.value","value":"\"quoted\""}}`},
+			err:                nil,
+		},
+		{
+			name:               "Send label with a space in the value",
+			filter:             "label=env:my value",
+			expectedFilterType: "label",
+			expectedFilters:    []string{`{"equals":{"field":"labels.key","value":"env"}}`, `{"equals":{"field":"labels.value","value":"my value"}}`},
+			err:                nil,
+		},
+		{
+			name:               "Send label with & in the key",
+			filter:             "label=a&b:c",
+			expectedFilterType: "label",
+			expectedFilters:    []string{`{"equals":{"field":"labels.key","value":"a&b"}}`, `{"equals":{"field":"labels.value","value":"c"}}`},
+			err:                nil,
+		},
+		{
+			name:               "Send type with a space and |",
+			filter:             "type=my type | other",
+			expectedFilterType: "type",
+			expectedFilters:    []string{`{"equals":{"field":"type","value":"my type | other"}}`},
+			err:                nil,
+		},
+		{
+			name:               "Send label and type conditions joined with &",
+			filter:             "label=env:dev & type=mongo",
+			expectedFilterType: "expression",
+			expectedFilters:    []string{`{"and":[{"and":[{"equals":{"field":"labels.key","value":"env"}},{"equals":{"field":"labels.value","value":"dev"}}]},{"equals":{"field":"type","value":"mongo"}}]}`},
+			err:                nil,
+		},
+		{
+			name:               "Send label with a quoted & followed by a condition",
+			filter:             `label=a:"x&type=y"`,
+			expectedFilterType: "label",
+			expectedFilters:    []string{`{"equals":{"field":"labels.key","value":"a"}}`, `{"equals":{"field":"labels.value","value":"\"x\u0026type=y\""}}`},
+			err:                nil,
 		},
 		{
 			name:   "Send unknown filter",
-			filter: "name=mongo",
-			err:    NewError(ErrorExitCode, "Filter type \"name\" does not exist"),
+			filter: "color=blue",
+			err:    NewError(ErrorExitCode, "Filter type \"color\" does not exist"),
 		},
 		{
 			name:   "Send filter with no =",
@@ -717,6 +817,25 @@ func Test_parseFilter(t *testing.T) {
 			filter: "type=",
 			err:    NewError(ErrorExitCode, "The value in the type filter \"type=\" cannot be empty"),
 		},
+		{
+			name:               "Send a filter expression",
+			filter:             "name=my-* & !label=env:dev",
+			expectedFilterType: "expression",
+			expectedFilters:    []string{`{"and":[{"wildcard":{"field":"name","value":"my-*"}},{"not":{"and":[{"equals":{"field":"labels.key","value":"env"}},{"equals":{"field":"labels.value","value":"dev"}}]}}]}`},
+			err:                nil,
+		},
+		{
+			name:               "Send a negated type filter",
+			filter:             "type!=mongo",
+			expectedFilterType: "expression",
+			expectedFilters:    []string{`{"not":{"equals":{"field":"type","value":"mongo"}}}`},
+			err:                nil,
+		},
+		{
+			name:   "Send an invalid filter expression",
+			filter: "(type=mongo",
+			err:    NewError(ErrorExitCode, "Invalid filter \"(type=mongo\": expected \")\" at position 12"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -733,69 +852,11 @@ func Test_parseFilter(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				assert.Equal(t, tc.expectedFilterType, filterType)
-				assert.Equal(t, tc.expectedFilters, filters)
-			}
-		})
-	}
-}
-
-// Test_getAndFilterString tests the getAndFilterString() function.
-func Test_getAndFilterString(t *testing.T) {
-	tests := []struct {
-		name                 string
-		filters              []string
-		expectedFilterString string
-		err                  error
-	}{
-		{
-			name: "Send two filters",
-			filters: []string{`{
-	"equals": {
-		"field": "labels.key",
-		"value": "A"
-		}
-	}`, `{
-	"equals": {
-		"field": "labels.value",
-		"value": "C"
-		}
-	}`},
-			expectedFilterString: "{\"and\":[{\n\t\"equals\": {\n\t\t\"field\": \"labels.key\",\n\t\t\"value\": \"A\"\n\t\t}\n\t},{\n\t\"equals\": {\n\t\t\"field\": \"labels.value\",\n\t\t\"value\": \"C\"\n\t\t}\n\t}]}",
-			err:                  nil,
-		},
-		{
-			name: "Send one filter",
-			filters: []string{`{
-	"equals": {
-		"field": "labels.key",
-		"value": "A"
-		}
-	}`},
-			expectedFilterString: `{
-	"equals": {
-		"field": "labels.key",
-		"value": "A"
-		}
-	}`,
-			err: nil,
-		},
-		{
-			name:                 "Send no filters",
-			filters:              []string{},
-			expectedFilterString: `{}`,
-			err:                  nil,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			filterString, err := getAndFilterString(tc.filters)
-
-			if tc.err != nil {
-				assert.EqualError(t, err, tc.err.Error())
-			} else {
-				require.NoError(t, err)
-				assert.Equal(t, tc.expectedFilterString, filterString)
+				raws := []string{}
+				for _, filter := range filters {
+					raws = append(raws, filter.Raw)
+				}
+				assert.Equal(t, tc.expectedFilters, raws)
 			}
 		})
 	}
@@ -1062,6 +1123,38 @@ func TestBuildEntitiesFilter(t *testing.T) {
     }
   ]
 }
+`,
+			err: nil,
+		},
+		{
+			name:    "Label filter with a filter expression",
+			filters: []string{"label=A", "name=my-* | updated>=2026-10-01"},
+			expectedFilter: `{
+  "and": [
+    {
+      "equals": {
+        "field": "labels.key",
+        "value": "A"
+      }
+    },
+    {
+      "or": [
+        {
+          "wildcard": {
+            "field": "name",
+            "value": "my-*"
+          }
+        },
+        {
+          "range": {
+            "field": "lastUpdatedTimestamp",
+            "gte": "2026-10-01T00:00:00Z"
+          }
+        }
+      ]
+    }
+  ]
+}
 `,
 			err: nil,
 		},
@@ -1070,9 +1163,9 @@ func TestBuildEntitiesFilter(t *testing.T) {
 		{
 
 			name:           "Filter that does not exist",
-			filters:        []string{"name=mongo"},
+			filters:        []string{"color=blue"},
 			expectedFilter: ``,
-			err:            NewError(ErrorExitCode, "Filter type \"name\" does not exist"),
+			err:            NewError(ErrorExitCode, "Filter type \"color\" does not exist"),
 		},
 	}
 
@@ -1649,6 +1742,13 @@ func Test_discovery_SearchUpsertEntity(t *testing.T) {
 			expected: gjson.Result{},
 			err:      errors.New("entities must have a name"),
 		},
+		{
+			name:     "SearchUpsertEntity does not create an entity if several entities have the name",
+			client:   new(mocks.AmbiguousSearcher),
+			config:   gjson.Parse(`{"type": "mongo", "name": "MongoDB Atlas Server"}`),
+			expected: gjson.Result{},
+			err:      NewErrorWithCause(ErrorExitCode, discoveryPackage.AmbiguousNameError{Name: "MongoDB Atlas Server", Candidates: make([]gjson.Result, 2)}, "Several entities have the name \"MongoDB Atlas Server\". Use the id or the {type}/{name} format to choose one of them:\n- id: 986ce864-af76-4fcb-8b4f-f4e4c6ab0951, type: mongo, labels: []\n- id: 8f14c11c-bb66-49d3-aa2a-dedff4608c17, type: openai, labels: [A:B, C]"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -2073,6 +2173,12 @@ func Test_discovery_SearchDeleteEntity(t *testing.T) {
 }`),
 			}, "Could not search for entity with name \"MongoDB Atlas Server\""),
 		},
+		{
+			name:    "Several entities have the name",
+			client:  new(mocks.AmbiguousSearcher),
+			printer: nil,
+			err:     NewErrorWithCause(ErrorExitCode, NewErrorWithCause(ErrorExitCode, discoveryPackage.AmbiguousNameError{Name: "MongoDB Atlas Server", Candidates: make([]gjson.Result, 2)}, "Several entities have the name \"MongoDB Atlas Server\". Use the id or the {type}/{name} format to choose one of them:\n- id: 986ce864-af76-4fcb-8b4f-f4e4c6ab0951, type: mongo, labels: []\n- id: 8f14c11c-bb66-49d3-aa2a-dedff4608c17, type: openai, labels: [A:B, C]"), "Could not search for entity with name \"MongoDB Atlas Server\""),
+		},
 		{
 			name:      "Printing fails",
 			client:    new(mocks.WorkingSearchDeleter),
@@ -2113,3 +2219,357 @@ func Test_discovery_SearchDeleteEntity(t *testing.T) {
 		})
 	}
 }
+
+// Test_discovery_SearchCloneEntity tests the discovery.SearchCloneEntity() function.
+func Test_discovery_SearchCloneEntity(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         SearchCloner
+		config         CloneConfig
+		printer        Printer
+		expectedOutput string
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working case
+		{
+			name:           "SearchCloneEntity prints the copy with the pretty printer",
+			client:         new(mocks.WorkingSearchCloner),
+			config:         CloneConfig{},
+			printer:        nil,
+			expectedOutput: "{\n  \"id\": \"c77caced-b1d4-49de-b690-09bac3bc80a7\",\n  \"labels\": [\n    {\n      \"key\": \"A\",\n      \"value\": \"A\"\n    },\n    {\n      \"key\": \"B\"\n    }\n  ],\n  \"name\": \"MongoDB Atlas server clone\",\n  \"type\": \"mongo\"\n}\n",
+			err:            nil,
+		},
+		{
+			name:           "SearchCloneEntity sends the name, URI and depth of the copy",
+			client:         new(mocks.WorkingSearchCloner),
+			config:         CloneConfig{Name: "my-copy", URI: "/my-copy", Deep: true},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"deep\":\"true\",\"id\":\"c77caced-b1d4-49de-b690-09bac3bc80a7\",\"labels\":[{\"key\":\"A\",\"value\":\"A\"},{\"key\":\"B\"}],\"name\":\"my-copy\",\"type\":\"mongo\",\"uri\":\"/my-copy\"}\n",
+			err:            nil,
+		},
+		{
+			name:           "SearchCloneEntity adds the labels to the copy",
+			client:         new(mocks.WorkingSearchCloner),
+			config:         CloneConfig{Labels: []string{"A:B", "C", "D:E:F"}},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"id\":\"c77caced-b1d4-49de-b690-09bac3bc80a7\",\"labels\":[{\"key\":\"B\"},{\"key\":\"A\",\"value\":\"B\"},{\"key\":\"C\"},{\"key\":\"D\",\"value\":\"E:F\"}],\"name\":\"MongoDB Atlas server clone\",\"type\":\"mongo\"}\n",
+			err:            nil,
+		},
+
+		// Error case
+		{
+			name:    "Label without a key",
+			client:  new(mocks.WorkingSearchCloner),
+			config:  CloneConfig{Labels: []string{":B"}},
+			printer: nil,
+			err:     NewError(ErrorExitCode, "Label \":B\" does not follow the format {key}[:{value}]"),
+		},
+		{
+			name:    "Search fails",
+			client:  new(mocks.FailingSearchClonerSearchFails),
+			config:  CloneConfig{},
+			printer: nil,
+			err: NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "MongoDB Atlas Server" does not exist"
+	],
+	"timestamp": "2025-09-30T15:38:42.885125200Z"
+}`),
+			}, "Could not search for entity with name \"MongoDB Atlas Server\""),
+		},
+		{
+			name:    "Clone returns 409 Conflict",
+			client:  new(mocks.FailingSearchClonerCloneFails),
+			config:  CloneConfig{},
+			printer: nil,
+			err: NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusConflict, Body: gjson.Parse(`{
+	"status": 409,
+	"code": 4002,
+	"messages": [
+		"Duplicated entity: MongoDB Atlas server clone"
+	]
+}`)}, "Could not clone entity with id \"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\""),
+		},
+		{
+			name:    "Update of the labels returns 400 Bad Request",
+			client:  new(mocks.FailingSearchClonerUpdateFails),
+			config:  CloneConfig{Labels: []string{"A:B"}},
+			printer: nil,
+			err: NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{
+	"status": 400,
+	"code": 3002,
+	"messages": [
+		"The label key cannot be blank"
+	]
+}`)}, "Could not add the labels to the copy with id \"c77caced-b1d4-49de-b690-09bac3bc80a7\""),
+		},
+		{
+			name:      "Printing fails",
+			client:    new(mocks.WorkingSearchCloner),
+			config:    CloneConfig{},
+			printer:   nil,
+			outWriter: testutils.ErrWriter{Err: errors.New("write failed")},
+			err:       NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not print JSON object"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.SearchCloneEntity(tc.client, "MongoDB Atlas Server", tc.config, tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// Test_discovery_SearchEnableEntities tests the discovery.SearchEnableEntities() function.
+func Test_discovery_SearchEnableEntities(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         SearchEnabler
+		names          []string
+		filter         gjson.Result
+		enable         bool
+		printer        Printer
+		expectedOutput string
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working case
+		{
+			name:           "SearchEnableEntities enables the entity of a name",
+			client:         new(mocks.WorkingSearchEnabler),
+			names:          []string{"MongoDB Atlas server"},
+			enable:         true,
+			printer:        nil,
+			expectedOutput: "{\"acknowledged\":true,\"id\":\"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\"name\":\"MongoDB Atlas server\"}\n",
+			err:            nil,
+		},
+		{
+			name:           "SearchEnableEntities disables the entities of the names and the filter only once",
+			client:         new(mocks.WorkingSearchEnabler),
+			names:          []string{"MongoDB Atlas server"},
+			filter:         discoveryPackage.Equals("type", "mongo"),
+			enable:         false,
+			printer:        JsonArrayPrinter(true),
+			expectedOutput: "[\n  {\n    \"acknowledged\": true,\n    \"id\": \"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\n    \"name\": \"MongoDB Atlas server\"\n  },\n  {\n    \"acknowledged\": true,\n    \"id\": \"8f14c11c-bb66-49d3-aa2a-dedff4608c17\",\n    \"name\": \"MongoDB Atlas server clone 1\"\n  }\n]\n",
+			err:            nil,
+		},
+		{
+			name:           "SearchEnableEntities does not change any entity when the filter does not match them",
+			client:         new(mocks.FailingSearchEnablerSearchFails),
+			filter:         discoveryPackage.Equals("type", "mongo"),
+			enable:         true,
+			printer:        nil,
+			expectedOutput: "",
+			err:            nil,
+		},
+
+		// Error case
+		{
+			name:           "The name does not exist",
+			client:         new(mocks.FailingSearchEnablerSearchFails),
+			names:          []string{"MongoDB Atlas server"},
+			enable:         true,
+			printer:        nil,
+			expectedOutput: "{\"error\":\"status: 404, body: {\\n\\t\\\"status\\\": 404,\\n\\t\\\"code\\\": 1003,\\n\\t\\\"messages\\\": [\\n\\t\\t\\\"Entity not found: entity with name \\\"MongoDB Atlas server\\\" does not exist\\\"\\n\\t],\\n\\t\\\"timestamp\\\": \\\"2025-09-30T15:38:42.885125200Z\\\"\\n}\\n\",\"name\":\"MongoDB Atlas server\"}\n",
+			err:            NewError(ErrorExitCode, "Could not enable 1 of the 1 entities"),
+		},
+		{
+			name:           "One of the entities cannot be disabled",
+			client:         new(mocks.FailingSearchEnablerToggleFails),
+			filter:         discoveryPackage.Equals("type", "mongo"),
+			enable:         false,
+			printer:        nil,
+			expectedOutput: "{\"acknowledged\":true,\"id\":\"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\"name\":\"MongoDB Atlas server\"}\n{\"error\":{\"code\":1003,\"messages\":[\"Entity not found: 8f14c11c-bb66-49d3-aa2a-dedff4608c17\"],\"status\":404},\"id\":\"8f14c11c-bb66-49d3-aa2a-dedff4608c17\",\"name\":\"MongoDB Atlas server clone 1\"}\n",
+			err:            NewError(ErrorExitCode, "Could not disable 1 of the 2 entities"),
+		},
+		{
+			name:   "The search of the filter fails",
+			client: &mocks.WorkingSearchEnabler{Err: discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status": 500, "code": 5000, "messages": ["Internal server error"]}`)}},
+			names:  []string{"MongoDB Atlas server"},
+			filter: discoveryPackage.Equals("type", "mongo"),
+			enable: true,
+			err:    NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status": 500, "code": 5000, "messages": ["Internal server error"]}`)}, "Could not search for the entities to enable"),
+		},
+		{
+			name:      "Printing fails",
+			client:    new(mocks.WorkingSearchEnabler),
+			names:     []string{"MongoDB Atlas server"},
+			enable:    true,
+			printer:   nil,
+			outWriter: testutils.ErrWriter{Err: errors.New("write failed")},
+			err:       NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not print JSON Array"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.SearchEnableEntities(tc.client, tc.names, tc.filter, tc.enable, tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expectedOutput != "" {
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// Test_discovery_GetEntities_Streaming tests that GetEntities() prints the entities as they are streamed by the client.
+func Test_discovery_GetEntities_Streaming(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         Getter
+		config         ListConfig
+		expectedOutput string
+		err            error
+	}{
+		{
+			name:           "GetEntities prints every streamed entity",
+			client:         &mocks.StreamingGetter{},
+			expectedOutput: "{\"id\":\"3393f6d9-94c1-4b70-ba02-5f582727d998\",\"name\":\"MongoDB text processor 4\"}\n{\"id\":\"5f125024-1e5e-4591-9fee-365dc20eeeed\",\"name\":\"MongoDB text processor\"}\n",
+			err:            nil,
+		},
+		{
+			name:           "GetEntities prints the entities received before the stream fails",
+			client:         &mocks.StreamingGetter{Err: discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"error":"Internal Server Error"}`)}},
+			expectedOutput: "{\"id\":\"3393f6d9-94c1-4b70-ba02-5f582727d998\",\"name\":\"MongoDB text processor 4\"}\n{\"id\":\"5f125024-1e5e-4591-9fee-365dc20eeeed\",\"name\":\"MongoDB text processor\"}\n",
+			err:            NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"error":"Internal Server Error"}`)}, "Could not get all entities"),
+		},
+		{
+			name:           "GetEntities stops consuming the stream once the limit is reached",
+			client:         &mocks.StreamingGetter{Err: discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"error":"Internal Server Error"}`)}},
+			config:         ListConfig{Limit: 1},
+			expectedOutput: "{\"id\":\"3393f6d9-94c1-4b70-ba02-5f582727d998\",\"name\":\"MongoDB text processor 4\"}\n",
+			err:            nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: buf,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.GetEntities(tc.client, tc.config, nil)
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedOutput, buf.String())
+		})
+	}
+}
+
+// Test_discovery_SearchEntities_Streaming tests that SearchEntities() prints the results as they are streamed by the client.
+func Test_discovery_SearchEntities_Streaming(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         Searcher
+		config         ListConfig
+		expectedOutput string
+		err            error
+	}{
+		{
+			name:           "SearchEntities prints every streamed result",
+			client:         &mocks.StreamingSearcher{},
+			expectedOutput: "[\n  {\n    \"id\": \"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\n    \"name\": \"MongoDB Atlas server clone\"\n  }\n]\n",
+			err:            nil,
+		},
+		{
+			name:           "SearchEntities prints the results received before the stream fails",
+			client:         &mocks.StreamingSearcher{Err: discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}},
+			expectedOutput: "[\n  {\n    \"id\": \"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\n    \"name\": \"MongoDB Atlas server clone\"\n  }",
+			err:            NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}, "Could not search for the entities"),
+		},
+		{
+			name:           "SearchEntities stops consuming the stream once the limit is reached",
+			client:         &mocks.StreamingSearcher{Err: discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}},
+			config:         ListConfig{Limit: 1},
+			expectedOutput: "[\n  {\n    \"id\": \"986ce864-af76-4fcb-8b4f-f4e4c6ab0951\",\n    \"name\": \"MongoDB Atlas server clone\"\n  }\n]\n",
+			err:            nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: buf,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.SearchEntities(tc.client, gjson.Result{}, tc.config, JsonArrayStreamPrinter(true))
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedOutput, buf.String())
+		})
+	}
+}
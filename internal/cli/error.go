@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
+	"net"
+	"net/url"
 	"syscall"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 )
 
 // ExitCode is a type definition that is used to define the possible execution exit codes.
@@ -27,6 +32,11 @@ func (e Error) Error() string {
 	}
 }
 
+// Unwrap returns the cause of the error, so that errors.Is() and errors.As() can inspect it.
+func (e Error) Unwrap() error {
+	return e.Cause
+}
+
 // The following constants represent possible exit codes of the CLI.
 const (
 	// This code is used when the CLI finished the command successfully.
@@ -35,8 +45,38 @@ const (
 	ErrorExitCode ExitCode = 1
 	// This code is used when the CLI failed because it panicked somewhere in the code.
 	PanicErrorExitCode ExitCode = 2
+	// This code is used when the CLI failed because Discovery could not find an entity.
+	NotFoundExitCode ExitCode = 3
+	// This code is used when the CLI failed because Discovery rejected the API key.
+	AuthExitCode ExitCode = 4
+	// This code is used when the CLI failed because the entity conflicts with an existing one in Discovery.
+	ConflictExitCode ExitCode = 5
+	// This code is used when the CLI failed because Discovery rejected the request as invalid.
+	ValidationExitCode ExitCode = 6
+	// This code is used when the CLI failed because it could not connect to Discovery or the request timed out.
+	NetworkExitCode ExitCode = 7
 )
 
+// ExitCodeFromError obtains the exit code that corresponds to the cause of the error.
+// The Discovery errors are mapped by their status and the connection errors and timeouts are mapped to the NetworkExitCode.
+// Any other error is mapped to the ErrorExitCode.
+func ExitCodeFromError(err error) ExitCode {
+	switch {
+	case discoveryPackage.IsNotFound(err):
+		return NotFoundExitCode
+	case discoveryPackage.IsUnauthorized(err):
+		return AuthExitCode
+	case discoveryPackage.IsConflict(err):
+		return ConflictExitCode
+	case discoveryPackage.IsBadRequest(err):
+		return ValidationExitCode
+	case isNetworkError(err):
+		return NetworkExitCode
+	default:
+		return ErrorExitCode
+	}
+}
+
 // NewErrorWithCause creates an Error with a cause. It receives the exit code, cause, message, and any arguments that can be added to the message in a formatted string.
 func NewErrorWithCause(code ExitCode, cause error, message string, args ...any) Error {
 	return Error{
@@ -55,15 +95,37 @@ func NewError(code ExitCode, message string, args ...any) Error {
 	}
 }
 
+// isNetworkError checks if the error was caused by a failed connection to Discovery or a request that timed out.
+// The requests canceled by the user are not network errors.
+func isNetworkError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	var urlErr *url.Error
+	return errors.As(err, &opErr) ||
+		errors.As(err, &dnsErr) ||
+		errors.As(err, &urlErr) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
 // FromError transforms an error into the Error struct.
+// If the error has the generic ErrorExitCode, its exit code is obtained from its cause with the ExitCodeFromError() function.
 func FromError(err error) *Error {
 	if err != nil {
 		var e Error
 		if errors.As(err, &e) {
+			if e.ExitCode == ErrorExitCode {
+				e.ExitCode = ExitCodeFromError(e.Cause)
+			}
 			return &e
 		}
 		return &Error{
-			ExitCode: ErrorExitCode,
+			ExitCode: ExitCodeFromError(err),
 			Message:  "",
 			Cause:    err,
 		}
@@ -1,13 +1,20 @@
 package cli
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
 	"syscall"
 	"testing"
 
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 )
 
 // TestError_Error tests the Error.Error() function that outputs a string.
@@ -178,6 +185,27 @@ func TestFromError(t *testing.T) {
 			expectedMsg:   "",
 			expectedCause: "JSON unmarshal failed",
 		},
+		{
+			name:          "The exit code of an Error is obtained from its Discovery cause",
+			input:         NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{"status":404}`)}, "Could not get the seed"),
+			expectedCode:  NotFoundExitCode,
+			expectedMsg:   "Could not get the seed",
+			expectedCause: "status: 404, body: {\"status\":404}\n",
+		},
+		{
+			name:          "The exit code of an Error that is not generic is kept",
+			input:         NewErrorWithCause(PanicErrorExitCode, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{"status":404}`)}, "The CLI panicked"),
+			expectedCode:  PanicErrorExitCode,
+			expectedMsg:   "The CLI panicked",
+			expectedCause: "status: 404, body: {\"status\":404}\n",
+		},
+		{
+			name:          "The exit code of a generic error is obtained from the error",
+			input:         &url.Error{Op: "Get", URL: "http://localhost:12010/v2/seed", Err: syscall.ECONNREFUSED},
+			expectedCode:  NetworkExitCode,
+			expectedMsg:   "",
+			expectedCause: "Get \"http://localhost:12010/v2/seed\": connection refused",
+		},
 		{
 			name:          "FromError receives nil",
 			input:         nil,
@@ -207,6 +235,51 @@ func TestFromError(t *testing.T) {
 	}
 }
 
+// TestError_Unwrap tests that errors.Is() and errors.As() inspect the cause of the Error.
+func TestError_Unwrap(t *testing.T) {
+	cause := discoveryPackage.Error{Status: http.StatusConflict, Body: gjson.Parse(`{"status":409}`)}
+	err := NewErrorWithCause(ErrorExitCode, cause, "Could not create the bucket")
+
+	assert.Equal(t, cause, err.Unwrap())
+	assert.True(t, discoveryPackage.IsConflict(err))
+
+	var discoveryErr discoveryPackage.Error
+	require.ErrorAs(t, err, &discoveryErr)
+	assert.Equal(t, http.StatusConflict, discoveryErr.Status)
+	assert.Nil(t, NewError(ErrorExitCode, "No cause").Unwrap())
+}
+
+// TestExitCodeFromError tests the ExitCodeFromError() function.
+func TestExitCodeFromError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected ExitCode
+	}{
+		{name: "Not found", err: discoveryPackage.Error{Status: http.StatusNotFound}, expected: NotFoundExitCode},
+		{name: "Unauthorized", err: discoveryPackage.Error{Status: http.StatusUnauthorized}, expected: AuthExitCode},
+		{name: "Forbidden", err: discoveryPackage.Error{Status: http.StatusForbidden}, expected: AuthExitCode},
+		{name: "Conflict", err: discoveryPackage.Error{Status: http.StatusConflict}, expected: ConflictExitCode},
+		{name: "Bad request", err: discoveryPackage.Error{Status: http.StatusBadRequest}, expected: ValidationExitCode},
+		{name: "Unprocessable entity", err: discoveryPackage.Error{Status: http.StatusUnprocessableEntity}, expected: ValidationExitCode},
+		{name: "Internal server error", err: discoveryPackage.Error{Status: http.StatusInternalServerError}, expected: ErrorExitCode},
+		{name: "Wrapped Discovery error", err: NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusNotFound}, "Could not get the seed"), expected: NotFoundExitCode},
+		{name: "Connection refused", err: &url.Error{Op: "Get", URL: "http://localhost:12010", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}, expected: NetworkExitCode},
+		{name: "Unknown host", err: &net.DNSError{Err: "no such host", Name: "discovery.local", IsNotFound: true}, expected: NetworkExitCode},
+		{name: "Timeout", err: fmt.Errorf("request failed: %w", context.DeadlineExceeded), expected: NetworkExitCode},
+		{name: "Canceled request", err: &url.Error{Op: "Get", URL: "http://localhost:12010", Err: context.Canceled}, expected: ErrorExitCode},
+		{name: "File error", err: &fs.PathError{Op: "mkdir", Path: "/home/.discovery", Err: syscall.ENOTDIR}, expected: ErrorExitCode},
+		{name: "Generic error", err: errors.New("invalid JSON"), expected: ErrorExitCode},
+		{name: "No error", err: nil, expected: ErrorExitCode},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ExitCodeFromError(tc.err))
+		})
+	}
+}
+
 // TestNormalizeReadFileError tests the NormalizeReadFileError.
 func TestNormalizeReadFileError(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,488 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+)
+
+// The filter expressions of the get commands are compiled to Discovery's DSL with the following grammar:
+//
+//	expression := and ("|" and)*
+//	and        := unary ("&" unary)*
+//	unary      := "!" unary | "(" expression ")" | condition
+//	condition  := name operator value
+//	operator   := "=" | "!=" | ">" | ">=" | "<" | "<="
+//
+// The value of a condition ends at a space or at one of the ")", "|" and "&" characters, unless they are quoted with double quotes.
+// A filter that is a single label or type condition, like label=env:my value, is not parsed with this grammar, so its value is the rest of the filter as it is.
+// The field conditions compare the value of any field of the entities, like field=config.index:products or field=config.batchSize>=10.
+// For example, (type=mongo | type=openai) & !label=env:dev & updated>2026-10-01.
+
+// timestampFields maps the names of the timestamp conditions to the fields of the entities.
+var timestampFields = map[string]string{
+	"created": "creationTimestamp",
+	"updated": "lastUpdatedTimestamp",
+}
+
+// filterSegment is a part of the value of a condition. The quoted segments are taken literally.
+type filterSegment struct {
+	text   string
+	quoted bool
+}
+
+// filterValue is the value of a condition, which is made of quoted and unquoted segments.
+type filterValue []filterSegment
+
+// String joins the text of the segments.
+func (v filterValue) String() string {
+	var builder strings.Builder
+	for _, segment := range v {
+		builder.WriteString(segment.text)
+	}
+	return builder.String()
+}
+
+// cut splits the value at the first unquoted occurrence of any of the given separators, which are checked in order at each position.
+// It returns the separator that was found, or an empty string if there is not one.
+func (v filterValue) cut(separators ...string) (filterValue, string, filterValue) {
+	for i, segment := range v {
+		if segment.quoted {
+			continue
+		}
+		for j := range len(segment.text) {
+			for _, separator := range separators {
+				if strings.HasPrefix(segment.text[j:], separator) {
+					left := append(v[:i:i], filterSegment{text: segment.text[:j]})
+					right := append(filterValue{{text: segment.text[j+len(separator):]}}, v[i+1:]...)
+					return left, separator, right
+				}
+			}
+		}
+	}
+	return v, "", nil
+}
+
+// isPattern checks if an unquoted segment of the value has a * or ? wildcard.
+func (v filterValue) isPattern() bool {
+	for _, segment := range v {
+		if !segment.quoted && strings.ContainsAny(segment.text, "*?") {
+			return true
+		}
+	}
+	return false
+}
+
+// literal returns the value as a JSON number or boolean if it is not quoted and it can be parsed as one. Otherwise, it returns the value as a string.
+func (v filterValue) literal() any {
+	text := v.String()
+	for _, segment := range v {
+		if segment.quoted {
+			return text
+		}
+	}
+
+	if result := gjson.Parse(text); gjson.Valid(text) && (result.Type == gjson.Number || result.Type == gjson.True || result.Type == gjson.False) {
+		return result
+	}
+	return text
+}
+
+// filterCondition is a condition of a filter expression, like label=A:B or updated>2026-10-01.
+type filterCondition struct {
+	source   string
+	name     string
+	operator string
+	value    filterValue
+}
+
+// filterNode is a node of a parsed filter expression.
+// The "and", "or" and "not" nodes have children, and the nodes without an operator are conditions.
+type filterNode struct {
+	operator  string
+	children  []filterNode
+	condition filterCondition
+}
+
+// filterParser parses a filter expression.
+type filterParser struct {
+	input string
+	pos   int
+}
+
+// syntaxError returns the error of an invalid filter expression at the current position.
+func (p *filterParser) syntaxError(message string) error {
+	return NewError(ErrorExitCode, "Invalid filter %q: %s at position %d", p.input, message, p.pos+1)
+}
+
+// skipSpaces advances the parser to the next character that is not a space.
+func (p *filterParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\n\r", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// peek skips the spaces and checks if the next character is the given one.
+func (p *filterParser) peek(c byte) bool {
+	p.skipSpaces()
+	return p.pos < len(p.input) && p.input[p.pos] == c
+}
+
+// parseExpression parses the operands of the "|" operator.
+func (p *filterParser) parseExpression() (filterNode, error) {
+	return p.parseBinary("or", '|', p.parseAnd)
+}
+
+// parseAnd parses the operands of the "&" operator.
+func (p *filterParser) parseAnd() (filterNode, error) {
+	return p.parseBinary("and", '&', p.parseUnary)
+}
+
+// parseBinary parses the operands of a binary operator with the given function.
+// If there is a single operand, it is returned as it is.
+func (p *filterParser) parseBinary(operator string, symbol byte, parseOperand func() (filterNode, error)) (filterNode, error) {
+	node, err := parseOperand()
+	if err != nil {
+		return filterNode{}, err
+	}
+
+	children := []filterNode{node}
+	for p.peek(symbol) {
+		p.pos++
+		node, err := parseOperand()
+		if err != nil {
+			return filterNode{}, err
+		}
+		children = append(children, node)
+	}
+
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return filterNode{operator: operator, children: children}, nil
+}
+
+// parseUnary parses a negation, a group in parentheses or a condition.
+func (p *filterParser) parseUnary() (filterNode, error) {
+	switch {
+	case p.peek('!'):
+		p.pos++
+		node, err := p.parseUnary()
+		if err != nil {
+			return filterNode{}, err
+		}
+		return filterNode{operator: "not", children: []filterNode{node}}, nil
+	case p.peek('('):
+		p.pos++
+		node, err := p.parseExpression()
+		if err != nil {
+			return filterNode{}, err
+		}
+		if !p.peek(')') {
+			return filterNode{}, p.syntaxError(`expected ")"`)
+		}
+		p.pos++
+		return node, nil
+	default:
+		return p.parseCondition()
+	}
+}
+
+// parseCondition parses a condition with its name, operator and value.
+func (p *filterParser) parseCondition() (filterNode, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) && isFilterNameChar(p.input[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		return filterNode{}, p.syntaxError("expected a condition")
+	}
+	name := p.input[start:p.pos]
+
+	operator := ""
+	for _, candidate := range []string{"!=", ">=", "<=", "=", ">", "<"} {
+		if strings.HasPrefix(p.input[p.pos:], candidate) {
+			operator = candidate
+			break
+		}
+	}
+	if operator == "" {
+		end := p.pos
+		for end < len(p.input) && !isFilterDelimiter(p.input[end]) {
+			end++
+		}
+		return filterNode{}, NewError(ErrorExitCode, "Filter %q does not follow the format {type}={key}[:{value}]", p.input[start:end])
+	}
+	p.pos += len(operator)
+
+	value, err := p.parseValue()
+	if err != nil {
+		return filterNode{}, err
+	}
+
+	return filterNode{condition: filterCondition{
+		source:   p.input[start:p.pos],
+		name:     name,
+		operator: operator,
+		value:    value,
+	}}, nil
+}
+
+// parseValue parses the quoted and unquoted segments of the value of a condition.
+func (p *filterParser) parseValue() (filterValue, error) {
+	value := filterValue{}
+	for p.pos < len(p.input) && !isFilterDelimiter(p.input[p.pos]) {
+		if p.input[p.pos] != '"' {
+			start := p.pos
+			for p.pos < len(p.input) && !isFilterDelimiter(p.input[p.pos]) && p.input[p.pos] != '"' {
+				p.pos++
+			}
+			value = append(value, filterSegment{text: p.input[start:p.pos]})
+			continue
+		}
+
+		start := p.pos
+		p.pos++
+		var builder strings.Builder
+		for {
+			if p.pos >= len(p.input) {
+				p.pos = start
+				return nil, p.syntaxError("unterminated quoted value")
+			}
+			c := p.input[p.pos]
+			p.pos++
+			if c == '"' {
+				break
+			}
+			if c == '\\' && p.pos < len(p.input) {
+				c = p.input[p.pos]
+				p.pos++
+			}
+			builder.WriteByte(c)
+		}
+		value = append(value, filterSegment{text: builder.String(), quoted: true})
+	}
+	return value, nil
+}
+
+// isFilterNameChar checks if the character can be part of the name of a condition.
+func isFilterNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+// isFilterDelimiter checks if the character ends an unquoted value.
+func isFilterDelimiter(c byte) bool {
+	return strings.IndexByte(" \t\n\r)|&", c) >= 0
+}
+
+// isSingleEqualityFilter checks if the filter is a single label or type condition, like label=env:my value or type=my type.
+// The filter is an expression instead if an unquoted "|" or "&" is followed by another condition, a negation or a group.
+func isSingleEqualityFilter(filter string) bool {
+	if !strings.HasPrefix(filter, "label=") && !strings.HasPrefix(filter, "type=") {
+		return false
+	}
+
+	quoted := false
+	for i := 0; i < len(filter); i++ {
+		switch c := filter[i]; {
+		case c == '\\' && quoted:
+			i++
+		case c == '"':
+			quoted = !quoted
+		case !quoted && (c == '|' || c == '&') && startsFilterOperand(strings.TrimLeft(filter[i+1:], " \t\n\r")):
+			return false
+		}
+	}
+	return true
+}
+
+// startsFilterOperand checks if the text starts with a negation, a group or the name and operator of a condition.
+func startsFilterOperand(text string) bool {
+	if strings.HasPrefix(text, "!") || strings.HasPrefix(text, "(") {
+		return true
+	}
+
+	end := 0
+	for end < len(text) && isFilterNameChar(text[end]) {
+		end++
+	}
+	return end > 0 && end < len(text) && strings.IndexByte("=!<>", text[end]) >= 0
+}
+
+// parseFilterExpression parses the filter expression.
+func parseFilterExpression(filter string) (filterNode, error) {
+	parser := &filterParser{input: filter}
+	node, err := parser.parseExpression()
+	if err != nil {
+		return filterNode{}, err
+	}
+
+	parser.skipSpaces()
+	if parser.pos < len(filter) {
+		return filterNode{}, parser.syntaxError("unexpected " + `"` + filter[parser.pos:parser.pos+1] + `"`)
+	}
+	return node, nil
+}
+
+// compile converts the node to Discovery's DSL.
+func (n filterNode) compile() (gjson.Result, error) {
+	if n.operator == "" {
+		return n.condition.compile()
+	}
+
+	filters := make([]gjson.Result, 0, len(n.children))
+	for _, child := range n.children {
+		filter, err := child.compile()
+		if err != nil {
+			return gjson.Result{}, err
+		}
+		filters = append(filters, filter)
+	}
+
+	switch n.operator {
+	case "and":
+		return discoveryPackage.And(filters...), nil
+	case "or":
+		return discoveryPackage.Or(filters...), nil
+	default:
+		return discoveryPackage.Not(filters[0]), nil
+	}
+}
+
+// equalityFilters returns the equals filters of the label and type conditions, which are combined through the "and" operator.
+func (c filterCondition) equalityFilters() ([]gjson.Result, error) {
+	switch c.name {
+	case "label":
+		key, separator, value := c.value.cut(":")
+		if key.String() == "" {
+			return nil, NewError(ErrorExitCode, "The label's key in the filter %q cannot be empty", c.source)
+		}
+		filters := []gjson.Result{discoveryPackage.Equals("labels.key", key.String())}
+		if separator != "" {
+			if value.String() == "" {
+				return nil, NewError(ErrorExitCode, "The label's value in the filter %q cannot be empty if ':' is included", c.source)
+			}
+			filters = append(filters, discoveryPackage.Equals("labels.value", value.String()))
+		}
+		return filters, nil
+	default:
+		if c.value.String() == "" {
+			return nil, NewError(ErrorExitCode, "The value in the type filter %q cannot be empty", c.source)
+		}
+		return []gjson.Result{discoveryPackage.Equals("type", c.value.String())}, nil
+	}
+}
+
+// compile converts the condition to Discovery's DSL.
+func (c filterCondition) compile() (gjson.Result, error) {
+	var filter gjson.Result
+	switch c.name {
+	case "label", "type":
+		if err := c.checkEqualityOperator(); err != nil {
+			return gjson.Result{}, err
+		}
+		filters, err := c.equalityFilters()
+		if err != nil {
+			return gjson.Result{}, err
+		}
+		filter = discoveryPackage.And(filters...)
+	case "name":
+		if err := c.checkEqualityOperator(); err != nil {
+			return gjson.Result{}, err
+		}
+		if c.value.String() == "" {
+			return gjson.Result{}, NewError(ErrorExitCode, "The value in the name filter %q cannot be empty", c.source)
+		}
+		filter = matchFilter("name", c.value)
+	case "field":
+		if err := c.checkEqualityOperator(); err != nil {
+			return gjson.Result{}, err
+		}
+		path, comparison, value := c.value.cut(":", ">=", "<=", ">", "<")
+		if comparison == "" {
+			return gjson.Result{}, NewError(ErrorExitCode, "Filter %q does not follow the format field={path}{:|>|>=|<|<=}{value}", c.source)
+		}
+		if path.String() == "" {
+			return gjson.Result{}, NewError(ErrorExitCode, "The path in the field filter %q cannot be empty", c.source)
+		}
+		if comparison == ":" {
+			filter = matchFilter(path.String(), value)
+		} else {
+			filter = discoveryPackage.Range(path.String(), rangeBound(comparison, value.literal()))
+		}
+	default:
+		field, ok := timestampFields[c.name]
+		if !ok {
+			return gjson.Result{}, NewError(ErrorExitCode, "Filter type %q does not exist", c.name)
+		}
+		if c.operator == "=" || c.operator == "!=" {
+			return gjson.Result{}, NewError(ErrorExitCode, "The %s filter %q only supports the >, >=, < and <= operators", c.name, c.source)
+		}
+		timestamp, err := parseFilterTimestamp(c.value.String())
+		if err != nil {
+			return gjson.Result{}, NewErrorWithCause(ErrorExitCode, err, "The timestamp in the filter %q must be a date in the format YYYY-MM-DD or an RFC 3339 timestamp", c.source)
+		}
+		return discoveryPackage.Range(field, rangeBound(c.operator, timestamp)), nil
+	}
+
+	if c.operator == "!=" {
+		return discoveryPackage.Not(filter), nil
+	}
+	return filter, nil
+}
+
+// checkEqualityOperator checks that the condition uses the = or != operators.
+func (c filterCondition) checkEqualityOperator() error {
+	if c.operator != "=" && c.operator != "!=" {
+		return NewError(ErrorExitCode, "The %s filter %q only supports the = and != operators", c.name, c.source)
+	}
+	return nil
+}
+
+// matchFilter returns a wildcard filter if the value is a pattern, or an equals filter if it is not.
+func matchFilter(field string, value filterValue) gjson.Result {
+	if value.isPattern() {
+		return discoveryPackage.Wildcard(field, value.String())
+	}
+	return discoveryPackage.Equals(field, value.literal())
+}
+
+// rangeBound returns the bound of a range filter for the given comparison operator.
+func rangeBound(operator string, value any) discoveryPackage.RangeBound {
+	switch operator {
+	case ">":
+		return discoveryPackage.Gt(value)
+	case ">=":
+		return discoveryPackage.Gte(value)
+	case "<":
+		return discoveryPackage.Lt(value)
+	default:
+		return discoveryPackage.Lte(value)
+	}
+}
+
+// parseFilterTimestamp parses a date or an RFC 3339 timestamp and formats it as an RFC 3339 timestamp in UTC.
+func parseFilterTimestamp(value string) (string, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date.Format(time.RFC3339), nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", err
+	}
+	return timestamp.UTC().Format(time.RFC3339), nil
+}
+
+// ParseFilterJSON parses a filter written in Discovery's DSL.
+// It returns an error if it is not a JSON object.
+func ParseFilterJSON(filter string) (gjson.Result, error) {
+	if !gjson.Valid(filter) || !gjson.Parse(filter).IsObject() {
+		return gjson.Result{}, NewError(ErrorExitCode, "The filter JSON %q is not a valid JSON object", filter)
+	}
+	return gjson.Parse(filter), nil
+}
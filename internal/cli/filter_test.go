@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// Test_filterNode_compile tests that the filter expressions are parsed and compiled to Discovery's DSL.
+func Test_filterNode_compile(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     string
+		expected   string
+		errMessage string
+	}{
+		// Working cases
+		{
+			name:     "Name with an exact value",
+			filter:   "name=my-seed",
+			expected: `{"equals":{"field":"name","value":"my-seed"}}`,
+		},
+		{
+			name:     "Name with a prefix",
+			filter:   "name=my-*",
+			expected: `{"wildcard":{"field":"name","value":"my-*"}}`,
+		},
+		{
+			name:     "Name with a quoted wildcard character",
+			filter:   `name="my-*"`,
+			expected: `{"equals":{"field":"name","value":"my-*"}}`,
+		},
+		{
+			name:     "Field with a string",
+			filter:   "field=config.index:products",
+			expected: `{"equals":{"field":"config.index","value":"products"}}`,
+		},
+		{
+			name:     "Field with a number",
+			filter:   "field=config.batchSize:10",
+			expected: `{"equals":{"field":"config.batchSize","value":10}}`,
+		},
+		{
+			name:     "Field with a quoted number and colon",
+			filter:   `field=config.port:"10:20"`,
+			expected: `{"equals":{"field":"config.port","value":"10:20"}}`,
+		},
+		{
+			name:     "Field with a range",
+			filter:   "field=config.batchSize>=10 & field=config.batchSize<100",
+			expected: `{"and":[{"range":{"field":"config.batchSize","gte":10}},{"range":{"field":"config.batchSize","lt":100}}]}`,
+		},
+		{
+			name:     "Field that is not equal",
+			filter:   "field!=config.enabled:true",
+			expected: `{"not":{"equals":{"field":"config.enabled","value":true}}}`,
+		},
+		{
+			name:     "Updated with a date",
+			filter:   "updated>2026-10-01",
+			expected: `{"range":{"field":"lastUpdatedTimestamp","gt":"2026-10-01T00:00:00Z"}}`,
+		},
+		{
+			name:     "Created with a timestamp",
+			filter:   "created<=2026-10-01T12:30:00-06:00",
+			expected: `{"range":{"field":"creationTimestamp","lte":"2026-10-01T18:30:00Z"}}`,
+		},
+		{
+			name:     "Negation, groups and precedence",
+			filter:   "!(type=mongo | type=openai) & label=env:prod | name=test-?",
+			expected: `{"or":[{"and":[{"not":{"or":[{"equals":{"field":"type","value":"mongo"}},{"equals":{"field":"type","value":"openai"}}]}},{"and":[{"equals":{"field":"labels.key","value":"env"}},{"equals":{"field":"labels.value","value":"prod"}}]}]},{"wildcard":{"field":"name","value":"test-?"}}]}`,
+		},
+		{
+			name:     "Quoted value with spaces and escapes",
+			filter:   `label="my key":"say \"hi\""`,
+			expected: `{"and":[{"equals":{"field":"labels.key","value":"my key"}},{"equals":{"field":"labels.value","value":"say \"hi\""}}]}`,
+		},
+
+		// Error cases
+		{
+			name:       "Unknown condition",
+			filter:     "color=blue",
+			errMessage: "Filter type \"color\" does not exist",
+		},
+		{
+			name:       "Condition without operator",
+			filter:     "type=mongo & label",
+			errMessage: "Filter \"label\" does not follow the format {type}={key}[:{value}]",
+		},
+		{
+			name:       "Missing condition",
+			filter:     "type=mongo &",
+			errMessage: "Invalid filter \"type=mongo &\": expected a condition at position 13",
+		},
+		{
+			name:       "Unexpected parenthesis",
+			filter:     "type=mongo)",
+			errMessage: "Invalid filter \"type=mongo)\": unexpected \")\" at position 11",
+		},
+		{
+			name:       "Unterminated quoted value",
+			filter:     `name="my-seed`,
+			errMessage: "Invalid filter \"name=\\\"my-seed\": unterminated quoted value at position 6",
+		},
+		{
+			name:       "Empty name",
+			filter:     "name=",
+			errMessage: "The value in the name filter \"name=\" cannot be empty",
+		},
+		{
+			name:       "Range operator in a label filter",
+			filter:     "label>A",
+			errMessage: "The label filter \"label>A\" only supports the = and != operators",
+		},
+		{
+			name:       "Field without a path",
+			filter:     "field=products",
+			errMessage: "Filter \"field=products\" does not follow the format field={path}{:|>|>=|<|<=}{value}",
+		},
+		{
+			name:       "Range operator in a field filter",
+			filter:     "field>config.batchSize:10",
+			errMessage: "The field filter \"field>config.batchSize:10\" only supports the = and != operators",
+		},
+		{
+			name:       "Field with an empty path",
+			filter:     "field=:products",
+			errMessage: "The path in the field filter \"field=:products\" cannot be empty",
+		},
+		{
+			name:       "Equality operator in a timestamp filter",
+			filter:     "updated=2026-10-01",
+			errMessage: "The updated filter \"updated=2026-10-01\" only supports the >, >=, < and <= operators",
+		},
+		{
+			name:       "Invalid timestamp",
+			filter:     "created>yesterday",
+			errMessage: "The timestamp in the filter \"created>yesterday\" must be a date in the format YYYY-MM-DD or an RFC 3339 timestamp",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, err := parseFilterExpression(tc.filter)
+			var filter gjson.Result
+			if err == nil {
+				filter, err = node.compile()
+			}
+
+			if tc.errMessage != "" {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.Equal(t, tc.errMessage, errStruct.Message)
+			} else {
+				require.NoError(t, err)
+				assert.JSONEq(t, tc.expected, filter.Raw)
+			}
+		})
+	}
+}
+
+// TestParseFilterJSON tests the ParseFilterJSON() function.
+func TestParseFilterJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected string
+		err      error
+	}{
+		{
+			name:     "Valid filter",
+			filter:   `{"exists":{"field":"labels"}}`,
+			expected: `{"exists":{"field":"labels"}}`,
+		},
+		{
+			name:   "Invalid JSON",
+			filter: `{"exists":`,
+			err:    NewError(ErrorExitCode, "The filter JSON \"{\\\"exists\\\":\" is not a valid JSON object"),
+		},
+		{
+			name:   "JSON that is not an object",
+			filter: `["exists"]`,
+			err:    NewError(ErrorExitCode, "The filter JSON \"[\\\"exists\\\"]\" is not a valid JSON object"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := ParseFilterJSON(tc.filter)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.JSONEq(t, tc.expected, filter.Raw)
+			}
+		})
+	}
+}
@@ -1,6 +1,13 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
@@ -73,23 +80,168 @@ func (d discovery) HaltSeedExecution(client IngestionSeedExecutionController, ex
 	return printer(*d.IOStreams(), haltResult)
 }
 
+// inactiveExecutionStatuses are the statuses of the seed executions that finished. The executions with any other status are active.
+var inactiveExecutionStatuses = []string{"DONE", "HALTED", "FAILED"}
+
+// IngestionSeedResetter defines the methods to halt and reset a seed.
+type IngestionSeedResetter interface {
+	IngestionSeedController
+	Reset(id uuid.UUID) (gjson.Result, error)
+}
+
+// LastExecutionsGetter defines the method to get the last executions of a seed.
+type LastExecutionsGetter interface {
+	GetLast5Executions() (gjson.Result, error)
+}
+
+// ResetSeedConfig contains the options of the reset of a seed.
+// With Halt, the active executions of the seed are halted before the reset. If not, the reset is refused while the seed has active executions.
+// With Yes, the seed is reset without asking the user for confirmation.
+// PollInterval is the time between the checks of the halted executions. If it is zero, they are checked every second.
+type ResetSeedConfig struct {
+	Halt         bool
+	Yes          bool
+	PollInterval time.Duration
+}
+
+// activeExecutions returns the ids of the active executions among the last executions of a seed.
+func activeExecutions(client LastExecutionsGetter) ([]string, error) {
+	executions, err := client.GetLast5Executions()
+	if err != nil {
+		return nil, err
+	}
+
+	active := []string{}
+	for _, execution := range executions.Array() {
+		if !slices.Contains(inactiveExecutionStatuses, execution.Get("status").String()) {
+			active = append(active, execution.Get("id").String())
+		}
+	}
+	return active, nil
+}
+
+// waitForHaltedExecutions checks the last executions of the seed until none of them is active.
+func waitForHaltedExecutions(ctx context.Context, client LastExecutionsGetter, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		active, err := activeExecutions(client)
+		if err != nil || len(active) == 0 {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ResetSeed resets the metadata of a seed and deletes its records.
+// The active executions are checked first with the last executions of the seed. If there are any, the reset is refused, unless they are halted first with the Halt option.
+// The user is asked for confirmation before the seed is changed, unless the Yes option is set.
+func (d discovery) ResetSeed(ctx context.Context, client IngestionSeedResetter, executions func(uuid.UUID) LastExecutionsGetter, name string, config ResetSeedConfig, printer Printer) error {
+	seed, err := d.searchEntity(client, name)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not search for seed %q", name)
+	}
+
+	seedId, err := uuid.Parse(seed.Get("id").String())
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not get the id of seed %q", name)
+	}
+	seedName := seed.Get("name").String()
+
+	executionsClient := executions(seedId)
+	active, err := activeExecutions(executionsClient)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not get the executions of seed %q", seedName)
+	}
+
+	if len(active) > 0 && !config.Halt {
+		return NewError(ErrorExitCode, "Seed %q cannot be reset because it has active executions: %s. Halt them first, or reset the seed with the --halt flag", seedName, strings.Join(active, ", "))
+	}
+
+	if !config.Yes {
+		question := fmt.Sprintf("Reset seed %q (%s)? Its records will be deleted and its metadata will be reset.", seedName, seedId)
+		if len(active) > 0 {
+			question = fmt.Sprintf("Halt %d active %s of seed %q (%s) and reset it? Its records will be deleted and its metadata will be reset.", len(active), plural(len(active), "execution", "executions"), seedName, seedId)
+		}
+
+		confirmed, err := confirm(d.IOStreams(), question)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return NewError(ErrorExitCode, "The reset of seed %q was canceled", seedName)
+		}
+	}
+
+	if len(active) > 0 {
+		_, err = client.Halt(seedId)
+		if err != nil {
+			return NewErrorWithCause(ErrorExitCode, err, "Could not halt the active executions of seed %q", seedName)
+		}
+
+		fmt.Fprintf(d.IOStreams().Err, "Waiting for the executions of seed %q to halt.\n", seedName)
+		interval := config.PollInterval
+		if interval <= 0 {
+			interval = time.Second
+		}
+		err = waitForHaltedExecutions(ctx, executionsClient, interval)
+		if err != nil {
+			return NewErrorWithCause(ErrorExitCode, err, "Could not wait for the executions of seed %q to halt", seedName)
+		}
+	}
+
+	result, err := client.Reset(seedId)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not reset seed %q", seedName)
+	}
+
+	if printer == nil {
+		printer = JsonObjectPrinter(true)
+	}
+
+	return printer(*d.IOStreams(), result)
+}
+
 // ConvertJSONArrayToString transforms a []gjson.Result into a valid JSON array string.
 func ConvertJSONArrayToString(array []gjson.Result) string {
-	arrayString := "[\n"
-	for index, record := range array {
-		arrayString = arrayString + record.Raw
-		if index != (len(array) - 1) {
-			arrayString = arrayString + ","
+	arrayString, _ := convertJSONSeqToString(resultsSeq(array, nil))
+	return arrayString
+}
+
+// convertJSONSeqToString transforms the JSON objects of a sequence into a valid JSON array string as they are received.
+// It returns the error yielded by the sequence, if any.
+func convertJSONSeqToString(seq iter.Seq2[gjson.Result, error]) (string, error) {
+	var builder strings.Builder
+	builder.WriteString("[\n")
+	index := 0
+	for record, err := range seq {
+		if err != nil {
+			return "", err
 		}
-		arrayString = arrayString + "\n"
+
+		if index > 0 {
+			builder.WriteString(",\n")
+		}
+		builder.WriteString(record.Raw)
+		index++
+	}
+
+	if index > 0 {
+		builder.WriteString("\n")
 	}
-	return arrayString + "]"
+	builder.WriteString("]")
+	return builder.String(), nil
 }
 
 // RecordGetter defines the methods to get seed records.
 type RecordGetter interface {
 	Get(id string) (gjson.Result, error)
-	GetAll() ([]gjson.Result, error)
+	GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error)
 	Summarizer
 }
 
@@ -118,14 +270,24 @@ func (d discovery) AppendSeedRecord(seed gjson.Result, client RecordGetter, id s
 	return printer(*d.IOStreams(), seedWithRecord)
 }
 
+// seedRecords returns a sequence with every record of the seed.
+// If the client implements the StreamGetter interface, the records are streamed. If not, they are obtained with the GetAll() method.
+func seedRecords(client RecordGetter) iter.Seq2[gjson.Result, error] {
+	if streamGetter, ok := client.(StreamGetter); ok {
+		return streamGetter.GetAllSeq()
+	}
+
+	return resultsSeq(client.GetAll())
+}
+
 // AppendSeedRecords adds a "records" field to the seed, which contains all of the records obtained from the seed.
+// If the client implements the StreamGetter interface, the records are added to the field as they are received.
 func AppendSeedRecords(seed gjson.Result, client RecordGetter) (gjson.Result, error) {
-	records, err := client.GetAll()
+	recordsString, err := convertJSONSeqToString(seedRecords(client))
 	if err != nil {
 		return gjson.Result{}, err
 	}
 
-	recordsString := ConvertJSONArrayToString(records)
 	seedWithRecord, err := sjson.SetRaw(seed.Raw, "records", recordsString)
 	return gjson.Parse(seedWithRecord), err
 }
@@ -156,14 +318,28 @@ type SeedExecutionGetter interface {
 	GetLast5Executions() (gjson.Result, error)
 }
 
+// StreamAuditor is implemented by the clients that can iterate through the audited changes of a seed execution as they are received.
+type StreamAuditor interface {
+	AuditSeq(executionId uuid.UUID) iter.Seq2[gjson.Result, error]
+}
+
+// auditLogs returns a sequence with the audited changes of the seed execution.
+// If the client implements the StreamAuditor interface, the changes are streamed. If not, they are obtained with the Audit() method.
+func auditLogs(client SeedExecutionGetter, seedExecutionId uuid.UUID) iter.Seq2[gjson.Result, error] {
+	if auditor, ok := client.(StreamAuditor); ok {
+		return auditor.AuditSeq(seedExecutionId)
+	}
+
+	return resultsSeq(client.Audit(seedExecutionId))
+}
+
 // AppendSeedExecutionDetails appends details, like the audited changes and summaries to a seed execution JSON.
 func AppendSeedExecutionDetails(seedExecution gjson.Result, seedExecutionId uuid.UUID, client SeedExecutionGetter, summarizers map[string]Summarizer) (gjson.Result, error) {
-	auditLogs, err := client.Audit(seedExecutionId)
+	auditString, err := convertJSONSeqToString(auditLogs(client, seedExecutionId))
 	if err != nil {
 		return gjson.Result{}, err
 	}
 
-	auditString := ConvertJSONArrayToString(auditLogs)
 	raw, err := sjson.SetRaw(seedExecution.Raw, "audit", auditString)
 	if err != nil {
 		return gjson.Result{}, err
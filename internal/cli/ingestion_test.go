@@ -2,11 +2,14 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
@@ -284,6 +287,182 @@ func Test_discovery_HaltSeedExecution(t *testing.T) {
 	}
 }
 
+// Test_discovery_ResetSeed tests the discovery.ResetSeed() function.
+func Test_discovery_ResetSeed(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		client         IngestionSeedResetter
+		executions     LastExecutionsGetter
+		config         ResetSeedConfig
+		in             string
+		printer        Printer
+		expectedOutput string
+		expectedErr    string
+		expectedHalted bool
+		expectedResets int
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working case
+		{
+			name:           "ResetSeed resets a seed without active executions",
+			ctx:            context.Background(),
+			client:         new(mocks.WorkingSeedResetter),
+			executions:     new(mocks.WorkingSeedExecutionGetter),
+			config:         ResetSeedConfig{Yes: true},
+			printer:        nil,
+			expectedOutput: "{\n  \"acknowledged\": true\n}\n",
+			expectedResets: 1,
+		},
+		{
+			name:           "ResetSeed resets the seed when the user confirms",
+			ctx:            context.Background(),
+			client:         new(mocks.WorkingSeedResetter),
+			executions:     new(mocks.WorkingSeedExecutionGetter),
+			config:         ResetSeedConfig{},
+			in:             "y\n",
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "Reset seed \"MongoDB Atlas server\" (986ce864-af76-4fcb-8b4f-f4e4c6ab0951)? Its records will be deleted and its metadata will be reset. [y/N]: {\"acknowledged\":true}\n",
+			expectedResets: 1,
+		},
+		{
+			name:           "ResetSeed halts the active executions and waits for them before the reset",
+			ctx:            context.Background(),
+			client:         new(mocks.WorkingSeedResetter),
+			executions:     &mocks.ActiveExecutionsGetter{Active: 2},
+			config:         ResetSeedConfig{Halt: true, PollInterval: time.Millisecond},
+			in:             "YES\n",
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "Halt 1 active execution of seed \"MongoDB Atlas server\" (986ce864-af76-4fcb-8b4f-f4e4c6ab0951) and reset it? Its records will be deleted and its metadata will be reset. [y/N]: {\"acknowledged\":true}\n",
+			expectedErr:    "Waiting for the executions of seed \"MongoDB Atlas server\" to halt.\n",
+			expectedHalted: true,
+			expectedResets: 1,
+		},
+
+		// Error case
+		{
+			name:       "The seed does not exist",
+			ctx:        context.Background(),
+			client:     new(mocks.FailingSeedResetterSearchFails),
+			executions: new(mocks.WorkingSeedExecutionGetter),
+			config:     ResetSeedConfig{Yes: true},
+			err: NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{
+				Status: http.StatusNotFound,
+				Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: entity with name "MongoDB Atlas server" does not exist"
+	],
+	"timestamp": "2025-09-30T15:38:42.885125200Z"
+}`),
+			}, "Could not search for seed \"MongoDB Atlas server\""),
+		},
+		{
+			name:       "Getting the executions fails",
+			ctx:        context.Background(),
+			client:     new(mocks.WorkingSeedResetter),
+			executions: new(mocks.FailingSeedExecutionGetterLastExecutionsFails),
+			config:     ResetSeedConfig{Yes: true},
+			err:        NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}, "Could not get the executions of seed \"MongoDB Atlas server\""),
+		},
+		{
+			name:       "The seed has active executions",
+			ctx:        context.Background(),
+			client:     new(mocks.WorkingSeedResetter),
+			executions: &mocks.ActiveExecutionsGetter{Active: 1},
+			config:     ResetSeedConfig{Yes: true},
+			err:        NewError(ErrorExitCode, "Seed \"MongoDB Atlas server\" cannot be reset because it has active executions: 0c309dbb-0402-4710-8659-2c75f5d649b6. Halt them first, or reset the seed with the --halt flag"),
+		},
+		{
+			name:           "The user does not confirm the reset",
+			ctx:            context.Background(),
+			client:         new(mocks.WorkingSeedResetter),
+			executions:     new(mocks.WorkingSeedExecutionGetter),
+			config:         ResetSeedConfig{},
+			in:             "n\n",
+			expectedOutput: "Reset seed \"MongoDB Atlas server\" (986ce864-af76-4fcb-8b4f-f4e4c6ab0951)? Its records will be deleted and its metadata will be reset. [y/N]: ",
+			err:            NewError(ErrorExitCode, "The reset of seed \"MongoDB Atlas server\" was canceled"),
+		},
+		{
+			name:       "Halting the executions fails",
+			ctx:        context.Background(),
+			client:     new(mocks.FailingSeedResetterHaltFails),
+			executions: &mocks.ActiveExecutionsGetter{Active: 1},
+			config:     ResetSeedConfig{Halt: true, Yes: true},
+			err:        NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status":500,"code":5000,"messages":["Internal server error"]}`)}, "Could not halt the active executions of seed \"MongoDB Atlas server\""),
+		},
+		{
+			name:       "The wait for the halted executions is canceled",
+			ctx:        canceled,
+			client:     new(mocks.WorkingSeedResetter),
+			executions: &mocks.ActiveExecutionsGetter{Active: 5},
+			config:     ResetSeedConfig{Halt: true, Yes: true, PollInterval: time.Hour},
+			err:        NewErrorWithCause(ErrorExitCode, context.Canceled, "Could not wait for the executions of seed \"MongoDB Atlas server\" to halt"),
+		},
+		{
+			name:       "Reset fails",
+			ctx:        context.Background(),
+			client:     new(mocks.FailingSeedResetterResetFails),
+			executions: new(mocks.WorkingSeedExecutionGetter),
+			config:     ResetSeedConfig{Yes: true},
+			err:        NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusConflict, Body: gjson.Parse(`{"status":409,"code":4001,"messages":["The seed has 1 executions: 0c309dbb-0402-4710-8659-2c75f5d649b6"],"timestamp":"2025-09-04T20:17:00.116546400Z"}`)}, "Could not reset seed \"MongoDB Atlas server\""),
+		},
+		{
+			name:       "Printing fails",
+			ctx:        context.Background(),
+			client:     new(mocks.WorkingSeedResetter),
+			executions: new(mocks.WorkingSeedExecutionGetter),
+			config:     ResetSeedConfig{Yes: true},
+			outWriter:  testutils.ErrWriter{Err: errors.New("write failed")},
+			err:        NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not print JSON object"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  strings.NewReader(tc.in),
+				Out: out,
+				Err: errBuf,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.ResetSeed(tc.ctx, tc.client, func(uuid.UUID) LastExecutionsGetter { return tc.executions }, "MongoDB Atlas server", tc.config, tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedErr, errBuf.String())
+				resetter := tc.client.(*mocks.WorkingSeedResetter)
+				assert.Equal(t, tc.expectedHalted, resetter.Halted)
+				assert.Equal(t, tc.expectedResets, resetter.Resets)
+			}
+
+			if tc.outWriter == nil {
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
 // TestConvertJSONArrayToString tests the ConvertJSONArrayToString() function.
 func TestConvertJSONArrayToString(t *testing.T) {
 	tests := []struct {
@@ -323,6 +502,19 @@ func TestConvertJSONArrayToString(t *testing.T) {
 	}
 }
 
+// Test_convertJSONSeqToString tests the convertJSONSeqToString() function.
+func Test_convertJSONSeqToString(t *testing.T) {
+	actual, err := convertJSONSeqToString(resultsSeq(gjson.Parse(`[{"id": 1},{"id": 2}]`).Array(), nil))
+	require.NoError(t, err)
+	assert.Equal(t, "[\n{\"id\": 1},\n{\"id\": 2}\n]", actual)
+
+	actual, err = convertJSONSeqToString(func(yield func(gjson.Result, error) bool) {
+		_ = yield(gjson.Parse(`{"id": 1}`), nil) && yield(gjson.Result{}, errors.New("page failed"))
+	})
+	assert.EqualError(t, err, "page failed")
+	assert.Equal(t, "", actual)
+}
+
 // TestAppendSeedRecord tests the AppendSeedRecord() function.
 func TestAppendSeedRecord(t *testing.T) {
 	tests := []struct {
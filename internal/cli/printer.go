@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"iter"
 
 	"github.com/pureinsights/discovery-cli/internal/iostreams"
 	"github.com/tidwall/gjson"
@@ -36,9 +37,15 @@ func printJsonObject(ios iostreams.IOStreams, pretty bool, object gjson.Result,
 }
 
 // printJsonInArray is an auxiliary function to reduce the complexity of printJsonArray.
-func printJsonInArray(object gjson.Result, pretty bool, index, arraySize int, ios iostreams.IOStreams) error {
+// Since the size of the array is not known while streaming, the opening bracket is printed before the first object in the pretty format,
+// and the comma that separates the objects is printed before every object except the first one.
+func printJsonInArray(object gjson.Result, pretty bool, index int, ios iostreams.IOStreams) error {
 	if pretty {
-		_, err := fmt.Fprint(ios.Out, "  ")
+		separator := "[\n  "
+		if index > 0 {
+			separator = ",\n  "
+		}
+		_, err := fmt.Fprint(ios.Out, separator)
 		if err != nil {
 			return err
 		}
@@ -47,44 +54,70 @@ func printJsonInArray(object gjson.Result, pretty bool, index, arraySize int, io
 	if err != nil {
 		return err
 	}
-	if pretty && index != (arraySize-1) {
-		_, err := fmt.Fprint(ios.Out, ",")
+	if !pretty {
+		_, err = fmt.Fprint(ios.Out, "\n")
 		if err != nil {
 			return err
 		}
 	}
-	_, err = fmt.Fprint(ios.Out, "\n")
-	if err != nil {
-		return err
-	}
 
 	return nil
 }
 
-// printJsonArray prints the given JSON array to the Out IOStream.
+// printArrayStream prints the JSON objects of the sequence to the Out IOStream as they are received.
 // If the pretty boolean is true, it prints the objects in the array with spacing and indentation and adds brackets at each end.
 // If not, it prints the objects in a compact format.
-func printArrayObject(ios iostreams.IOStreams, pretty bool, array ...gjson.Result) error {
-	if pretty {
-		_, err := fmt.Fprint(ios.Out, "[\n")
+// If the sequence yields an error, the printing stops and the error is returned in the sequenceErr return value.
+// Nothing is printed if the sequence fails before yielding its first object.
+// The printErr return value contains the error that happened while writing to the Out IOStream.
+func printArrayStream(ios iostreams.IOStreams, pretty bool, seq iter.Seq2[gjson.Result, error]) (sequenceErr error, printErr error) {
+	index := 0
+	for object, err := range seq {
 		if err != nil {
-			return err
+			return err, nil
 		}
-	}
 
-	for index, object := range array {
-		err := printJsonInArray(object, pretty, index, len(array), ios)
-		if err != nil {
-			return err
+		if err := printJsonInArray(object, pretty, index, ios); err != nil {
+			return nil, err
 		}
+		index++
 	}
 
 	if pretty {
-		_, err := fmt.Fprint(ios.Out, "]\n")
-		return err
+		closing := "[\n]\n"
+		if index > 0 {
+			closing = "\n]\n"
+		}
+		_, err := fmt.Fprint(ios.Out, closing)
+		return nil, err
 	}
 
-	return nil
+	return nil, nil
+}
+
+// printArrayObject prints the given JSON array to the Out IOStream.
+// If the pretty boolean is true, it prints the objects in the array with spacing and indentation and adds brackets at each end.
+// If not, it prints the objects in a compact format.
+func printArrayObject(ios iostreams.IOStreams, pretty bool, array ...gjson.Result) error {
+	_, err := printArrayStream(ios, pretty, resultsSeq(array, nil))
+	return err
+}
+
+// resultsSeq returns a sequence that yields the given JSON objects or the given error.
+// It is used to print the results of the functions that return arrays with the stream printers.
+func resultsSeq(results []gjson.Result, err error) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		if err != nil {
+			yield(gjson.Result{}, err)
+			return
+		}
+
+		for _, result := range results {
+			if !yield(result, nil) {
+				return
+			}
+		}
+	}
 }
 
 // JsonObjectPrinter receives the pretty boolean and returns the function that prints the JSON object with that boolean as a parameter.
@@ -117,6 +150,39 @@ func JsonArrayPrinter(pretty bool) Printer {
 	}
 }
 
+// StreamPrinter is the type that prints the gjson.Result objects of a sequence to the Out IOStream as they are received.
+// If the sequence yields an error, the printing stops and the error is returned without modifications so that the caller can describe it.
+type StreamPrinter func(iostreams.IOStreams, iter.Seq2[gjson.Result, error]) error
+
+// JsonArrayStreamPrinter receives the pretty boolean and returns the function that prints the JSON objects of a sequence with that boolean as a parameter.
+func JsonArrayStreamPrinter(pretty bool) StreamPrinter {
+	return func(ios iostreams.IOStreams, seq iter.Seq2[gjson.Result, error]) error {
+		sequenceErr, err := printArrayStream(ios, pretty, seq)
+		if sequenceErr != nil {
+			return sequenceErr
+		}
+		if err != nil {
+			return NewErrorWithCause(ErrorExitCode, err, "Could not print JSON Array")
+		}
+		return nil
+	}
+}
+
+// captureError returns a sequence that yields the elements of the given sequence and stores the error it yields in the err parameter.
+// It is used to tell apart the errors of the sequence from the errors of the printer.
+func captureError(seq iter.Seq2[gjson.Result, error], err *error) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		for object, seqErr := range seq {
+			if seqErr != nil {
+				*err = seqErr
+			}
+			if !yield(object, seqErr) || seqErr != nil {
+				return
+			}
+		}
+	}
+}
+
 // GetObjectPrinter chooses the most appropiate printer depending on the given printer name.
 func GetObjectPrinter(name string) Printer {
 	switch name {
@@ -140,3 +206,15 @@ func GetArrayPrinter(name string) Printer {
 		return nil
 	}
 }
+
+// GetArrayStreamPrinter chooses the most appropiate stream printer depending on the given printer name.
+func GetArrayStreamPrinter(name string) StreamPrinter {
+	switch name {
+	case "json":
+		return JsonArrayStreamPrinter(false)
+	case "pretty-json":
+		return JsonArrayStreamPrinter(true)
+	default:
+		return nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"iter"
 	"os"
 	"testing"
 
@@ -224,7 +225,7 @@ func Test_printArrayObject(t *testing.T) {
 			]`).Array(),
 			expectedPrint: ``,
 			err:           errors.New("write failed"),
-			outWriter:     &testutils.FailOnNWriter{Writer: &bytes.Buffer{}, N: 4},
+			outWriter:     &testutils.FailOnNWriter{Writer: &bytes.Buffer{}, N: 3},
 		},
 		{
 			name:   "Working JSON Array, but fail to print \"  \" to ios.Out",
@@ -235,7 +236,7 @@ func Test_printArrayObject(t *testing.T) {
 			]`).Array(),
 			expectedPrint: ``,
 			err:           errors.New("write failed"),
-			outWriter:     &testutils.FailOnNWriter{Writer: &bytes.Buffer{}, N: 2},
+			outWriter:     &testutils.FailOnNWriter{Writer: &bytes.Buffer{}, N: 1},
 		},
 		{
 			name:   "Working JSON Array, but fail to print \"\n\" to ios.Out",
@@ -257,7 +258,7 @@ func Test_printArrayObject(t *testing.T) {
 			]`).Array(),
 			expectedPrint: ``,
 			err:           errors.New("write failed"),
-			outWriter:     &testutils.FailOnNWriter{Writer: &bytes.Buffer{}, N: 13},
+			outWriter:     &testutils.FailOnNWriter{Writer: &bytes.Buffer{}, N: 7},
 		},
 		{
 			name:   "Failing JSON, unmarshal fails",
@@ -655,3 +656,150 @@ func TestGetArrayPrinter(t *testing.T) {
 		})
 	}
 }
+
+// TestJsonArrayStreamPrinter tests the JsonArrayStreamPrinter() function.
+func TestJsonArrayStreamPrinter(t *testing.T) {
+	tests := []struct {
+		name           string
+		pretty         bool
+		seq            iter.Seq2[gjson.Result, error]
+		expectedOutput string
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working cases
+		{
+			name:           "Ugly printer prints every object of the sequence",
+			pretty:         false,
+			seq:            resultsSeq(gjson.Parse(`[{"id":1},{"id":2}]`).Array(), nil),
+			expectedOutput: "{\"id\":1}\n{\"id\":2}\n",
+			err:            nil,
+		},
+		{
+			name:           "Pretty printer prints every object of the sequence",
+			pretty:         true,
+			seq:            resultsSeq(gjson.Parse(`[{"id":1},{"id":2}]`).Array(), nil),
+			expectedOutput: "[\n  {\n    \"id\": 1\n  },\n  {\n    \"id\": 2\n  }\n]\n",
+			err:            nil,
+		},
+		{
+			name:           "Pretty printer prints an empty array",
+			pretty:         true,
+			seq:            resultsSeq([]gjson.Result{}, nil),
+			expectedOutput: "[\n]\n",
+			err:            nil,
+		},
+
+		// Error cases
+		{
+			name:   "The sequence fails after the first object",
+			pretty: false,
+			seq: func(yield func(gjson.Result, error) bool) {
+				_ = yield(gjson.Parse(`{"id":1}`), nil) && yield(gjson.Result{}, errors.New("page failed"))
+			},
+			expectedOutput: "{\"id\":1}\n",
+			err:            errors.New("page failed"),
+		},
+		{
+			name:           "The pretty printer prints nothing if the sequence fails before the first object",
+			pretty:         true,
+			seq:            resultsSeq(nil, errors.New("page failed")),
+			expectedOutput: "",
+			err:            errors.New("page failed"),
+		},
+		{
+			name:      "Printing fails",
+			pretty:    true,
+			seq:       resultsSeq(gjson.Parse(`[{"id":1}]`).Array(), nil),
+			outWriter: testutils.ErrWriter{Err: errors.New("write failed")},
+			err:       NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not print JSON Array"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer = buf
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			}
+
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: os.Stderr,
+			}
+
+			err := JsonArrayStreamPrinter(tc.pretty)(ios, tc.seq)
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.outWriter == nil {
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// TestGetArrayStreamPrinter tests the GetArrayStreamPrinter() function.
+func TestGetArrayStreamPrinter(t *testing.T) {
+	tests := []struct {
+		name           string
+		printerName    string
+		expectedOutput string
+	}{
+		{
+			name:           "The switch returns the JSON stream printer",
+			printerName:    "json",
+			expectedOutput: "{\"id\":1}\n",
+		},
+		{
+			name:           "The switch returns the pretty JSON stream printer",
+			printerName:    "pretty-json",
+			expectedOutput: "[\n  {\n    \"id\": 1\n  }\n]\n",
+		},
+		{
+			name:        "The switch returns nil",
+			printerName: "yaml",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			printer := GetArrayStreamPrinter(tc.printerName)
+			if tc.expectedOutput == "" {
+				assert.Nil(t, printer)
+				return
+			}
+
+			buf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: buf,
+				Err: os.Stderr,
+			}
+			require.NoError(t, printer(ios, resultsSeq(gjson.Parse(`[{"id":1}]`).Array(), nil)))
+			assert.Equal(t, tc.expectedOutput, buf.String())
+		})
+	}
+}
+
+// Test_captureError tests that the captureError() function stores the error of the sequence.
+func Test_captureError(t *testing.T) {
+	var err error
+	var ids []int64
+	for object, seqErr := range captureError(func(yield func(gjson.Result, error) bool) {
+		_ = yield(gjson.Parse(`{"id":1}`), nil) && yield(gjson.Result{}, errors.New("page failed")) && yield(gjson.Parse(`{"id":2}`), nil)
+	}, &err) {
+		if seqErr == nil {
+			ids = append(ids, object.Get("id").Int())
+		}
+	}
+
+	assert.Equal(t, []int64{1}, ids)
+	assert.EqualError(t, err, "page failed")
+}
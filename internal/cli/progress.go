@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+)
+
+// progressInterval is the minimum time between two reports of the progress of a transfer.
+const progressInterval = 200 * time.Millisecond
+
+// ProgressWriter counts the bytes written to it and reports them on a single line of the given writer, which is rewritten on every report.
+// The methods of a nil ProgressWriter do nothing, so that the transfers do not need to check if their progress is reported.
+type ProgressWriter struct {
+	out     io.Writer
+	label   string
+	written int64
+	last    time.Time
+	now     func() time.Time
+}
+
+// NewProgressWriter returns a ProgressWriter that reports the progress with the given label to the out writer.
+// If out is nil, it returns nil, which does not report the progress.
+func NewProgressWriter(out io.Writer, label string) *ProgressWriter {
+	if out == nil {
+		return nil
+	}
+	return &ProgressWriter{out: out, label: label, now: time.Now}
+}
+
+// Write counts the bytes and reports the progress if enough time passed since the last report.
+func (p *ProgressWriter) Write(b []byte) (int, error) {
+	if p == nil {
+		return len(b), nil
+	}
+
+	p.written += int64(len(b))
+	if now := p.now(); now.Sub(p.last) >= progressInterval {
+		p.last = now
+		fmt.Fprintf(p.out, "\r%s: %s", p.label, formatBytes(p.written))
+	}
+	return len(b), nil
+}
+
+// Finish reports the total number of bytes that were written and ends the line.
+func (p *ProgressWriter) Finish() {
+	if p == nil {
+		return
+	}
+	fmt.Fprintf(p.out, "\r%s: %s\n", p.label, formatBytes(p.written))
+}
+
+// formatBytes formats a number of bytes with the largest binary unit that keeps the value over one, like "1.5 MiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 4; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTP"[exp])
+}
+
+// progressOutput returns the error stream if it is a terminal, so that the progress of the transfers is only reported to interactive sessions.
+// Otherwise, it returns nil.
+func progressOutput(ios *iostreams.IOStreams) io.Writer {
+	if ios == nil || !iostreams.IsTerminal(ios.Err) {
+		return nil
+	}
+	return ios.Err
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProgressWriter tests that the ProgressWriter reports the bytes written at most once per interval and the total when it finishes.
+func TestProgressWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	progress := NewProgressWriter(out, "Exporting entities")
+	progress.now = func() time.Time { return now }
+
+	n, err := progress.Write(make([]byte, 512))
+	require.NoError(t, err)
+	assert.Equal(t, 512, n)
+
+	_, err = progress.Write(make([]byte, 1024))
+	require.NoError(t, err)
+
+	now = now.Add(progressInterval)
+	_, err = progress.Write(make([]byte, 2*1024*1024))
+	require.NoError(t, err)
+
+	progress.Finish()
+	assert.Equal(t, "\rExporting entities: 512 B\rExporting entities: 2.0 MiB\rExporting entities: 2.0 MiB\n", out.String())
+}
+
+// TestProgressWriter_Nil tests that a nil ProgressWriter does not report anything.
+func TestProgressWriter_Nil(t *testing.T) {
+	progress := NewProgressWriter(nil, "Exporting entities")
+	require.Nil(t, progress)
+
+	n, err := progress.Write([]byte("zip"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	progress.Finish()
+}
+
+// Test_formatBytes tests the formatBytes() function.
+func Test_formatBytes(t *testing.T) {
+	tests := []struct {
+		bytes    int64
+		expected string
+	}{
+		{bytes: 0, expected: "0 B"},
+		{bytes: 1023, expected: "1023 B"},
+		{bytes: 1536, expected: "1.5 KiB"},
+		{bytes: 300 * 1024 * 1024, expected: "300.0 MiB"},
+		{bytes: 5 * 1024 * 1024 * 1024, expected: "5.0 GiB"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatBytes(tc.bytes))
+		})
+	}
+}
+
+// Test_progressOutput tests that the progress is not reported when the error stream is not a terminal.
+func Test_progressOutput(t *testing.T) {
+	assert.Nil(t, progressOutput(nil))
+	assert.Nil(t, progressOutput(&iostreams.IOStreams{Err: &bytes.Buffer{}}))
+}
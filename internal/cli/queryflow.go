@@ -0,0 +1,279 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+)
+
+// EndpointInvoker defines the methods to call the API and Debug versions of QueryFlow's endpoints.
+type EndpointInvoker interface {
+	Invoke(method, uri string, options ...discoveryPackage.RequestOption) (gjson.Result, error)
+	Debug(method, uri string, options ...discoveryPackage.RequestOption) (gjson.Result, error)
+}
+
+// InvokeConfig contains the request that is sent to an endpoint.
+// If the method is empty, the endpoint is called with GET. The query parameters follow the format {key}={value} and the same key can be sent several times.
+// If the body is empty, the request is sent without a body. With Debug, the Debug version of the endpoint is called instead of its API version.
+type InvokeConfig struct {
+	Method string
+	Query  []string
+	Body   string
+	Debug  bool
+}
+
+// parseQueryParameters converts the query parameters in the format {key}={value} to the parameters of the request.
+func parseQueryParameters(params []string) (map[string][]string, error) {
+	parsed := map[string][]string{}
+	for _, param := range params {
+		key, value, hasValue := strings.Cut(param, "=")
+		if key == "" || !hasValue {
+			return nil, NewError(ErrorExitCode, "Query parameter %q does not follow the format {key}={value}", param)
+		}
+		parsed[key] = append(parsed[key], value)
+	}
+	return parsed, nil
+}
+
+// invokeEndpoint sends the request to the endpoint with the given URI and returns its response.
+func invokeEndpoint(client EndpointInvoker, uri string, config InvokeConfig) (gjson.Result, error) {
+	method := config.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	query, err := parseQueryParameters(config.Query)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+
+	options := []discoveryPackage.RequestOption{}
+	if len(query) > 0 {
+		options = append(options, discoveryPackage.WithQueryParameters(query))
+	}
+	if config.Body != "" {
+		options = append(options, discoveryPackage.WithJSONBody(config.Body))
+	}
+
+	invoke := client.Invoke
+	if config.Debug {
+		invoke = client.Debug
+	}
+
+	result, err := invoke(method, uri, options...)
+	if err != nil {
+		return gjson.Result{}, NewErrorWithCause(ErrorExitCode, err, "Could not invoke endpoint %q", uri)
+	}
+	return result, nil
+}
+
+// InvokeEndpoint calls the endpoint with the given URI and prints its response.
+// If the endpoint returns an HTTP error, the returned error contains its status and body.
+func (d discovery) InvokeEndpoint(client EndpointInvoker, uri string, config InvokeConfig, printer Printer) error {
+	result, err := invokeEndpoint(client, uri, config)
+	if err != nil {
+		return err
+	}
+
+	// Some endpoints answer without a body, like the ones that only return a status.
+	if result.Raw == "" {
+		return nil
+	}
+
+	if printer == nil {
+		printer = JsonObjectPrinter(true)
+	}
+
+	return printer(*d.IOStreams(), result)
+}
+
+// DebugConfig contains the options to render the trace returned by the Debug version of an endpoint.
+// If Processor is set, only the output of the processor with that name or UUID is shown.
+// The payloads whose compact JSON is longer than Collapse bytes are replaced by a summary. A Collapse of zero shows every payload.
+type DebugConfig struct {
+	Processor string
+	Collapse  int
+}
+
+// processorNames obtains the names of the processors executed in the trace, so that they can be shown instead of their UUIDs.
+// If the name of a processor cannot be obtained, a warning is written and its UUID is shown instead.
+func (d discovery) processorNames(client Getter, trace gjson.Result) map[string]string {
+	names := map[string]string{}
+	for _, state := range trace.Get("execution").Array() {
+		for _, result := range state.Get("result").Array() {
+			id := result.Get("processor").String()
+			if _, found := names[id]; found || id == "" {
+				continue
+			}
+
+			names[id] = id
+			parsed, err := uuid.Parse(id)
+			if err != nil {
+				continue
+			}
+			processor, err := client.Get(parsed)
+			if err != nil || processor.Get("name").String() == "" {
+				fmt.Fprintf(d.IOStreams().Err, "WARNING: Could not get the name of processor %q\n", id)
+				continue
+			}
+			names[id] = processor.Get("name").String()
+		}
+	}
+	return names
+}
+
+// renderPayload writes the payload with the given label. The payload is indented with the prefix and collapsed if it is longer than the limit.
+func renderPayload(w io.Writer, prefix, label string, payload gjson.Result, collapse int) error {
+	compact := &bytes.Buffer{}
+	if err := json.Compact(compact, []byte(payload.Raw)); err != nil {
+		return err
+	}
+	if collapse > 0 && compact.Len() > collapse {
+		var summary string
+		switch {
+		case payload.IsObject():
+			keys := len(payload.Map())
+			summary = fmt.Sprintf("<collapsed object: %d %s, %d bytes>", keys, plural(keys, "key", "keys"), compact.Len())
+		case payload.IsArray():
+			items := len(payload.Array())
+			summary = fmt.Sprintf("<collapsed array: %d %s, %d bytes>", items, plural(items, "item", "items"), compact.Len())
+		default:
+			summary = fmt.Sprintf("<collapsed %s: %d bytes>", strings.ToLower(payload.Type.String()), compact.Len())
+		}
+		_, err := fmt.Fprintf(w, "%s%s: %s\n", prefix, label, summary)
+		return err
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(payload.Raw), &v); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(v, prefix, "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s%s: %s\n", prefix, label, b)
+	return err
+}
+
+// plural returns the singular word if the count is one and the plural word otherwise.
+func plural(count int, singular, plural string) string {
+	if count == 1 {
+		return singular
+	}
+	return plural
+}
+
+// renderDebugTrace writes the states of the trace in the order in which they were executed.
+// Each state shows its processors with their names and durations, and the transition to the next state.
+func renderDebugTrace(w io.Writer, trace gjson.Result, names map[string]string, config DebugConfig) error {
+	states := trace.Get("execution").Array()
+	transitions := make([]string, 0, len(states))
+	for _, state := range states {
+		transitions = append(transitions, state.Get("state").String())
+	}
+
+	if _, err := fmt.Fprintf(w, "Duration: %d ms\nTransitions: %s\n", trace.Get("duration").Int(), strings.Join(transitions, " -> ")); err != nil {
+		return err
+	}
+
+	for i, state := range states {
+		if _, err := fmt.Fprintf(w, "\n%d. %s\n", i+1, state.Get("state").String()); err != nil {
+			return err
+		}
+
+		result := state.Get("result")
+		switch {
+		case result.IsArray():
+			for _, processor := range result.Array() {
+				id := processor.Get("processor").String()
+				label := id
+				if names[id] != id {
+					label = fmt.Sprintf("%s (%s)", names[id], id)
+				}
+				if _, err := fmt.Fprintf(w, "   %s: %d ms\n", label, processor.Get("duration").Int()); err != nil {
+					return err
+				}
+
+				chosen := config.Processor == id || config.Processor == names[id]
+				if config.Processor != "" && !chosen {
+					continue
+				}
+
+				collapse := config.Collapse
+				if chosen {
+					collapse = 0
+				}
+				for _, field := range []string{"output", "error"} {
+					if payload := processor.Get(field); payload.Exists() {
+						if err := renderPayload(w, "     ", field, payload, collapse); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		case result.Get("statusCode").Exists():
+			if _, err := fmt.Fprintf(w, "   status: %d\n", result.Get("statusCode").Int()); err != nil {
+				return err
+			}
+			if body := result.Get("body"); body.Exists() && config.Processor == "" {
+				if err := renderPayload(w, "   ", "body", body, config.Collapse); err != nil {
+					return err
+				}
+			}
+		case result.Exists() && config.Processor == "":
+			if err := renderPayload(w, "   ", "result", result, config.Collapse); err != nil {
+				return err
+			}
+		}
+
+		if i < len(states)-1 {
+			if _, err := fmt.Fprintf(w, "   -> %s\n", states[i+1].Get("state").String()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DebugEndpoint calls the Debug version of the endpoint with the given URI and renders its trace in a readable format.
+// The names of the processors are obtained with the processors client. If the chosen processor was not executed, an error is returned.
+func (d discovery) DebugEndpoint(client EndpointInvoker, processors Getter, uri string, invoke InvokeConfig, config DebugConfig) error {
+	invoke.Debug = true
+	trace, err := invokeEndpoint(client, uri, invoke)
+	if err != nil {
+		return err
+	}
+
+	if !trace.Get("execution").IsArray() {
+		return NewError(ErrorExitCode, "The response of endpoint %q is not a debug trace", uri)
+	}
+
+	names := d.processorNames(processors, trace)
+	if config.Processor != "" {
+		found := false
+		for id, name := range names {
+			if config.Processor == id || config.Processor == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return NewError(ErrorExitCode, "Processor %q was not executed by endpoint %q", config.Processor, uri)
+		}
+	}
+
+	err = renderDebugTrace(d.IOStreams().Out, trace, names, config)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not render the debug trace")
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+	"github.com/pureinsights/discovery-cli/internal/iostreams"
+	"github.com/pureinsights/discovery-cli/internal/testutils"
+	"github.com/pureinsights/discovery-cli/internal/testutils/mocks"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+// Test_discovery_InvokeEndpoint tests the discovery.InvokeEndpoint() function.
+func Test_discovery_InvokeEndpoint(t *testing.T) {
+	tests := []struct {
+		name             string
+		client           EndpointInvoker
+		uri              string
+		config           InvokeConfig
+		printer          Printer
+		expectedOutput   string
+		expectedMethod   string
+		expectedDebugged bool
+		expectedQuery    url.Values
+		expectedBody     any
+		outWriter        io.Writer
+		exitCode         ExitCode
+		err              error
+	}{
+		// Working case
+		{
+			name:           "InvokeEndpoint calls the endpoint with GET by default",
+			client:         &mocks.WorkingEndpointInvoker{Response: gjson.Parse(`{"hits":[{"id":"1"}],"total":1}`)},
+			uri:            "my/endpoint",
+			config:         InvokeConfig{},
+			printer:        nil,
+			expectedOutput: "{\n  \"hits\": [\n    {\n      \"id\": \"1\"\n    }\n  ],\n  \"total\": 1\n}\n",
+			expectedMethod: http.MethodGet,
+			expectedQuery:  url.Values{},
+		},
+		{
+			name:           "InvokeEndpoint sends the query parameters and the body",
+			client:         &mocks.WorkingEndpointInvoker{Response: gjson.Parse(`{"total":0}`)},
+			uri:            "my/endpoint",
+			config:         InvokeConfig{Method: http.MethodPost, Query: []string{"q=shoes", "tag=a", "tag=b", "empty="}, Body: `{"size":5}`},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"total\":0}\n",
+			expectedMethod: http.MethodPost,
+			expectedQuery:  url.Values{"q": {"shoes"}, "tag": {"a", "b"}, "empty": {""}},
+			expectedBody:   `{"size":5}`,
+		},
+		{
+			name:             "InvokeEndpoint calls the Debug version of the endpoint",
+			client:           &mocks.WorkingEndpointInvoker{Response: gjson.Parse(`{"states":{}}`)},
+			uri:              "my/endpoint",
+			config:           InvokeConfig{Method: http.MethodGet, Debug: true},
+			printer:          JsonObjectPrinter(false),
+			expectedOutput:   "{\"states\":{}}\n",
+			expectedMethod:   http.MethodGet,
+			expectedDebugged: true,
+			expectedQuery:    url.Values{},
+		},
+		{
+			name:           "InvokeEndpoint prints nothing when the response has no body",
+			client:         &mocks.WorkingEndpointInvoker{},
+			uri:            "my/endpoint",
+			config:         InvokeConfig{Method: http.MethodDelete},
+			printer:        nil,
+			expectedOutput: "",
+			expectedMethod: http.MethodDelete,
+			expectedQuery:  url.Values{},
+		},
+
+		// Error case
+		{
+			name:     "The endpoint returns an HTTP error",
+			client:   new(mocks.FailingEndpointInvoker),
+			uri:      "my/endpoint",
+			config:   InvokeConfig{},
+			printer:  nil,
+			exitCode: NotFoundExitCode,
+			err:      NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{"status":404,"code":1003,"messages":["Endpoint not found: GET /my/endpoint"],"timestamp":"2025-10-20T18:07:44.071585600Z"}`)}, "Could not invoke endpoint \"my/endpoint\""),
+		},
+		{
+			name:     "Query parameter does not have a value",
+			client:   new(mocks.FailingEndpointInvoker),
+			uri:      "my/endpoint",
+			config:   InvokeConfig{Query: []string{"q"}},
+			printer:  nil,
+			exitCode: ErrorExitCode,
+			err:      NewError(ErrorExitCode, "Query parameter \"q\" does not follow the format {key}={value}"),
+		},
+		{
+			name:     "Query parameter does not have a key",
+			client:   new(mocks.FailingEndpointInvoker),
+			uri:      "my/endpoint",
+			config:   InvokeConfig{Query: []string{"=shoes"}},
+			printer:  nil,
+			exitCode: ErrorExitCode,
+			err:      NewError(ErrorExitCode, "Query parameter \"=shoes\" does not follow the format {key}={value}"),
+		},
+		{
+			name:      "Printing fails",
+			client:    &mocks.WorkingEndpointInvoker{Response: gjson.Parse(`{"total":0}`)},
+			uri:       "my/endpoint",
+			config:    InvokeConfig{},
+			printer:   nil,
+			outWriter: testutils.ErrWriter{Err: errors.New("write failed")},
+			exitCode:  ErrorExitCode,
+			err:       NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not print JSON object"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.InvokeEndpoint(tc.client, tc.uri, tc.config, tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+				assert.Equal(t, tc.exitCode, FromError(err).ExitCode)
+			} else {
+				require.NoError(t, err)
+				client := tc.client.(*mocks.WorkingEndpointInvoker)
+				assert.Equal(t, tc.expectedMethod, client.Method)
+				assert.Equal(t, tc.uri, client.URI)
+				assert.Equal(t, tc.expectedDebugged, client.Debugged)
+				assert.Equal(t, tc.expectedQuery, client.Request.QueryParam)
+				assert.Equal(t, tc.expectedBody, client.Request.Body)
+			}
+
+			if tc.outWriter == nil {
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// Test_discovery_DebugEndpoint tests the discovery.DebugEndpoint() function.
+func Test_discovery_DebugEndpoint(t *testing.T) {
+	trace, err := os.ReadFile(filepath.Join("testdata", "queryflow-debug.json"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		client      EndpointInvoker
+		config      DebugConfig
+		outGolden   string
+		expectedErr string
+		outWriter   io.Writer
+		err         error
+	}{
+		// Working case
+		{
+			name:        "DebugEndpoint renders the trace and collapses the large payloads",
+			client:      &mocks.WorkingEndpointInvoker{Response: gjson.ParseBytes(trace)},
+			config:      DebugConfig{Collapse: 200},
+			outGolden:   "DebugEndpoint_Out_Collapsed",
+			expectedErr: "WARNING: Could not get the name of processor \"8a399b1c-95fc-406c-a220-7d321aaa7b0e\"\n",
+		},
+		{
+			name:        "DebugEndpoint renders every payload",
+			client:      &mocks.WorkingEndpointInvoker{Response: gjson.ParseBytes(trace)},
+			config:      DebugConfig{},
+			outGolden:   "DebugEndpoint_Out_NotCollapsed",
+			expectedErr: "WARNING: Could not get the name of processor \"8a399b1c-95fc-406c-a220-7d321aaa7b0e\"\n",
+		},
+		{
+			name:        "DebugEndpoint only shows the output of the processor with the given name",
+			client:      &mocks.WorkingEndpointInvoker{Response: gjson.ParseBytes(trace)},
+			config:      DebugConfig{Processor: "prompt-processor", Collapse: 10},
+			outGolden:   "DebugEndpoint_Out_ProcessorByName",
+			expectedErr: "WARNING: Could not get the name of processor \"8a399b1c-95fc-406c-a220-7d321aaa7b0e\"\n",
+		},
+		{
+			name:        "DebugEndpoint only shows the output of the processor with the given id",
+			client:      &mocks.WorkingEndpointInvoker{Response: gjson.ParseBytes(trace)},
+			config:      DebugConfig{Processor: "8a399b1c-95fc-406c-a220-7d321aaa7b0e"},
+			outGolden:   "DebugEndpoint_Out_ProcessorByID",
+			expectedErr: "WARNING: Could not get the name of processor \"8a399b1c-95fc-406c-a220-7d321aaa7b0e\"\n",
+		},
+		{
+			name: "DebugEndpoint renders the errors of the processors and the results of other states",
+			client: &mocks.WorkingEndpointInvoker{Response: gjson.Parse(`{"duration":25,"execution":[
+				{"state":"searchState","result":[{"processor":"a5ee116b-bd95-474e-9d50-db7be988b196","error":{"message":"Connection refused"},"duration":20}]},
+				{"state":"switchState","result":{"next":"errorState"}},
+				{"state":"errorState","result":{"statusCode":500}}]}`)},
+			config:    DebugConfig{Collapse: 500},
+			outGolden: "DebugEndpoint_Out_ProcessorError",
+		},
+
+		// Error case
+		{
+			name:   "The endpoint returns an HTTP error",
+			client: new(mocks.FailingEndpointInvoker),
+			err:    NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{"status":404,"code":1003,"messages":["Endpoint not found: GET /my/endpoint"],"timestamp":"2025-10-20T18:07:44.071585600Z"}`)}, "Could not invoke endpoint \"my/endpoint\""),
+		},
+		{
+			name:   "The response is not a debug trace",
+			client: &mocks.WorkingEndpointInvoker{Response: gjson.Parse(`{"hits":[]}`)},
+			err:    NewError(ErrorExitCode, "The response of endpoint \"my/endpoint\" is not a debug trace"),
+		},
+		{
+			name:   "The processor was not executed",
+			client: &mocks.WorkingEndpointInvoker{Response: gjson.ParseBytes(trace)},
+			config: DebugConfig{Processor: "my-processor"},
+			err:    NewError(ErrorExitCode, "Processor \"my-processor\" was not executed by endpoint \"my/endpoint\""),
+		},
+		{
+			name:      "Rendering fails",
+			client:    &mocks.WorkingEndpointInvoker{Response: gjson.ParseBytes(trace)},
+			outWriter: testutils.ErrWriter{Err: errors.New("write failed")},
+			err:       NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not render the debug trace"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			errBuf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: errBuf,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.DebugEndpoint(tc.client, new(mocks.WorkingProcessorGetter), "my/endpoint", InvokeConfig{Method: http.MethodGet}, tc.config)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.True(t, tc.client.(*mocks.WorkingEndpointInvoker).Debugged)
+				assert.Equal(t, tc.expectedErr, errBuf.String())
+				testutils.CompareBytes(t, tc.outGolden, testutils.Read(t, tc.outGolden), buf.Bytes())
+			}
+		})
+	}
+}
@@ -2,13 +2,17 @@ package cli
 
 import (
 	"archive/zip"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/fs"
-	"net/http"
+	"iter"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
+	"github.com/google/uuid"
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/tidwall/gjson"
 )
@@ -27,6 +31,21 @@ type StagingContentController interface {
 	Scroll(filters, projections gjson.Result, size *int) ([]gjson.Result, error)
 }
 
+// StagingContentStreamer is implemented by the content clients that can iterate through the records of a bucket as they are received.
+type StagingContentStreamer interface {
+	ScrollSeq(filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error]
+}
+
+// scrollRecords returns a sequence with the records of the bucket that match the filters.
+// If the client implements the StagingContentStreamer interface, the records are streamed. If not, they are obtained with the Scroll() method.
+func scrollRecords(client StagingContentController, filters, projections gjson.Result, size *int) iter.Seq2[gjson.Result, error] {
+	if streamer, ok := client.(StagingContentStreamer); ok {
+		return streamer.ScrollSeq(filters, projections, size)
+	}
+
+	return resultsSeq(client.Scroll(filters, projections, size))
+}
+
 // updateIndices updates the indices in a bucket with the new configuration.
 // If any update fails, the function returns an error.
 func updateIndices(client StagingBucketController, bucketName string, oldIndices []gjson.Result, newIndices gjson.Result) error {
@@ -73,12 +92,7 @@ func (d discovery) StoreBucket(client StagingBucketController, bucketName string
 	const bucketError string = "Could not create bucket with name %q."
 	result, err := client.Create(bucketName, options)
 	if err != nil {
-		discoveryErr, ok := err.(discoveryPackage.Error)
-		if !ok {
-			return NewErrorWithCause(ErrorExitCode, err, bucketError, bucketName)
-		}
-
-		if discoveryErr.Status != http.StatusConflict {
+		if !discoveryPackage.IsConflict(err) {
 			return NewErrorWithCause(ErrorExitCode, err, bucketError, bucketName)
 		}
 
@@ -117,15 +131,193 @@ func (d discovery) DeleteBucket(client StagingBucketController, bucketName strin
 	return printer(*d.IOStreams(), result)
 }
 
+// StagingBucketPurger defines the methods to find a bucket by its name or UUID and delete its records.
+type StagingBucketPurger interface {
+	Searcher
+	Purge(bucket uuid.UUID) (gjson.Result, error)
+}
+
+// StagingBucketIndexController defines the methods to find a bucket by its name or UUID and manage its indices.
+type StagingBucketIndexController interface {
+	Searcher
+	CreateIndex(id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error)
+	DeleteIndex(id uuid.UUID, index string) (gjson.Result, error)
+}
+
+// indexDirections are the sort directions of the fields of an index.
+var indexDirections = []string{"ASC", "DESC"}
+
+// searchBucket searches for the bucket with the given name or UUID and returns it with its UUID.
+func (d discovery) searchBucket(client Searcher, nameOrID string) (gjson.Result, uuid.UUID, error) {
+	bucket, err := d.searchEntity(client, nameOrID)
+	if err != nil {
+		return gjson.Result{}, uuid.Nil, NewErrorWithCause(ErrorExitCode, err, "Could not find bucket with name or id %q", nameOrID)
+	}
+
+	id, err := uuid.Parse(bucket.Get("id").String())
+	if err != nil {
+		return gjson.Result{}, uuid.Nil, NewErrorWithCause(ErrorExitCode, err, "Could not get the id of bucket %q", nameOrID)
+	}
+	return bucket, id, nil
+}
+
+// PurgeBucket deletes every record of the bucket with the given name or UUID. The bucket and its indices are kept.
+// The user is asked for confirmation before the records are deleted, unless yes is true.
+func (d discovery) PurgeBucket(client StagingBucketPurger, nameOrID string, yes bool, printer Printer) error {
+	bucket, id, err := d.searchBucket(client, nameOrID)
+	if err != nil {
+		return err
+	}
+	bucketName := bucket.Get("name").String()
+
+	if !yes {
+		confirmed, err := confirm(d.IOStreams(), fmt.Sprintf("Purge bucket %q (%s)? All of its records will be deleted.", bucketName, id))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return NewError(ErrorExitCode, "The purge of bucket %q was canceled", bucketName)
+		}
+	}
+
+	result, err := client.Purge(id)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not purge bucket %q", bucketName)
+	}
+
+	if printer == nil {
+		printer = JsonObjectPrinter(true)
+	}
+
+	return printer(*d.IOStreams(), result)
+}
+
+// ListBucketIndices prints the indices of the bucket with the given name or UUID.
+func (d discovery) ListBucketIndices(client Searcher, nameOrID string, printer Printer) error {
+	bucket, _, err := d.searchBucket(client, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if printer == nil {
+		printer = JsonArrayPrinter(false)
+	}
+
+	return printer(*d.IOStreams(), bucket.Get("indices").Array()...)
+}
+
+// parseIndexFields converts the fields of an index to the configuration that is sent to Discovery Staging.
+// The fields are either a JSON array like [{"field":"ASC"}], or {field}:{direction} pairs separated by commas, like field:ASC,field2:DESC.
+func parseIndexFields(fields string) ([]gjson.Result, error) {
+	fields = strings.TrimSpace(fields)
+	if fields == "" {
+		return nil, NewError(ErrorExitCode, "The index must have at least one field")
+	}
+
+	if strings.HasPrefix(fields, "[") {
+		if !gjson.Valid(fields) {
+			return nil, NewError(ErrorExitCode, "The fields of the index are not a valid JSON")
+		}
+		parsed := gjson.Parse(fields).Array()
+		if len(parsed) == 0 {
+			return nil, NewError(ErrorExitCode, "The index must have at least one field")
+		}
+		for _, field := range parsed {
+			if !field.IsObject() {
+				return nil, NewError(ErrorExitCode, "The fields of the index must be a JSON array of objects like [{\"field\":\"ASC\"}]")
+			}
+		}
+		return parsed, nil
+	}
+
+	parsed := []gjson.Result{}
+	for _, field := range strings.Split(fields, ",") {
+		separator := strings.LastIndex(field, ":")
+		if separator < 0 {
+			return nil, NewError(ErrorExitCode, "Index field %q does not follow the format {field}:{ASC|DESC}", field)
+		}
+
+		name := strings.TrimSpace(field[:separator])
+		direction := strings.ToUpper(strings.TrimSpace(field[separator+1:]))
+		if name == "" || !slices.Contains(indexDirections, direction) {
+			return nil, NewError(ErrorExitCode, "Index field %q does not follow the format {field}:{ASC|DESC}", field)
+		}
+
+		config, err := json.Marshal(map[string]string{name: direction})
+		if err != nil {
+			return nil, NewErrorWithCause(ErrorExitCode, err, "Could not convert index field %q to JSON", field)
+		}
+		parsed = append(parsed, gjson.ParseBytes(config))
+	}
+	return parsed, nil
+}
+
+// AddBucketIndex creates the index with the given name and fields in the bucket with the given name or UUID.
+// If the bucket already has an index with that name, it is replaced.
+func (d discovery) AddBucketIndex(client StagingBucketIndexController, nameOrID, index, fields string, printer Printer) error {
+	config, err := parseIndexFields(fields)
+	if err != nil {
+		return err
+	}
+
+	bucket, id, err := d.searchBucket(client, nameOrID)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.CreateIndex(id, index, config)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not create index %q of bucket %q", index, bucket.Get("name").String())
+	}
+
+	if printer == nil {
+		printer = JsonObjectPrinter(true)
+	}
+
+	return printer(*d.IOStreams(), result)
+}
+
+// RemoveBucketIndex deletes the index with the given name from the bucket with the given name or UUID.
+// If the bucket does not have that index, an error is returned.
+func (d discovery) RemoveBucketIndex(client StagingBucketIndexController, nameOrID, index string, printer Printer) error {
+	bucket, id, err := d.searchBucket(client, nameOrID)
+	if err != nil {
+		return err
+	}
+	bucketName := bucket.Get("name").String()
+
+	if !bucket.Get(fmt.Sprintf("indices.#(name==%q)", index)).Exists() {
+		return NewError(ErrorExitCode, "Bucket %q does not have an index with name %q", bucketName, index)
+	}
+
+	result, err := client.DeleteIndex(id, index)
+	if err != nil {
+		return NewErrorWithCause(ErrorExitCode, err, "Could not delete index %q of bucket %q", index, bucketName)
+	}
+
+	if printer == nil {
+		printer = JsonObjectPrinter(true)
+	}
+
+	return printer(*d.IOStreams(), result)
+}
+
 // writeRecordsToFile writes the records obtained from the scroll to a JSON file in a temporary directory.
-func writeRecordsToFile(records []gjson.Result, bucket string) (string, error) {
+// Each record is written as soon as it is received, so the records do not need to be kept in memory.
+// If the sequence yields an error, the temporary directory is removed and the error is returned.
+func writeRecordsToFile(records iter.Seq2[gjson.Result, error], bucket string) (string, error) {
 	dir, err := os.MkdirTemp("", fmt.Sprintf("dump-%s-*", bucket))
 	if err != nil {
 		defer os.RemoveAll(dir)
 		return "", NormalizeWriteFileError(os.TempDir(), err)
 	}
 
-	for _, record := range records {
+	for record, err := range records {
+		if err != nil {
+			defer os.RemoveAll(dir)
+			return "", err
+		}
+
 		transaction := record.Get("transaction").String()
 
 		err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("%s.json", transaction)), []byte(record.Raw), 0o644)
@@ -219,13 +411,14 @@ type DumpConfig struct {
 }
 
 // DumpBucket scrolls the contents of a bucket based on the given filters, projections and maximum page size.
+// The records are written to temporary files as they are received and then zipped into the given file.
 func (d discovery) DumpBucket(client StagingContentController, bucketName, file string, filters, projections gjson.Result, size *int, printer Printer) error {
-	records, err := client.Scroll(filters, projections, size)
-	if err != nil {
-		return NewErrorWithCause(ErrorExitCode, err, "Could not scroll the bucket with name %q.", bucketName)
+	var scrollErr error
+	dir, err := writeRecordsToFile(captureError(scrollRecords(client, filters, projections, size), &scrollErr), bucketName)
+	if scrollErr != nil {
+		return NewErrorWithCause(ErrorExitCode, scrollErr, "Could not scroll the bucket with name %q.", bucketName)
 	}
 
-	dir, err := writeRecordsToFile(records, bucketName)
 	if err != nil {
 		return NewErrorWithCause(ErrorExitCode, err, "Could not write records to temporary folder.")
 	}
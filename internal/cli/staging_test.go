@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 	"github.com/pureinsights/discovery-cli/internal/iostreams"
 	"github.com/pureinsights/discovery-cli/internal/testutils"
@@ -516,6 +517,392 @@ func Test_discovery_DeleteBucket(t *testing.T) {
 	}
 }
 
+// Test_discovery_PurgeBucket tests the discovery.PurgeBucket() function.
+func Test_discovery_PurgeBucket(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         StagingBucketPurger
+		yes            bool
+		in             string
+		printer        Printer
+		expectedOutput string
+		expectedPurged uuid.UUID
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working case
+		{
+			name:           "PurgeBucket purges the bucket without confirmation",
+			client:         new(mocks.WorkingBucketPurger),
+			yes:            true,
+			printer:        nil,
+			expectedOutput: "{\n  \"acknowledged\": true\n}\n",
+			expectedPurged: uuid.MustParse("69eeb20b-8ded-478f-937f-64caa0a3e8c0"),
+		},
+		{
+			name:           "PurgeBucket purges the bucket when the user confirms",
+			client:         new(mocks.WorkingBucketPurger),
+			in:             "y\n",
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "Purge bucket \"my-bucket\" (69eeb20b-8ded-478f-937f-64caa0a3e8c0)? All of its records will be deleted. [y/N]: {\"acknowledged\":true}\n",
+			expectedPurged: uuid.MustParse("69eeb20b-8ded-478f-937f-64caa0a3e8c0"),
+		},
+		{
+			name:           "PurgeBucket does not purge the bucket when the user does not confirm",
+			client:         new(mocks.WorkingBucketPurger),
+			in:             "\n",
+			printer:        nil,
+			expectedOutput: "Purge bucket \"my-bucket\" (69eeb20b-8ded-478f-937f-64caa0a3e8c0)? All of its records will be deleted. [y/N]: ",
+			expectedPurged: uuid.Nil,
+			err:            NewError(ErrorExitCode, "The purge of bucket \"my-bucket\" was canceled"),
+		},
+
+		// Error case
+		{
+			name:    "Purge returns an HTTP error",
+			client:  new(mocks.FailingBucketPurger),
+			yes:     true,
+			printer: nil,
+			err:     NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status":500,"code":5000,"messages":["Internal server error"]}`)}, "Could not purge bucket \"my-bucket\""),
+		},
+		{
+			name:           "Printing fails",
+			client:         new(mocks.WorkingBucketPurger),
+			yes:            true,
+			printer:        nil,
+			outWriter:      testutils.ErrWriter{Err: errors.New("write failed")},
+			expectedPurged: uuid.MustParse("69eeb20b-8ded-478f-937f-64caa0a3e8c0"),
+			err:            NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not print JSON object"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			ios := iostreams.IOStreams{
+				In:  strings.NewReader(tc.in),
+				Out: out,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.PurgeBucket(tc.client, "my-bucket", tc.yes, tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if purger, ok := tc.client.(*mocks.WorkingBucketPurger); ok {
+				assert.Equal(t, tc.expectedPurged, purger.Purged)
+			}
+			if tc.outWriter == nil {
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// Test_discovery_ListBucketIndices tests the discovery.ListBucketIndices() function.
+func Test_discovery_ListBucketIndices(t *testing.T) {
+	_, searchErr := new(mocks.FailingSearcher).SearchByName("my-bucket")
+
+	tests := []struct {
+		name           string
+		client         Searcher
+		printer        Printer
+		expectedOutput string
+		err            error
+	}{
+		// Working case
+		{
+			name:           "ListBucketIndices prints the indices of the bucket",
+			client:         new(mocks.WorkingBucketSearcher),
+			printer:        nil,
+			expectedOutput: "{\"fields\":[{\"fieldName\":\"ASC\"}],\"name\":\"myIndexA\",\"unique\":false}\n{\"fields\":[{\"fieldName2\":\"DESC\"},{\"fieldName3\":\"ASC\"}],\"name\":\"myIndexB\",\"unique\":false}\n",
+		},
+		{
+			name:           "ListBucketIndices prints an empty array when the bucket has no indices",
+			client:         new(mocks.WorkingSearcher),
+			printer:        JsonArrayPrinter(true),
+			expectedOutput: "[\n]\n",
+		},
+
+		// Error case
+		{
+			name:    "The bucket cannot be found",
+			client:  new(mocks.FailingSearcher),
+			printer: nil,
+			err:     NewErrorWithCause(ErrorExitCode, searchErr, "Could not find bucket with name or id \"my-bucket\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: buf,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.ListBucketIndices(tc.client, "my-bucket", tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// Test_parseIndexFields tests the parseIndexFields() function.
+func Test_parseIndexFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   string
+		expected []gjson.Result
+		err      error
+	}{
+		// Working case
+		{
+			name:     "parseIndexFields parses a JSON array",
+			fields:   `[{"title":"ASC"},{"date":"DESC"}]`,
+			expected: gjson.Parse(`[{"title":"ASC"},{"date":"DESC"}]`).Array(),
+		},
+		{
+			name:     "parseIndexFields parses the shorthand",
+			fields:   "title:asc, author.name:DESC",
+			expected: []gjson.Result{gjson.Parse(`{"title":"ASC"}`), gjson.Parse(`{"author.name":"DESC"}`)},
+		},
+
+		// Error case
+		{
+			name:   "The fields are empty",
+			fields: " ",
+			err:    NewError(ErrorExitCode, "The index must have at least one field"),
+		},
+		{
+			name:   "The JSON array is empty",
+			fields: "[]",
+			err:    NewError(ErrorExitCode, "The index must have at least one field"),
+		},
+		{
+			name:   "The JSON is not valid",
+			fields: `[{"title":"ASC"}`,
+			err:    NewError(ErrorExitCode, "The fields of the index are not a valid JSON"),
+		},
+		{
+			name:   "The JSON array does not contain objects",
+			fields: `["title"]`,
+			err:    NewError(ErrorExitCode, "The fields of the index must be a JSON array of objects like [{\"field\":\"ASC\"}]"),
+		},
+		{
+			name:   "A field does not have a direction",
+			fields: "title:ASC,date",
+			err:    NewError(ErrorExitCode, "Index field \"date\" does not follow the format {field}:{ASC|DESC}"),
+		},
+		{
+			name:   "A field has an invalid direction",
+			fields: "title:UP",
+			err:    NewError(ErrorExitCode, "Index field \"title:UP\" does not follow the format {field}:{ASC|DESC}"),
+		},
+		{
+			name:   "A field does not have a name",
+			fields: ":ASC",
+			err:    NewError(ErrorExitCode, "Index field \":ASC\" does not follow the format {field}:{ASC|DESC}"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fields, err := parseIndexFields(tc.fields)
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Len(t, fields, len(tc.expected))
+				for i := range tc.expected {
+					assert.JSONEq(t, tc.expected[i].Raw, fields[i].Raw)
+				}
+			}
+		})
+	}
+}
+
+// Test_discovery_AddBucketIndex tests the discovery.AddBucketIndex() function.
+func Test_discovery_AddBucketIndex(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         StagingBucketIndexController
+		fields         string
+		printer        Printer
+		expectedOutput string
+		expectedFields string
+		outWriter      io.Writer
+		err            error
+	}{
+		// Working case
+		{
+			name:           "AddBucketIndex creates the index with the shorthand fields",
+			client:         new(mocks.WorkingBucketIndexController),
+			fields:         "title:ASC,date:DESC",
+			printer:        nil,
+			expectedOutput: "{\n  \"acknowledged\": true\n}\n",
+			expectedFields: `[{"title":"ASC"},{"date":"DESC"}]`,
+		},
+		{
+			name:           "AddBucketIndex creates the index with the JSON fields",
+			client:         new(mocks.WorkingBucketIndexController),
+			fields:         `[{"title":"ASC"}]`,
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"acknowledged\":true}\n",
+			expectedFields: `[{"title":"ASC"}]`,
+		},
+
+		// Error case
+		{
+			name:    "The fields are not valid",
+			client:  new(mocks.WorkingBucketIndexController),
+			fields:  "title",
+			printer: nil,
+			err:     NewError(ErrorExitCode, "Index field \"title\" does not follow the format {field}:{ASC|DESC}"),
+		},
+		{
+			name:    "CreateIndex returns an HTTP error",
+			client:  new(mocks.FailingBucketIndexController),
+			fields:  "title:ASC",
+			printer: nil,
+			err:     NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{"status":400,"code":3002,"messages":["Invalid index configuration"]}`)}, "Could not create index \"myIndexC\" of bucket \"my-bucket\""),
+		},
+		{
+			name:      "Printing fails",
+			client:    new(mocks.WorkingBucketIndexController),
+			fields:    "title:ASC",
+			printer:   nil,
+			outWriter: testutils.ErrWriter{Err: errors.New("write failed")},
+			err:       NewErrorWithCause(ErrorExitCode, errors.New("write failed"), "Could not print JSON object"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			var out io.Writer
+			if tc.outWriter != nil {
+				out = tc.outWriter
+			} else {
+				out = buf
+			}
+
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: out,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.AddBucketIndex(tc.client, "my-bucket", "myIndexC", tc.fields, tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				client := tc.client.(*mocks.WorkingBucketIndexController)
+				assert.Equal(t, uuid.MustParse("69eeb20b-8ded-478f-937f-64caa0a3e8c0"), client.Bucket)
+				assert.Equal(t, "myIndexC", client.Index)
+				assert.JSONEq(t, tc.expectedFields, ConvertJSONArrayToString(client.Fields))
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+// Test_discovery_RemoveBucketIndex tests the discovery.RemoveBucketIndex() function.
+func Test_discovery_RemoveBucketIndex(t *testing.T) {
+	tests := []struct {
+		name           string
+		client         StagingBucketIndexController
+		index          string
+		printer        Printer
+		expectedOutput string
+		err            error
+	}{
+		// Working case
+		{
+			name:           "RemoveBucketIndex deletes the index",
+			client:         new(mocks.WorkingBucketIndexController),
+			index:          "myIndexB",
+			printer:        nil,
+			expectedOutput: "{\n  \"acknowledged\": true\n}\n",
+		},
+
+		// Error case
+		{
+			name:    "The bucket does not have the index",
+			client:  new(mocks.WorkingBucketIndexController),
+			index:   "myIndexC",
+			printer: nil,
+			err:     NewError(ErrorExitCode, "Bucket \"my-bucket\" does not have an index with name \"myIndexC\""),
+		},
+		{
+			name:    "DeleteIndex returns an HTTP error",
+			client:  new(mocks.FailingBucketIndexController),
+			index:   "myIndexA",
+			printer: nil,
+			err:     NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status":500,"code":5000,"messages":["Internal server error"]}`)}, "Could not delete index \"myIndexA\" of bucket \"my-bucket\""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			ios := iostreams.IOStreams{
+				In:  os.Stdin,
+				Out: buf,
+				Err: os.Stderr,
+			}
+
+			d := NewDiscovery(&ios, viper.New(), "")
+			err := d.RemoveBucketIndex(tc.client, "my-bucket", tc.index, tc.printer)
+
+			if tc.err != nil {
+				require.Error(t, err)
+				var errStruct Error
+				require.ErrorAs(t, err, &errStruct)
+				assert.EqualError(t, err, tc.err.Error())
+			} else {
+				require.NoError(t, err)
+				client := tc.client.(*mocks.WorkingBucketIndexController)
+				assert.Equal(t, uuid.MustParse("69eeb20b-8ded-478f-937f-64caa0a3e8c0"), client.Bucket)
+				assert.Equal(t, tc.index, client.Index)
+				assert.Equal(t, tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
 // Test_writeRecordsToFile_AllFilesWritten tests the writeRecordsToFile() function to verify that all the files are written correctly.
 func Test_writeRecordsToFile_AllFilesWritten(t *testing.T) {
 	records := gjson.Parse(`[
@@ -549,7 +936,7 @@ func Test_writeRecordsToFile_AllFilesWritten(t *testing.T) {
     }
 ]`).Array()
 
-	dir, err := writeRecordsToFile(records, "my-bucket")
+	dir, err := writeRecordsToFile(resultsSeq(records, nil), "my-bucket")
 	require.NoError(t, err)
 	defer os.RemoveAll(dir)
 	assert.Contains(t, dir, "dump-my-bucket-")
@@ -585,7 +972,7 @@ func Test_writeRecordsToFile_WriteFails(t *testing.T) {
     }
 ]`).Array()
 
-	dir, err := writeRecordsToFile(records, "my-bucket")
+	dir, err := writeRecordsToFile(resultsSeq(records, nil), "my-bucket")
 	assert.EqualError(t, err, errors.New("the given path does not exist: "+filepath.Join(dir, "doesnotexist", "694eb7be78aedc7a163da900.json")).Error())
 }
 
@@ -626,7 +1013,7 @@ func Test_writeRecordsToFile_MkDirTempFails(t *testing.T) {
     }
 ]`).Array()
 
-	dir, err := writeRecordsToFile(records, "my-bucket")
+	dir, err := writeRecordsToFile(resultsSeq(records, nil), "my-bucket")
 	assert.Equal(t, "", dir)
 	assert.Contains(t, err.Error(), "the given path does not exist:")
 	assert.Contains(t, err.Error(), strings.ToLower(filepath.FromSlash("/does/not/exist")))
@@ -670,7 +1057,7 @@ func Test_zipRecords_ZipIsCreated(t *testing.T) {
     }
 ]`).Array()
 
-	dir, err := writeRecordsToFile(records, "my-bucket")
+	dir, err := writeRecordsToFile(resultsSeq(records, nil), "my-bucket")
 	require.NoError(t, err)
 	defer os.RemoveAll(dir)
 
@@ -740,7 +1127,7 @@ func Test_zipRecords_EmptyZip(t *testing.T) {
 
 	records := []gjson.Result{}
 
-	dir, err := writeRecordsToFile(records, "my-bucket")
+	dir, err := writeRecordsToFile(resultsSeq(records, nil), "my-bucket")
 	require.NoError(t, err)
 	defer os.RemoveAll(dir)
 
@@ -810,7 +1197,7 @@ func Test_zipRecords_DirContainsDirectory(t *testing.T) {
     }
 ]`).Array()
 
-	dir, err := writeRecordsToFile(records, "my-bucket")
+	dir, err := writeRecordsToFile(resultsSeq(records, nil), "my-bucket")
 	require.NoError(t, err)
 	defer os.RemoveAll(dir)
 	os.Mkdir(filepath.Join(dir, "directory"), 0o755)
@@ -869,6 +1256,14 @@ func Test_discovery_DumpBucket(t *testing.T) {
 			file:           filepath.Join(t.TempDir(), "my-bucket.zip"),
 			err:            nil,
 		},
+		{
+			name:           "DumpBucket writes the streamed records",
+			client:         &mocks.StreamingStagingContentController{},
+			printer:        JsonObjectPrinter(false),
+			expectedOutput: "{\"acknowledged\":true}\n",
+			file:           filepath.Join(t.TempDir(), "my-bucket.zip"),
+			err:            nil,
+		},
 		{
 			name:           "DumpBucket correctly prints the array with JSON pretty printer",
 			client:         new(mocks.WorkingStagingContentController),
@@ -904,6 +1299,14 @@ func Test_discovery_DumpBucket(t *testing.T) {
 }`),
 			}, "Could not scroll the bucket with name \"my-bucket\"."),
 		},
+		{
+			name:           "The streamed scroll fails after the first record",
+			client:         &mocks.StreamingStagingContentController{Err: discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"error":"Internal Server Error"}`)}},
+			printer:        nil,
+			expectedOutput: "",
+			file:           filepath.Join(t.TempDir(), "my-bucket.zip"),
+			err:            NewErrorWithCause(ErrorExitCode, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"error":"Internal Server Error"}`)}, "Could not scroll the bucket with name \"my-bucket\"."),
+		},
 		{
 			name:           "zipRecords fails",
 			client:         new(mocks.WorkingStagingContentController),
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 )
 
@@ -46,3 +47,15 @@ func (ios *IOStreams) AskUser(question string) (string, error) {
 
 	return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
 }
+
+// IsTerminal checks if the given writer is a terminal, like the standard error of an interactive session.
+// Writers that are not files, like buffers, and redirected files are not terminals.
+func IsTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -111,3 +113,16 @@ func TestAskUser(t *testing.T) {
 		})
 	}
 }
+
+// TestIsTerminal tests that the IsTerminal() function does not consider buffers and regular files as terminals.
+func TestIsTerminal(t *testing.T) {
+	require.False(t, IsTerminal(&bytes.Buffer{}))
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "out.txt"))
+	require.NoError(t, err)
+	defer file.Close()
+	require.False(t, IsTerminal(file))
+
+	require.NoError(t, file.Close())
+	require.False(t, IsTerminal(file))
+}
@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"net/http"
+	"sync/atomic"
 	"testing"
 )
 
@@ -91,3 +92,27 @@ func HttpMultiResponseHandler(
 		}
 	}
 }
+
+// HttpFailingHandler returns a handler that responds with the failure response to the first failures calls
+// and with the success response to the rest of them. The number of received calls is stored in the calls parameter.
+func HttpFailingHandler(
+	t *testing.T,
+	failures int,
+	failure MockResponse,
+	success MockResponse,
+	calls *atomic.Int32,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response := success
+		if int(calls.Add(1)) <= failures {
+			response = failure
+		}
+
+		if response.Assertions != nil {
+			response.Assertions(t, r)
+		}
+		w.Header().Set(ContentType, response.ContentType)
+		w.WriteHeader(response.StatusCode)
+		w.Write([]byte(response.Body))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -124,3 +125,33 @@ func TestHttpNoContentHandler(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, response.StatusCode)
 	assert.Equal(t, 0, len(actualBody), "NoContent should not have a body")
 }
+
+// TestHttpFailingHandler tests that the handler fails the first calls and then succeeds.
+func TestHttpFailingHandler(t *testing.T) {
+	calls := &atomic.Int32{}
+	handler := HttpFailingHandler(t, 2,
+		MockResponse{StatusCode: http.StatusServiceUnavailable, ContentType: "application/json", Body: `{"error":"unavailable"}`},
+		MockResponse{StatusCode: http.StatusOK, ContentType: "application/json", Body: `{"ok":true}`},
+		calls,
+	)
+
+	expected := []struct {
+		status int
+		body   string
+	}{
+		{status: http.StatusServiceUnavailable, body: `{"error":"unavailable"}`},
+		{status: http.StatusServiceUnavailable, body: `{"error":"unavailable"}`},
+		{status: http.StatusOK, body: `{"ok":true}`},
+	}
+
+	for _, e := range expected {
+		responseRecorder := httptest.NewRecorder()
+		handler.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		response := responseRecorder.Result()
+		actualBody, _ := io.ReadAll(response.Body)
+		assert.Equal(t, e.status, response.StatusCode)
+		assert.Equal(t, e.body, string(actualBody))
+	}
+	assert.Equal(t, int32(3), calls.Load())
+}
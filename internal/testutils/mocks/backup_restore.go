@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/tidwall/gjson"
@@ -11,9 +12,10 @@ import (
 // WorkingBackupRestore mocks a working backup restore.
 type WorkingBackupRestore struct{}
 
-// Export returns zip bytes as if the request worked successfully.
-func (g *WorkingBackupRestore) Export() ([]byte, string, error) {
-	return []byte("Exportfiles"), "export-20251110T1455.zip", nil
+// Export writes zip bytes as if the request worked successfully.
+func (g *WorkingBackupRestore) Export(w io.Writer) (string, error) {
+	_, err := w.Write([]byte("Exportfiles"))
+	return "export-20251110T1455.zip", err
 }
 
 // Import implements the interface.
@@ -25,8 +27,8 @@ func (g *WorkingBackupRestore) Import(discoveryPackage.OnConflict, string) (gjso
 type FailingBackupRestore struct{}
 
 // Export returns an error as if the request failed.
-func (g *FailingBackupRestore) Export() ([]byte, string, error) {
-	return []byte(nil), "discovery.zip", discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
+func (g *FailingBackupRestore) Export(io.Writer) (string, error) {
+	return "discovery.zip", discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
 // Import implements the interface.
@@ -155,7 +155,7 @@ func (s *WorkingServerPinger) SearchByName(string) (gjson.Result, error) {
 }
 
 // Search implements the searcher interface.
-func (s *WorkingServerPinger) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *WorkingServerPinger) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{
 		Status: http.StatusNotFound,
 		Body:   gjson.Result{},
@@ -178,7 +178,7 @@ func (s *WorkingServerPinger) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searcher interface.
-func (s *WorkingServerPinger) GetAll() ([]gjson.Result, error) {
+func (s *WorkingServerPinger) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
@@ -213,7 +213,7 @@ func (s *FailingServerPingerServerNotFound) SearchByName(string) (gjson.Result,
 }
 
 // Search implements the searcher interface.
-func (s *FailingServerPingerServerNotFound) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *FailingServerPingerServerNotFound) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{
 		Status: http.StatusNotFound,
 		Body:   gjson.Result{},
@@ -236,7 +236,7 @@ func (s *FailingServerPingerServerNotFound) Get(uuid.UUID) (gjson.Result, error)
 }
 
 // GetAll implements the searcher interface.
-func (s *FailingServerPingerServerNotFound) GetAll() ([]gjson.Result, error) {
+func (s *FailingServerPingerServerNotFound) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
@@ -279,7 +279,7 @@ func (s *FailingServerPingerPingFailed) SearchByName(string) (gjson.Result, erro
 }
 
 // Search implements the searcher interface.
-func (s *FailingServerPingerPingFailed) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *FailingServerPingerPingFailed) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{
 		Status: http.StatusNotFound,
 		Body:   gjson.Result{},
@@ -302,6 +302,39 @@ func (s *FailingServerPingerPingFailed) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searcher interface.
-func (s *FailingServerPingerPingFailed) GetAll() ([]gjson.Result, error) {
+func (s *FailingServerPingerPingFailed) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
+
+// WorkingLogLeveler simulates the maintenance client when the log level is changed.
+// It records the log level of every request, so that the tests can check the changes and restorations.
+type WorkingLogLeveler struct {
+	Levels []discoveryPackage.LogLevel
+}
+
+// Log records the level and returns an acknowledgement.
+func (l *WorkingLogLeveler) Log(_ string, level discoveryPackage.LogLevel, _ string) (gjson.Result, error) {
+	l.Levels = append(l.Levels, level)
+	return gjson.Parse(`{"acknowledged": true}`), nil
+}
+
+// FailingLogLeveler simulates the maintenance client when the request fails.
+type FailingLogLeveler struct{}
+
+// Log returns a bad request error.
+func (l *FailingLogLeveler) Log(string, discoveryPackage.LogLevel, string) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{"status":400,"code":3002,"messages":["Required QueryValue [componentName] not specified"],"timestamp":"2025-08-28T00:03:31.103683200Z"}`)}
+}
+
+// FailingLogLevelerRestoreFails simulates the maintenance client when the level is changed, but it cannot be restored.
+type FailingLogLevelerRestoreFails struct {
+	WorkingLogLeveler
+}
+
+// Log works the first time and returns an internal server error afterwards.
+func (l *FailingLogLevelerRestoreFails) Log(component string, level discoveryPackage.LogLevel, logger string) (gjson.Result, error) {
+	if len(l.Levels) == 0 {
+		return l.WorkingLogLeveler.Log(component, level, logger)
+	}
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status": 500, "code": 5000, "messages": ["Internal server error"]}`)}
+}
@@ -3,10 +3,12 @@ package mocks
 import (
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
 )
@@ -28,7 +30,7 @@ func (g *WorkingGetter) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll returns a list of processors.
-func (g *WorkingGetter) GetAll() ([]gjson.Result, error) {
+func (g *WorkingGetter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[
 		{
 		"type": "mongo",
@@ -79,7 +81,7 @@ func (g *FailingGetter) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll returns 401 unauthorized.
-func (g *FailingGetter) GetAll() ([]gjson.Result, error) {
+func (g *FailingGetter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
@@ -88,7 +90,7 @@ func (g *FailingGetter) GetAll() ([]gjson.Result, error) {
 type WorkingSearcher struct{}
 
 // Search returns an array of results as it if correctly found matches.
-func (s *WorkingSearcher) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *WorkingSearcher) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[
                   {
 					"source": {
@@ -179,7 +181,7 @@ func (s *WorkingSearcher) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll returns the result of a search.
-func (s *WorkingSearcher) GetAll() ([]gjson.Result, error) {
+func (s *WorkingSearcher) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[
 		{
 		"type": "mongo",
@@ -211,7 +213,7 @@ func (s *WorkingSearcher) GetAll() ([]gjson.Result, error) {
 type FailingSearcher struct{}
 
 // Search implements the searcher interface.
-func (s *FailingSearcher) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *FailingSearcher) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{
 		Status: http.StatusNotFound,
 		Body:   gjson.Result{},
@@ -249,7 +251,7 @@ func (s *FailingSearcher) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searcher interface.
-func (s *FailingSearcher) GetAll() ([]gjson.Result, error) {
+func (s *FailingSearcher) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
@@ -257,7 +259,7 @@ func (s *FailingSearcher) GetAll() ([]gjson.Result, error) {
 type FailingSearcherWorkingGetter struct{}
 
 // Search implements the searcher interface.
-func (s *FailingSearcherWorkingGetter) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *FailingSearcherWorkingGetter) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{
 		Status: http.StatusNotFound,
 		Body:   gjson.Result{},
@@ -302,7 +304,7 @@ func (s *FailingSearcherWorkingGetter) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searcher interface.
-func (s *FailingSearcherWorkingGetter) GetAll() ([]gjson.Result, error) {
+func (s *FailingSearcherWorkingGetter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -310,7 +312,7 @@ func (s *FailingSearcherWorkingGetter) GetAll() ([]gjson.Result, error) {
 type FailingSearcherFailingGetter struct{}
 
 // Search returns an error.
-func (s *FailingSearcherFailingGetter) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *FailingSearcherFailingGetter) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
@@ -344,7 +346,7 @@ func (s *FailingSearcherFailingGetter) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searcher interface.
-func (s *FailingSearcherFailingGetter) GetAll() ([]gjson.Result, error) {
+func (s *FailingSearcherFailingGetter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
@@ -352,7 +354,7 @@ func (s *FailingSearcherFailingGetter) GetAll() ([]gjson.Result, error) {
 type SearcherReturnsOtherError struct{}
 
 // Search implements the searcher interface.
-func (s *SearcherReturnsOtherError) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *SearcherReturnsOtherError) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{
 		Status: http.StatusNotFound,
 		Body:   gjson.Parse(``),
@@ -373,15 +375,49 @@ func (s *SearcherReturnsOtherError) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searcher interface.
-func (s *SearcherReturnsOtherError) GetAll() ([]gjson.Result, error) {
+func (s *SearcherReturnsOtherError) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(``)}
 }
 
+// RecordingSearcher mocks the discovery.Searcher struct with the given results of Get and SearchByName.
+// It records the calls to those functions, so that the tests can check which ones were used to find an entity.
+type RecordingSearcher struct {
+	GetResult          gjson.Result
+	GetErr             error
+	SearchByNameResult gjson.Result
+	SearchByNameErr    error
+	Calls              []string
+}
+
+// Search implements the searcher interface.
+func (s *RecordingSearcher) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
+	s.Calls = append(s.Calls, "Search")
+	return []gjson.Result(nil), errors.New("the entities must not be searched")
+}
+
+// SearchByName records the call and returns the SearchByName result.
+func (s *RecordingSearcher) SearchByName(name string) (gjson.Result, error) {
+	s.Calls = append(s.Calls, "SearchByName:"+name)
+	return s.SearchByNameResult, s.SearchByNameErr
+}
+
+// Get records the call and returns the Get result.
+func (s *RecordingSearcher) Get(id uuid.UUID) (gjson.Result, error) {
+	s.Calls = append(s.Calls, "Get:"+id.String())
+	return s.GetResult, s.GetErr
+}
+
+// GetAll implements the searcher interface.
+func (s *RecordingSearcher) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
+	s.Calls = append(s.Calls, "GetAll")
+	return []gjson.Result(nil), errors.New("the entities must not be listed")
+}
+
 // SearcherIDNotUUID simulates when the searcher returns a result with an ID that is not a UUID.
 type SearcherIDNotUUID struct{}
 
 // Search implements the searcher interface.
-func (s *SearcherIDNotUUID) Search(gjson.Result) ([]gjson.Result, error) {
+func (s *SearcherIDNotUUID) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{
 		Status: http.StatusNotFound,
 		Body:   gjson.Result{},
@@ -417,7 +453,7 @@ func (s *SearcherIDNotUUID) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searcher interface.
-func (s *SearcherIDNotUUID) GetAll() ([]gjson.Result, error) {
+func (s *SearcherIDNotUUID) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
@@ -501,6 +537,39 @@ func (g *FailingCreatorCreateWorksUpdateFails) Update(uuid.UUID, gjson.Result) (
 	return gjson.Result{}, errors.New(`invalid UUID length: 4`)
 }
 
+// AmbiguousSearcher mocks a searcher in which several entities have the searched name.
+// It also implements the Creator and Deleter interfaces to check that the entities are not created nor deleted.
+type AmbiguousSearcher struct {
+	FailingSearcherFailingGetter
+}
+
+// SearchByName returns an error with two entities that have the given name.
+func (s *AmbiguousSearcher) SearchByName(name string) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.AmbiguousNameError{
+		Name:   name,
+		Format: "{type}/{name}",
+		Candidates: []gjson.Result{
+			gjson.Parse(fmt.Sprintf(`{"id":"986ce864-af76-4fcb-8b4f-f4e4c6ab0951","name":%q,"type":"mongo","labels":[]}`, name)),
+			gjson.Parse(fmt.Sprintf(`{"id":"8f14c11c-bb66-49d3-aa2a-dedff4608c17","name":%q,"type":"openai","labels":[{"key":"A","value":"B"},{"key":"C"}]}`, name)),
+		},
+	}
+}
+
+// Create returns an error, as the entity must not be created.
+func (s *AmbiguousSearcher) Create(gjson.Result) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be created")
+}
+
+// Update returns an error, as the entity must not be updated.
+func (s *AmbiguousSearcher) Update(uuid.UUID, gjson.Result) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be updated")
+}
+
+// Delete returns an error, as the entity must not be deleted.
+func (s *AmbiguousSearcher) Delete(uuid.UUID) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be deleted")
+}
+
 // WorkingSearchCreatorObjectNotExists mocks when the user sends an entity with no ID and the search by name fails
 type WorkingSearchCreatorObjectNotExists struct {
 	FailingSearcher
@@ -672,7 +741,7 @@ func (g *WorkingSearchDeleter) SearchByName(string) (gjson.Result, error) {
 }
 
 // Search implements the searchDeleter interface.
-func (g *WorkingSearchDeleter) Search(gjson.Result) ([]gjson.Result, error) {
+func (g *WorkingSearchDeleter) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -690,7 +759,7 @@ func (g *WorkingSearchDeleter) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searchDeleter interface.
-func (g *WorkingSearchDeleter) GetAll() ([]gjson.Result, error) {
+func (g *WorkingSearchDeleter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -713,7 +782,7 @@ func (g *FailingSearchDeleterSearchFails) SearchByName(name string) (gjson.Resul
 }
 
 // Search implements the searchDeleter interface.
-func (g *FailingSearchDeleterSearchFails) Search(gjson.Result) ([]gjson.Result, error) {
+func (g *FailingSearchDeleterSearchFails) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -723,7 +792,7 @@ func (g *FailingSearchDeleterSearchFails) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searchDeleter interface.
-func (g *FailingSearchDeleterSearchFails) GetAll() ([]gjson.Result, error) {
+func (g *FailingSearchDeleterSearchFails) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -759,7 +828,7 @@ func (g *FailingSearchDeleterDeleteFails) SearchByName(string) (gjson.Result, er
 }
 
 // Search implements the searchDeleter interface.
-func (g *FailingSearchDeleterDeleteFails) Search(gjson.Result) ([]gjson.Result, error) {
+func (g *FailingSearchDeleterDeleteFails) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -777,7 +846,7 @@ func (g *FailingSearchDeleterDeleteFails) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the searchDeleter interface.
-func (g *FailingSearchDeleterDeleteFails) GetAll() ([]gjson.Result, error) {
+func (g *FailingSearchDeleterDeleteFails) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -813,7 +882,7 @@ func (g *FailingSearchDeleterParsingUUIDFails) SearchByName(string) (gjson.Resul
 }
 
 // Search implements the searchDeleter interface.
-func (g *FailingSearchDeleterParsingUUIDFails) Search(gjson.Result) ([]gjson.Result, error) {
+func (g *FailingSearchDeleterParsingUUIDFails) Search(gjson.Result, ...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -831,7 +900,7 @@ func (g *FailingSearchDeleterParsingUUIDFails) Get(uuid.UUID) (gjson.Result, err
 }
 
 // GetAll implements the searchDeleter interface.
-func (g *FailingSearchDeleterParsingUUIDFails) GetAll() ([]gjson.Result, error) {
+func (g *FailingSearchDeleterParsingUUIDFails) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[]`).Array(), nil
 }
 
@@ -856,3 +925,197 @@ func (c *FailingContentController) Scroll(filters, projections gjson.Result, siz
 		Body:   gjson.Parse(`{"status": 500, "code": 5000, "messages": ["Internal server error"]}`),
 	}
 }
+
+// StreamingGetter mocks the discovery.getter struct when the entities are streamed.
+// The sequence yields two entities and then the error, if it is set.
+type StreamingGetter struct {
+	WorkingGetter
+	Err error
+}
+
+// GetAllSeq yields two processors and then the error, if it is set.
+func (g *StreamingGetter) GetAllSeq(...discoveryPackage.RequestOption) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		if !yield(gjson.Parse(`{"id":"3393f6d9-94c1-4b70-ba02-5f582727d998","name":"MongoDB text processor 4"}`), nil) {
+			return
+		}
+		if !yield(gjson.Parse(`{"id":"5f125024-1e5e-4591-9fee-365dc20eeeed","name":"MongoDB text processor"}`), nil) {
+			return
+		}
+		if g.Err != nil {
+			yield(gjson.Result{}, g.Err)
+		}
+	}
+}
+
+// StreamingSearcher mocks the discovery.searcher struct when the search results are streamed.
+// The sequence yields one result and then the error, if it is set.
+type StreamingSearcher struct {
+	WorkingSearcher
+	Err error
+}
+
+// SearchSeq yields one server and then the error, if it is set.
+func (s *StreamingSearcher) SearchSeq(gjson.Result, ...discoveryPackage.RequestOption) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		if !yield(gjson.Parse(`{"id":"986ce864-af76-4fcb-8b4f-f4e4c6ab0951","name":"MongoDB Atlas server clone"}`), nil) {
+			return
+		}
+		if s.Err != nil {
+			yield(gjson.Result{}, s.Err)
+		}
+	}
+}
+
+// WorkingSearchCloner finds the entity by its name and clones it.
+// The copy has the name and URI of the query parameters, so that the tests can check the parameters that were sent.
+type WorkingSearchCloner struct {
+	WorkingSearcher
+}
+
+// Clone returns the copy of the entity.
+func (c *WorkingSearchCloner) Clone(id uuid.UUID, params map[string][]string) (gjson.Result, error) {
+	clone := `{"id":"c77caced-b1d4-49de-b690-09bac3bc80a7","type":"mongo","name":"MongoDB Atlas server clone","labels":[{"key":"A","value":"A"},{"key":"B"}]}`
+	for param, values := range params {
+		clone, _ = sjson.Set(clone, param, values[0])
+	}
+	return gjson.Parse(clone), nil
+}
+
+// Create returns an error, as the copy must not be created again.
+func (c *WorkingSearchCloner) Create(gjson.Result) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be created")
+}
+
+// Update returns the received configuration as if the copy was updated.
+func (c *WorkingSearchCloner) Update(_ uuid.UUID, config gjson.Result) (gjson.Result, error) {
+	return config, nil
+}
+
+// FailingSearchClonerSearchFails simulates when the entity that will be cloned does not exist.
+type FailingSearchClonerSearchFails struct {
+	FailingSearchDeleterSearchFails
+}
+
+// Clone returns an error, as the entity must not be cloned.
+func (c *FailingSearchClonerSearchFails) Clone(uuid.UUID, map[string][]string) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be cloned")
+}
+
+// Create returns an error, as the entity must not be created.
+func (c *FailingSearchClonerSearchFails) Create(gjson.Result) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be created")
+}
+
+// Update returns an error, as the entity must not be updated.
+func (c *FailingSearchClonerSearchFails) Update(uuid.UUID, gjson.Result) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be updated")
+}
+
+// FailingSearchClonerCloneFails finds the entity by its name, but the clone request fails.
+type FailingSearchClonerCloneFails struct {
+	WorkingSearchCloner
+}
+
+// Clone returns a conflict error.
+func (c *FailingSearchClonerCloneFails) Clone(uuid.UUID, map[string][]string) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusConflict, Body: gjson.Parse(`{
+	"status": 409,
+	"code": 4002,
+	"messages": [
+		"Duplicated entity: MongoDB Atlas server clone"
+	]
+}`)}
+}
+
+// FailingSearchClonerUpdateFails clones the entity, but the labels cannot be added to the copy.
+type FailingSearchClonerUpdateFails struct {
+	WorkingSearchCloner
+}
+
+// Update returns a bad request error.
+func (c *FailingSearchClonerUpdateFails) Update(uuid.UUID, gjson.Result) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{
+	"status": 400,
+	"code": 3002,
+	"messages": [
+		"The label key cannot be blank"
+	]
+}`)}
+}
+
+// WorkingSearchEnabler finds the entities by their names and filters and enables or disables them.
+// The search yields the entity found by name and another one, and then the error, if it is set.
+type WorkingSearchEnabler struct {
+	WorkingSearcher
+	Err error
+}
+
+// SearchSeq yields two servers and then the error, if it is set.
+func (e *WorkingSearchEnabler) SearchSeq(gjson.Result, ...discoveryPackage.RequestOption) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		if !yield(gjson.Parse(`{"id":"986ce864-af76-4fcb-8b4f-f4e4c6ab0951","name":"MongoDB Atlas server"}`), nil) {
+			return
+		}
+		if !yield(gjson.Parse(`{"id":"8f14c11c-bb66-49d3-aa2a-dedff4608c17","name":"MongoDB Atlas server clone 1"}`), nil) {
+			return
+		}
+		if e.Err != nil {
+			yield(gjson.Result{}, e.Err)
+		}
+	}
+}
+
+// Enable returns an acknowledgement as if the entity was enabled.
+func (e *WorkingSearchEnabler) Enable(uuid.UUID) (gjson.Result, error) {
+	return gjson.Parse(`{"acknowledged": true}`), nil
+}
+
+// Disable returns an acknowledgement as if the entity was disabled.
+func (e *WorkingSearchEnabler) Disable(uuid.UUID) (gjson.Result, error) {
+	return gjson.Parse(`{"acknowledged": true}`), nil
+}
+
+// FailingSearchEnablerToggleFails finds the entities, but the entity with id 8f14c11c-bb66-49d3-aa2a-dedff4608c17 cannot be enabled or disabled.
+type FailingSearchEnablerToggleFails struct {
+	WorkingSearchEnabler
+}
+
+// toggle returns a not found error for the entity that cannot be changed.
+func (e *FailingSearchEnablerToggleFails) toggle(id uuid.UUID) (gjson.Result, error) {
+	if id.String() == "8f14c11c-bb66-49d3-aa2a-dedff4608c17" {
+		return gjson.Result{}, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{
+	"status": 404,
+	"code": 1003,
+	"messages": [
+		"Entity not found: 8f14c11c-bb66-49d3-aa2a-dedff4608c17"
+	]
+}`)}
+	}
+	return gjson.Parse(`{"acknowledged": true}`), nil
+}
+
+// Enable fails for one of the entities.
+func (e *FailingSearchEnablerToggleFails) Enable(id uuid.UUID) (gjson.Result, error) {
+	return e.toggle(id)
+}
+
+// Disable fails for one of the entities.
+func (e *FailingSearchEnablerToggleFails) Disable(id uuid.UUID) (gjson.Result, error) {
+	return e.toggle(id)
+}
+
+// FailingSearchEnablerSearchFails simulates when the entities that will be enabled or disabled do not exist.
+type FailingSearchEnablerSearchFails struct {
+	FailingSearchDeleterSearchFails
+}
+
+// Enable returns an error, as the entity must not be enabled.
+func (e *FailingSearchEnablerSearchFails) Enable(uuid.UUID) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be enabled")
+}
+
+// Disable returns an error, as the entity must not be disabled.
+func (e *FailingSearchEnablerSearchFails) Disable(uuid.UUID) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the entity must not be disabled")
+}
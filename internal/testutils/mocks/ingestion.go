@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -114,7 +115,7 @@ func (g *WorkingRecordGetter) Get(string) (gjson.Result, error) {
 }
 
 // GetAll returns a list of records.
-func (g *WorkingRecordGetter) GetAll() ([]gjson.Result, error) {
+func (g *WorkingRecordGetter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[
 		{"id":{"plain":"4e7c8a47efd829ef7f710d64da661786","hash":"A3HTDEgCa65BFZsac9TInFisvloRlL3M50ijCWNCKx0="},"creationTimestamp":"2025-09-05T20:13:47Z","lastUpdatedTimestamp":"2025-09-05T20:13:47Z","status":"SUCCESS"},
 		{"id":{"plain":"8148e6a7b952a3b2964f706ced8c6885","hash":"IJeF-losyj33EAuqjgGW2G7sT-eE7poejQ5HokerZio="},"creationTimestamp":"2025-09-05T20:13:47Z","lastUpdatedTimestamp":"2025-09-05T20:13:47Z","status":"SUCCESS"},
@@ -144,7 +145,7 @@ func (g *WorkingRecordGetterNoSummary) Get(string) (gjson.Result, error) {
 }
 
 // GetAll returns a list of records.
-func (g *WorkingRecordGetterNoSummary) GetAll() ([]gjson.Result, error) {
+func (g *WorkingRecordGetterNoSummary) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return gjson.Parse(`[
 		{"id":{"plain":"4e7c8a47efd829ef7f710d64da661786","hash":"A3HTDEgCa65BFZsac9TInFisvloRlL3M50ijCWNCKx0="},"creationTimestamp":"2025-09-05T20:13:47Z","lastUpdatedTimestamp":"2025-09-05T20:13:47Z","status":"SUCCESS"},
 		{"id":{"plain":"8148e6a7b952a3b2964f706ced8c6885","hash":"IJeF-losyj33EAuqjgGW2G7sT-eE7poejQ5HokerZio="},"creationTimestamp":"2025-09-05T20:13:47Z","lastUpdatedTimestamp":"2025-09-05T20:13:47Z","status":"SUCCESS"},
@@ -176,7 +177,7 @@ func (g *FailingRecordGetter) Get(string) (gjson.Result, error) {
 }
 
 // GetAll returns 401 unauthorized.
-func (g *FailingRecordGetter) GetAll() ([]gjson.Result, error) {
+func (g *FailingRecordGetter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result(nil), discoveryPackage.Error{Status: http.StatusUnauthorized, Body: gjson.Parse(`{"error":"unauthorized"}`)}
 }
 
@@ -212,7 +213,7 @@ func (g *WorkingSeedExecutionGetter) Get(uuid.UUID) (gjson.Result, error) {
 }
 
 // GetAll implements the interface.
-func (g *WorkingSeedExecutionGetter) GetAll() ([]gjson.Result, error) {
+func (g *WorkingSeedExecutionGetter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result{}, nil
 }
 
@@ -254,7 +255,7 @@ func (g *WorkingSeedExecutionGetterNoExecutions) Get(uuid.UUID) (gjson.Result, e
 }
 
 // GetAll implements the interface.
-func (g *WorkingSeedExecutionGetterNoExecutions) GetAll() ([]gjson.Result, error) {
+func (g *WorkingSeedExecutionGetterNoExecutions) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result{}, nil
 }
 
@@ -289,7 +290,7 @@ func (g *FailingSeedExecutionGetterGetExecutionFails) Get(uuid.UUID) (gjson.Resu
 }
 
 // GetAll implements the interface.
-func (g *FailingSeedExecutionGetterGetExecutionFails) GetAll() ([]gjson.Result, error) {
+func (g *FailingSeedExecutionGetterGetExecutionFails) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result{}, nil
 }
 
@@ -326,7 +327,7 @@ func (g *FailingSeedExecutionGetterAuditFails) Get(uuid.UUID) (gjson.Result, err
 }
 
 // GetAll implements the interface.
-func (g *FailingSeedExecutionGetterAuditFails) GetAll() ([]gjson.Result, error) {
+func (g *FailingSeedExecutionGetterAuditFails) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result{}, nil
 }
 
@@ -363,7 +364,7 @@ func (g *FailingSeedExecutionGetterLastExecutionsFails) Get(uuid.UUID) (gjson.Re
 }
 
 // GetAll implements the interface.
-func (g *FailingSeedExecutionGetterLastExecutionsFails) GetAll() ([]gjson.Result, error) {
+func (g *FailingSeedExecutionGetterLastExecutionsFails) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
 	return []gjson.Result{}, nil
 }
 
@@ -415,3 +416,82 @@ func (s *FailingJobSummarizer) Summarize() (gjson.Result, error) {
   "timestamp": "2025-11-17T19:32:01.555127800Z"
 }`)}
 }
+
+// WorkingSeedResetter simulates the seeds client when a seed is halted and reset.
+// It records the halts and resets, so that the tests can check the changes to the seed.
+type WorkingSeedResetter struct {
+	WorkingSeedController
+	Halted bool
+	Resets int
+}
+
+// Halt records the halt and returns the results of halting a seed.
+func (r *WorkingSeedResetter) Halt(id uuid.UUID) ([]gjson.Result, error) {
+	r.Halted = true
+	return r.WorkingSeedController.Halt(id)
+}
+
+// Reset records the reset and returns an acknowledgement.
+func (r *WorkingSeedResetter) Reset(uuid.UUID) (gjson.Result, error) {
+	r.Resets++
+	return gjson.Parse(`{"acknowledged": true}`), nil
+}
+
+// FailingSeedResetterResetFails simulates the seeds client when the reset fails.
+type FailingSeedResetterResetFails struct {
+	WorkingSeedResetter
+}
+
+// Reset returns a conflict error.
+func (r *FailingSeedResetterResetFails) Reset(uuid.UUID) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusConflict, Body: gjson.Parse(`{"status":409,"code":4001,"messages":["The seed has 1 executions: 0c309dbb-0402-4710-8659-2c75f5d649b6"],"timestamp":"2025-09-04T20:17:00.116546400Z"}`)}
+}
+
+// FailingSeedResetterHaltFails simulates the seeds client when the active executions cannot be halted.
+type FailingSeedResetterHaltFails struct {
+	WorkingSeedResetter
+}
+
+// Halt returns an internal server error.
+func (r *FailingSeedResetterHaltFails) Halt(uuid.UUID) ([]gjson.Result, error) {
+	return nil, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status":500,"code":5000,"messages":["Internal server error"]}`)}
+}
+
+// FailingSeedResetterSearchFails simulates the seeds client when the seed that will be reset does not exist.
+type FailingSeedResetterSearchFails struct {
+	FailingSearchDeleterSearchFails
+}
+
+// Start returns an error, as the seed must not be started.
+func (r *FailingSeedResetterSearchFails) Start(uuid.UUID, discoveryPackage.ScanType, gjson.Result) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the seed must not be started")
+}
+
+// Halt returns an error, as the seed must not be halted.
+func (r *FailingSeedResetterSearchFails) Halt(uuid.UUID) ([]gjson.Result, error) {
+	return nil, errors.New("the seed must not be halted")
+}
+
+// Reset returns an error, as the seed must not be reset.
+func (r *FailingSeedResetterSearchFails) Reset(uuid.UUID) (gjson.Result, error) {
+	return gjson.Result{}, errors.New("the seed must not be reset")
+}
+
+// ActiveExecutionsGetter simulates the seed executions client of a seed with an active execution.
+// The execution is RUNNING for the number of checks in Active, and HALTED afterwards.
+type ActiveExecutionsGetter struct {
+	Active int
+}
+
+// GetLast5Executions returns the last executions of the seed.
+func (g *ActiveExecutionsGetter) GetLast5Executions() (gjson.Result, error) {
+	status := "HALTED"
+	if g.Active > 0 {
+		status = "RUNNING"
+		g.Active--
+	}
+	return gjson.Parse(`[
+	{"id":"0c309dbb-0402-4710-8659-2c75f5d649b6","creationTimestamp":"2026-04-10T17:26:26Z","lastUpdatedTimestamp":"2026-04-10T17:26:47Z","triggerType":"MANUAL","status":"` + status + `","scanType":"FULL"},
+	{"id":"3fdddf51-fa6b-406b-9b28-cc40969d908d","creationTimestamp":"2026-04-08T16:33:32Z","lastUpdatedTimestamp":"2026-04-08T16:39:32Z","triggerType":"MANUAL","status":"DONE","scanType":"FULL"}
+	]`), nil
+}
@@ -0,0 +1,82 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+
+	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
+)
+
+// WorkingEndpointInvoker simulates the QueryFlow client when the endpoints respond.
+// It records the last request, so that the tests can check what was sent to the endpoint.
+type WorkingEndpointInvoker struct {
+	Response gjson.Result
+	Debugged bool
+	Method   string
+	URI      string
+	Request  *resty.Request
+}
+
+// call records the request and returns the configured response.
+func (e *WorkingEndpointInvoker) call(method, uri string, options ...discoveryPackage.RequestOption) (gjson.Result, error) {
+	e.Method = method
+	e.URI = uri
+	e.Request = resty.New().R()
+	for _, option := range options {
+		if err := option(e.Request); err != nil {
+			return gjson.Result{}, err
+		}
+	}
+	return e.Response, nil
+}
+
+// Invoke records the request to the API version of the endpoint.
+func (e *WorkingEndpointInvoker) Invoke(method, uri string, options ...discoveryPackage.RequestOption) (gjson.Result, error) {
+	e.Debugged = false
+	return e.call(method, uri, options...)
+}
+
+// Debug records the request to the Debug version of the endpoint.
+func (e *WorkingEndpointInvoker) Debug(method, uri string, options ...discoveryPackage.RequestOption) (gjson.Result, error) {
+	e.Debugged = true
+	return e.call(method, uri, options...)
+}
+
+// FailingEndpointInvoker simulates the QueryFlow client when the endpoint does not exist.
+type FailingEndpointInvoker struct{}
+
+// Invoke returns a not found error.
+func (e *FailingEndpointInvoker) Invoke(string, string, ...discoveryPackage.RequestOption) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{"status":404,"code":1003,"messages":["Endpoint not found: GET /my/endpoint"],"timestamp":"2025-10-20T18:07:44.071585600Z"}`)}
+}
+
+// Debug returns a not found error.
+func (e *FailingEndpointInvoker) Debug(method, uri string, options ...discoveryPackage.RequestOption) (gjson.Result, error) {
+	return e.Invoke(method, uri, options...)
+}
+
+// WorkingProcessorGetter simulates the QueryFlow processors client when the names of the processors of a debug trace are obtained.
+// The processor "8a399b1c-95fc-406c-a220-7d321aaa7b0e" does not exist, so its name cannot be obtained.
+type WorkingProcessorGetter struct{}
+
+// Get returns the processor with the given id or a not found error.
+func (g *WorkingProcessorGetter) Get(id uuid.UUID) (gjson.Result, error) {
+	names := map[string]string{
+		"b5c25cd3-e7c9-4fd2-b7e6-2bcf6e2caf89": "embeddings-processor",
+		"a5ee116b-bd95-474e-9d50-db7be988b196": "mongo-processor",
+		"86e7f920-a4e4-4b64-be84-5437a7673db8": "prompt-processor",
+	}
+	name, found := names[id.String()]
+	if !found {
+		return gjson.Result{}, discoveryPackage.Error{Status: http.StatusNotFound, Body: gjson.Parse(`{"status":404,"code":1003,"messages":["Entity not found: ` + id.String() + `"]}`)}
+	}
+	return gjson.Parse(`{"type":"script","name":"` + name + `","id":"` + id.String() + `"}`), nil
+}
+
+// GetAll implements the interface.
+func (g *WorkingProcessorGetter) GetAll(...discoveryPackage.RequestOption) ([]gjson.Result, error) {
+	return []gjson.Result{}, nil
+}
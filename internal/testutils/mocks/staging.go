@@ -2,8 +2,10 @@ package mocks
 
 import (
 	"errors"
+	"iter"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/tidwall/gjson"
 
 	discoveryPackage "github.com/pureinsights/discovery-cli/discovery"
@@ -547,3 +549,114 @@ func (s *FailingStagingContentController) Scroll(gjson.Result, gjson.Result, *in
 }`),
 	}
 }
+
+// StreamingStagingContentController mocks a content controller that streams the records of the scroll.
+// The sequence yields one record and then the error, if it is set.
+type StreamingStagingContentController struct {
+	WorkingStagingContentController
+	Err error
+}
+
+// ScrollSeq yields one record and then the error, if it is set.
+func (s *StreamingStagingContentController) ScrollSeq(gjson.Result, gjson.Result, *int) iter.Seq2[gjson.Result, error] {
+	return func(yield func(gjson.Result, error) bool) {
+		if !yield(gjson.Parse(`{"id":"1","transaction":"694eb7b678aedc7a163da8ff"}`), nil) {
+			return
+		}
+		if s.Err != nil {
+			yield(gjson.Result{}, s.Err)
+		}
+	}
+}
+
+// bucketWithIndices is the bucket that the bucket searchers find by its name or UUID.
+const bucketWithIndices = `{
+	"name": "my-bucket",
+	"id": "69eeb20b-8ded-478f-937f-64caa0a3e8c0",
+	"active": true,
+	"labels": [],
+	"documentCount": {},
+	"creationTimestamp": "2026-06-04T22:06:02Z",
+	"lastUpdatedTimestamp": "2026-06-04T22:06:02Z",
+	"indices": [
+		{"name": "myIndexA", "fields": [{"fieldName": "ASC"}], "unique": false},
+		{"name": "myIndexB", "fields": [{"fieldName2": "DESC"}, {"fieldName3": "ASC"}], "unique": false}
+	]
+}`
+
+// WorkingBucketSearcher simulates the buckets client when the bucket is found by its name or UUID.
+type WorkingBucketSearcher struct {
+	WorkingSearcher
+}
+
+// SearchByName returns the bucket with its indices.
+func (s *WorkingBucketSearcher) SearchByName(string) (gjson.Result, error) {
+	return gjson.Parse(bucketWithIndices), nil
+}
+
+// Get returns the bucket with its indices.
+func (s *WorkingBucketSearcher) Get(uuid.UUID) (gjson.Result, error) {
+	return gjson.Parse(bucketWithIndices), nil
+}
+
+// WorkingBucketPurger simulates the buckets client when the records of a bucket are purged.
+// It records the purged bucket, so that the tests can check that the records were deleted.
+type WorkingBucketPurger struct {
+	WorkingBucketSearcher
+	Purged uuid.UUID
+}
+
+// Purge records the bucket and returns an acknowledgement.
+func (p *WorkingBucketPurger) Purge(bucket uuid.UUID) (gjson.Result, error) {
+	p.Purged = bucket
+	return gjson.Parse(`{"acknowledged":true}`), nil
+}
+
+// FailingBucketPurger simulates the buckets client when the purge fails.
+type FailingBucketPurger struct {
+	WorkingBucketSearcher
+}
+
+// Purge returns an internal server error.
+func (p *FailingBucketPurger) Purge(uuid.UUID) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status":500,"code":5000,"messages":["Internal server error"]}`)}
+}
+
+// WorkingBucketIndexController simulates the buckets client when the indices of a bucket are created and deleted.
+// It records the last change, so that the tests can check what was sent to Discovery Staging.
+type WorkingBucketIndexController struct {
+	WorkingBucketSearcher
+	Bucket uuid.UUID
+	Index  string
+	Fields []gjson.Result
+}
+
+// CreateIndex records the index and returns an acknowledgement.
+func (c *WorkingBucketIndexController) CreateIndex(id uuid.UUID, index string, config []gjson.Result) (gjson.Result, error) {
+	c.Bucket = id
+	c.Index = index
+	c.Fields = config
+	return gjson.Parse(`{"acknowledged":true}`), nil
+}
+
+// DeleteIndex records the index and returns an acknowledgement.
+func (c *WorkingBucketIndexController) DeleteIndex(id uuid.UUID, index string) (gjson.Result, error) {
+	c.Bucket = id
+	c.Index = index
+	return gjson.Parse(`{"acknowledged":true}`), nil
+}
+
+// FailingBucketIndexController simulates the buckets client when the indices of a bucket cannot be changed.
+type FailingBucketIndexController struct {
+	WorkingBucketSearcher
+}
+
+// CreateIndex returns a bad request error.
+func (c *FailingBucketIndexController) CreateIndex(uuid.UUID, string, []gjson.Result) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusBadRequest, Body: gjson.Parse(`{"status":400,"code":3002,"messages":["Invalid index configuration"]}`)}
+}
+
+// DeleteIndex returns an internal server error.
+func (c *FailingBucketIndexController) DeleteIndex(uuid.UUID, string) (gjson.Result, error) {
+	return gjson.Result{}, discoveryPackage.Error{Status: http.StatusInternalServerError, Body: gjson.Parse(`{"status":500,"code":5000,"messages":["Internal server error"]}`)}
+}